@@ -0,0 +1,383 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/registries"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/xchain/util"
+)
+
+// streamBundlePageSize is the number of miniblocks read from or written to storage at once.
+const streamBundlePageSize = 100
+
+// openStreamStorage opens the stream storage configured for this node.
+// If archiveId is set, the storage of the archive node with the given id is opened instead.
+// Storage is opened exclusively: the node using it must be stopped.
+func openStreamStorage(ctx context.Context, archiveId string) (storage.StreamStorage, func(), error) {
+	switch cmdConfig.StorageType {
+	case storage.StreamStorageTypePostgres:
+		var schema string
+		if archiveId != "" {
+			schema = storage.DbSchemaNameForArchive(archiveId)
+		} else {
+			wallet, err := util.LoadWallet(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			schema = storage.DbSchemaNameFromAddress(wallet.Address.Hex())
+		}
+
+		pool, err := storage.CreateAndValidatePgxPool(ctx, &cmdConfig.Database, schema, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		store, err := storage.NewPostgresStreamStore(
+			ctx,
+			pool,
+			base.GenShortNanoid(),
+			make(chan error, 1),
+			infra.NewMetricsFactory(nil, "river", "cmdline"),
+			crypto.DefaultOnChainSettings().StreamEphemeralStreamTTL,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if archiveId != "" && cmdConfig.Archive.ColdTier.Enabled() {
+			segments, err := storage.NewLocalSegmentBackend(cmdConfig.Archive.ColdTier.Directory)
+			if err != nil {
+				store.Close(ctx)
+				return nil, nil, err
+			}
+			store.EnableColdTier(segments)
+		}
+		return store, func() { store.Close(ctx) }, nil
+	case storage.StreamStorageTypePebble:
+		store, err := storage.NewPebbleStreamStore(
+			ctx,
+			cmdConfig.Database.DataDir,
+			crypto.DefaultOnChainSettings().StreamEphemeralStreamTTL,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		return store, func() { store.Close(ctx) }, nil
+	default:
+		return nil, nil, base.RiverError(
+			protocol.Err_BAD_CONFIG,
+			"Unknown storage type",
+			"storageType",
+			cmdConfig.StorageType,
+		).Func("openStreamStorage")
+	}
+}
+
+// exportStreamBundle reads all miniblocks of the stream from the given storage into a bundle.
+func exportStreamBundle(
+	ctx context.Context,
+	store storage.StreamStorage,
+	streamId shared.StreamId,
+	nodes []common.Address,
+) (*protocol.StreamBundle, error) {
+	lastMiniblockNum, err := store.GetMaxArchivedMiniblockNumber(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+	if lastMiniblockNum < 0 {
+		return nil, base.RiverError(protocol.Err_NOT_FOUND, "Stream has no miniblocks", "streamId", streamId)
+	}
+
+	bundle := &protocol.StreamBundle{
+		StreamId:         streamId[:],
+		LastMiniblockNum: lastMiniblockNum,
+	}
+	for _, node := range nodes {
+		bundle.Nodes = append(bundle.Nodes, node.Bytes())
+	}
+
+	for from := int64(0); from <= lastMiniblockNum; from += streamBundlePageSize {
+		miniblocks, err := store.ReadMiniblocks(ctx, streamId, from, min(from+streamBundlePageSize, lastMiniblockNum+1))
+		if err != nil {
+			return nil, err
+		}
+		for _, data := range miniblocks {
+			var mb protocol.Miniblock
+			if err := proto.Unmarshal(data, &mb); err != nil {
+				return nil, base.AsRiverError(err, protocol.Err_BAD_BLOCK).
+					Message("Failed to decode miniblock").
+					Tag("streamId", streamId).
+					Tag("miniblockNum", int64(len(bundle.Miniblocks)))
+			}
+			bundle.Miniblocks = append(bundle.Miniblocks, &mb)
+		}
+	}
+
+	if _, err := verifyStreamBundle(bundle); err != nil {
+		return nil, err
+	}
+	bundle.LastMiniblockHash = bundle.Miniblocks[len(bundle.Miniblocks)-1].GetHeader().GetHash()
+	return bundle, nil
+}
+
+// verifyStreamBundle checks hashes and signatures of all events and miniblocks in the bundle,
+// that miniblocks form a chain starting from genesis and that the bundle is complete.
+func verifyStreamBundle(bundle *protocol.StreamBundle) ([]*events.MiniblockInfo, error) {
+	streamId, err := shared.StreamIdFromBytes(bundle.GetStreamId())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bundle.GetMiniblocks()) == 0 || bundle.GetLastMiniblockNum() < 0 {
+		return nil, base.RiverError(protocol.Err_BAD_BLOCK, "Bundle has no miniblocks").
+			Tag("streamId", streamId).
+			Tag("lastMiniblockNum", bundle.GetLastMiniblockNum())
+	}
+
+	if int64(len(bundle.GetMiniblocks())) != bundle.GetLastMiniblockNum()+1 {
+		return nil, base.RiverError(protocol.Err_BAD_BLOCK, "Bundle is incomplete").
+			Tag("streamId", streamId).
+			Tag("lastMiniblockNum", bundle.GetLastMiniblockNum()).
+			Tag("numMiniblocks", len(bundle.GetMiniblocks()))
+	}
+
+	infos := make([]*events.MiniblockInfo, 0, len(bundle.GetMiniblocks()))
+	prevHash := common.Hash{}
+	for i, mb := range bundle.GetMiniblocks() {
+		info, err := events.NewMiniblockInfoFromProto(
+			mb,
+			events.NewParsedMiniblockInfoOpts().
+				WithExpectedBlockNumber(int64(i)).
+				WithExpectedPrevMiniblockHash(prevHash),
+		)
+		if err != nil {
+			return nil, base.AsRiverError(err).Tag("streamId", streamId).Tag("miniblockNum", i)
+		}
+		prevHash = info.Ref.Hash
+		infos = append(infos, info)
+	}
+
+	if inception := infos[0].Events(); len(inception) == 0 || inception[0].Event.GetInceptionPayload() == nil ||
+		!bytes.Equal(inception[0].Event.GetInceptionPayload().GetStreamId(), streamId[:]) {
+		return nil, base.RiverError(protocol.Err_BAD_BLOCK, "Genesis miniblock doesn't contain stream inception").
+			Tag("streamId", streamId)
+	}
+
+	if bundle.GetLastMiniblockHash() != nil && !bytes.Equal(bundle.GetLastMiniblockHash(), prevHash[:]) {
+		return nil, base.RiverError(protocol.Err_BAD_BLOCK, "Last miniblock hash mismatch").
+			Tag("streamId", streamId).
+			Tag("lastMiniblockHash", prevHash)
+	}
+
+	return infos, nil
+}
+
+// importStreamBundle verifies the bundle and writes the stream into the given storage.
+// Archive storage only holds miniblocks, so they are written as is. For stream node storage
+// miniblocks are written with WriteMiniblocks to keep the minipool and the last snapshot in sync.
+func importStreamBundle(
+	ctx context.Context,
+	store storage.StreamStorage,
+	bundle *protocol.StreamBundle,
+	archive bool,
+) error {
+	infos, err := verifyStreamBundle(bundle)
+	if err != nil {
+		return err
+	}
+	streamId, err := shared.StreamIdFromBytes(bundle.GetStreamId())
+	if err != nil {
+		return err
+	}
+
+	miniblocks := make([][]byte, len(infos))
+	for i, info := range infos {
+		if miniblocks[i], err = proto.Marshal(info.Proto); err != nil {
+			return err
+		}
+	}
+
+	if archive {
+		if err = store.CreateStreamArchiveStorage(ctx, streamId); err != nil {
+			return err
+		}
+		for from := 0; from < len(miniblocks); from += streamBundlePageSize {
+			to := min(from+streamBundlePageSize, len(miniblocks))
+			if err = store.WriteArchiveMiniblocks(ctx, streamId, int64(from), miniblocks[from:to]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err = store.CreateStreamStorage(ctx, streamId, miniblocks[0]); err != nil {
+		return err
+	}
+	for from := 1; from < len(miniblocks); from += streamBundlePageSize {
+		to := min(from+streamBundlePageSize, len(miniblocks))
+		var mbs []*storage.WriteMiniblockData
+		for i := from; i < to; i++ {
+			mbs = append(mbs, &storage.WriteMiniblockData{
+				Number:   infos[i].Ref.Num,
+				Hash:     infos[i].Ref.Hash,
+				Snapshot: infos[i].IsSnapshot(),
+				Data:     miniblocks[i],
+			})
+		}
+		if err = store.WriteMiniblocks(ctx, streamId, mbs, int64(to), nil, int64(from), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runStreamExportCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+	streamId, err := shared.StreamIdFromString(args[0])
+	if err != nil {
+		return err
+	}
+	archiveId, err := cmd.Flags().GetString("archive-id")
+	if err != nil {
+		return err
+	}
+
+	blockchain, err := crypto.NewBlockchain(
+		ctx,
+		&cmdConfig.RiverChain,
+		nil,
+		infra.NewMetricsFactory(nil, "river", "cmdline"),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	registryContract, err := registries.NewRiverRegistryContract(
+		ctx,
+		blockchain,
+		&cmdConfig.RegistryContract,
+		&cmdConfig.RiverRegistry,
+	)
+	if err != nil {
+		return err
+	}
+
+	streamRecord, err := registryContract.StreamRegistry.GetStream(nil, streamId)
+	if err != nil {
+		return err
+	}
+
+	store, closer, err := openStreamStorage(ctx, archiveId)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	bundle, err := exportStreamBundle(ctx, store, streamId, streamRecord.Nodes)
+	if err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(args[1], data, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported stream %s with %d miniblocks to %s\n", streamId, len(bundle.Miniblocks), args[1])
+	return nil
+}
+
+func runStreamImportCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+	archiveId, err := cmd.Flags().GetString("archive-id")
+	if err != nil {
+		return err
+	}
+	verifyOnly, err := cmd.Flags().GetBool("verify-only")
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var bundle protocol.StreamBundle
+	if err = proto.Unmarshal(data, &bundle); err != nil {
+		return err
+	}
+
+	if verifyOnly {
+		if _, err = verifyStreamBundle(&bundle); err != nil {
+			return err
+		}
+		fmt.Printf("Bundle %s is valid, %d miniblocks\n", args[0], len(bundle.Miniblocks))
+		return nil
+	}
+
+	store, closer, err := openStreamStorage(ctx, archiveId)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	if err = importStreamBundle(ctx, store, &bundle, archiveId != ""); err != nil {
+		return err
+	}
+
+	streamId, _ := shared.StreamIdFromBytes(bundle.StreamId)
+	fmt.Printf("Imported stream %s with %d miniblocks\n", streamId, len(bundle.Miniblocks))
+	for _, node := range bundle.Nodes {
+		fmt.Printf("  exported from node %s\n", common.BytesToAddress(node))
+	}
+	return nil
+}
+
+// addStreamBundleCommands adds the stream export and import commands to the stream command.
+func addStreamBundleCommands(cmdStream *cobra.Command) {
+	cmdStreamExport := &cobra.Command{
+		Use:   "export <stream-id> <file>",
+		Short: "Export full stream history from local storage into a bundle file",
+		Long: `Export all miniblocks of the stream, starting from genesis, from the storage of this node
+into a self-verifying bundle file. Bundle also records the nodes the stream is placed on.
+Storage is opened exclusively, the node must be stopped.
+If --archive-id is set, the stream is exported from the storage of the given archive node.`,
+		Args: cobra.ExactArgs(2),
+		RunE: runStreamExportCmd,
+	}
+	cmdStreamExport.Flags().String("archive-id", "", "Export from the storage of the archive node with the given id")
+
+	cmdStreamImport := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Verify and import a stream bundle file into local storage",
+		Long: `Verify hashes, signatures and the miniblock chain of the bundle and import the stream
+into the storage of this node. Stream must not exist in the storage.
+Storage is opened exclusively, the node must be stopped.
+If --archive-id is set, the stream is imported into the storage of the given archive node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runStreamImportCmd,
+	}
+	cmdStreamImport.Flags().String("archive-id", "", "Import into the storage of the archive node with the given id")
+	cmdStreamImport.Flags().Bool("verify-only", false, "Only verify the bundle, don't import it")
+
+	cmdStream.AddCommand(cmdStreamExport)
+	cmdStream.AddCommand(cmdStreamImport)
+}
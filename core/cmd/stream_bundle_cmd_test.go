@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func makeTestStreamBundle(t *testing.T, numMiniblocks int) *protocol.StreamBundle {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId := testutils.FakeStreamId(shared.STREAM_USER_SETTINGS_BIN)
+
+	inception, err := events.MakeParsedEventWithPayload(
+		wallet,
+		events.Make_UserSettingsPayload_Inception(streamId, nil),
+		&shared.MiniblockRef{},
+	)
+	require.NoError(err)
+	genesis, err := events.MakeGenesisMiniblock(wallet, []*events.ParsedEvent{inception})
+	require.NoError(err)
+
+	bundle := &protocol.StreamBundle{
+		StreamId:   streamId[:],
+		Nodes:      [][]byte{wallet.Address.Bytes()},
+		Miniblocks: []*protocol.Miniblock{genesis},
+	}
+	prev := common.BytesToHash(genesis.Header.Hash)
+	for i := int64(1); i < int64(numMiniblocks); i++ {
+		header, err := events.MakeEnvelopeWithPayload(
+			wallet,
+			events.Make_MiniblockHeader(&protocol.MiniblockHeader{
+				MiniblockNum:      i,
+				PrevMiniblockHash: prev[:],
+				Timestamp:         timestamppb.New(time.Now()),
+			}),
+			&shared.MiniblockRef{Hash: prev, Num: i - 1},
+		)
+		require.NoError(err)
+		bundle.Miniblocks = append(bundle.Miniblocks, &protocol.Miniblock{Header: header})
+		prev = common.BytesToHash(header.Hash)
+	}
+	bundle.LastMiniblockNum = int64(numMiniblocks - 1)
+	bundle.LastMiniblockHash = prev[:]
+	return bundle
+}
+
+func TestStreamBundleExportImport(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+
+	bundle := makeTestStreamBundle(t, 3)
+	streamId, err := shared.StreamIdFromBytes(bundle.StreamId)
+	require.NoError(err)
+
+	_, err = verifyStreamBundle(bundle)
+	require.NoError(err)
+
+	for _, archive := range []bool{false, true} {
+		store, err := storage.NewPebbleStreamStore(ctx, t.TempDir(), time.Minute)
+		require.NoError(err)
+		defer store.Close(ctx)
+
+		require.NoError(importStreamBundle(ctx, store, bundle, archive))
+
+		if !archive {
+			// Stream node storage is left consistent, so the stream can be loaded.
+			res, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 10)
+			require.NoError(err)
+			require.Len(res.Miniblocks, 3)
+			require.Empty(res.MinipoolEnvelopes)
+		}
+
+		exported, err := exportStreamBundle(
+			ctx,
+			store,
+			streamId,
+			[]common.Address{common.BytesToAddress(bundle.Nodes[0])},
+		)
+		require.NoError(err)
+		require.True(proto.Equal(bundle, exported))
+	}
+}
+
+func TestStreamBundleVerification(t *testing.T) {
+	require := require.New(t)
+
+	// Tampered event.
+	bundle := makeTestStreamBundle(t, 3)
+	bundle.Miniblocks[1].Header.Signature[0] ^= 0xff
+	_, err := verifyStreamBundle(bundle)
+	require.Error(err)
+
+	// Missing miniblock.
+	bundle = makeTestStreamBundle(t, 3)
+	bundle.Miniblocks = append(bundle.Miniblocks[:1], bundle.Miniblocks[2:]...)
+	_, err = verifyStreamBundle(bundle)
+	require.Equal(protocol.Err_BAD_BLOCK, base.AsRiverError(err).Code)
+
+	bundle.LastMiniblockNum = 1
+	_, err = verifyStreamBundle(bundle)
+	require.Equal(protocol.Err_BAD_BLOCK_NUMBER, base.AsRiverError(err).Code)
+
+	// Wrong last miniblock hash.
+	bundle = makeTestStreamBundle(t, 2)
+	bundle.LastMiniblockHash = bundle.Miniblocks[0].Header.Hash
+	_, err = verifyStreamBundle(bundle)
+	require.Equal(protocol.Err_BAD_BLOCK, base.AsRiverError(err).Code)
+
+	// Empty bundle.
+	bundle = makeTestStreamBundle(t, 2)
+	bundle.Miniblocks = nil
+	bundle.LastMiniblockNum = -1
+	_, err = verifyStreamBundle(bundle)
+	require.Equal(protocol.Err_BAD_BLOCK, base.AsRiverError(err).Code)
+}
@@ -585,5 +585,7 @@ max-block-range is optional and limits the number of blocks to consider (default
 	cmdStream.AddCommand(cmdStreamDump)
 	cmdStream.AddCommand(cmdStreamNodeDump)
 	cmdStream.AddCommand(cmdStreamGet)
+	addStreamBundleCommands(cmdStream)
+	addStreamRebalanceCommands(cmdStream)
 	rootCmd.AddCommand(cmdStream)
 }
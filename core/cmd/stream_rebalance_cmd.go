@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/towns-protocol/towns/core/contracts/river"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/rebalance"
+	"github.com/towns-protocol/towns/core/node/registries"
+)
+
+func runStreamRebalancePlanCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+
+	blockchain, err := crypto.NewBlockchain(
+		ctx,
+		&cmdConfig.RiverChain,
+		nil,
+		infra.NewMetricsFactory(nil, "river", "cmdline"),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	registryContract, err := registries.NewRiverRegistryContract(
+		ctx,
+		blockchain,
+		&cmdConfig.RegistryContract,
+		&cmdConfig.RiverRegistry,
+	)
+	if err != nil {
+		return err
+	}
+
+	chainConfig, err := crypto.NewOnChainConfig(
+		ctx,
+		blockchain.Client,
+		cmdConfig.RegistryContract.Address,
+		blockchain.InitialBlockNum,
+		blockchain.ChainMonitor,
+	)
+	if err != nil {
+		return err
+	}
+
+	nodeRecords, err := registryContract.GetAllNodes(ctx, blockchain.InitialBlockNum)
+	if err != nil {
+		return err
+	}
+	var operational []common.Address
+	for _, n := range nodeRecords {
+		if n.Status == river.NodeStatus_Operational {
+			operational = append(operational, n.NodeAddress)
+		}
+	}
+
+	plan, err := rebalance.ComputePlan(
+		ctx,
+		registryContract,
+		blockchain.InitialBlockNum,
+		operational,
+		chainConfig.Get(),
+	)
+	if err != nil {
+		return err
+	}
+
+	summary, _ := cmd.Flags().GetBool("summary")
+	if summary {
+		fmt.Printf("Block: %d\nStreams: %d\nMoves: %d\n", plan.BlockNum, plan.NumStreams, len(plan.Moves))
+		steps := make(map[common.Address]int)
+		for _, move := range plan.Moves {
+			for _, step := range move.Steps {
+				steps[step.Executor]++
+			}
+		}
+		for node, n := range steps {
+			fmt.Printf("  %s: %d steps\n", node.Hex(), n)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func addStreamRebalanceCommands(cmdStream *cobra.Command) {
+	cmdStreamRebalancePlan := &cobra.Command{
+		Use:   "rebalance-plan",
+		Short: "Print stream moves required to reach the target stream placement",
+		Long: `Compute the target placement of all streams registered in the river registry with the
+placement strategy from the on-chain configuration and print the moves the rebalancer would make.
+Nothing is changed, the plan is for review before enabling the rebalancer on the nodes.`,
+		Args: cobra.NoArgs,
+		RunE: runStreamRebalancePlanCmd,
+	}
+	cmdStreamRebalancePlan.Flags().Bool("summary", false, "Print only the number of moves per node")
+
+	cmdStream.AddCommand(cmdStreamRebalancePlan)
+}
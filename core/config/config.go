@@ -43,6 +43,10 @@ func GetDefaultConfig() *Config {
 			OnlineWorkerPoolSize:  32,
 			GetMiniblocksPageSize: 128,
 		},
+		StreamRebalancer: StreamRebalancerConfig{
+			MovesPerMinute: 10,
+			Delay:          time.Minute,
+		},
 		Log: LogConfig{
 			Level:   "info", // NOTE: this default is replaced by flag value
 			Console: true,   // NOTE: this default is replaced by flag value
@@ -119,6 +123,21 @@ type Config struct {
 	// Stream reconciliation
 	StreamReconciliation StreamReconciliationConfig
 
+	// Stream rebalancing when nodes join or leave the registry
+	StreamRebalancer StreamRebalancerConfig
+
+	// Search index over unencrypted event metadata
+	EventIndex EventIndexConfig
+
+	// Retention of AddEvent outcomes for requests with an idempotency key
+	AddEventIdempotency AddEventIdempotencyConfig
+
+	// Enforcement of app permissions and rate limits for events added by registered apps
+	AppPermissions AppPermissionsConfig
+
+	// Stream sync sessions
+	StreamSync StreamSyncConfig
+
 	// Network configuration
 	Network NetworkConfig
 
@@ -239,6 +258,10 @@ type DatabaseConfig struct {
 
 	// DebugTransactions enables tracking of few last transactions in the database.
 	DebugTransactions bool
+
+	// DataDir is the directory where the embedded storage keeps its data when StorageType is "pebble".
+	// Not used by Postgres storage.
+	DataDir string
 }
 
 func (c DatabaseConfig) GetUrl() string {
@@ -361,6 +384,31 @@ type ArchiveConfig struct {
 	// allow before considering a stream corrupt.
 	// Please access with GetMaxFailedConsecutiveUpdates
 	MaxFailedConsecutiveUpdates uint32 `json:",omitempty"` // If 0, default to 50.
+
+	// ColdTier configures offloading of sealed miniblock ranges from the archive database
+	// into immutable segment files.
+	ColdTier ColdTierConfig `json:",omitempty"`
+}
+
+type ColdTierConfig struct {
+	// Directory where segment files are stored. It can be a local directory or a mounted
+	// S3-compatible bucket. Offloading is disabled if empty.
+	Directory string `json:",omitempty"`
+
+	// SegmentSize is the number of miniblocks in a single segment file.
+	// Please access with GetSegmentSize
+	SegmentSize int64 `json:",omitempty"` // If 0, default to 1000.
+}
+
+func (c *ColdTierConfig) Enabled() bool {
+	return c.Directory != ""
+}
+
+func (c *ColdTierConfig) GetSegmentSize() int64 {
+	if c.SegmentSize <= 0 {
+		return 1000
+	}
+	return c.SegmentSize
 }
 
 type APNPushNotificationsConfig struct {
@@ -377,6 +425,18 @@ type APNPushNotificationsConfig struct {
 	AuthKey string `json:"-" yaml:"-"` // Omit sensitive field from logging
 }
 
+type FCMPushNotificationsConfig struct {
+	// ProjectID is the Firebase project notifications are sent through.
+	// If empty the project of the service account is used.
+	ProjectID string
+	// Expiration holds the duration in which the notification must be delivered. After that
+	// FCM might drop the notification. If set to 0 a default of 12 hours is used.
+	Expiration time.Duration
+	// ServiceAccount contains the JSON encoded service account key used to authenticate the notification
+	// service with FCM. FCM notifications are not sent if empty.
+	ServiceAccount string `json:"-" yaml:"-"` // Omit sensitive field from logging
+}
+
 type WebPushVapidNotificationConfig struct {
 	// PrivateKey is the private key of the public key that is shared with the client
 	// and used to sign push notifications that allows the client to verify the incoming
@@ -424,11 +484,174 @@ type NotificationsConfig struct {
 	Simulate bool
 	// APN holds the Apple Push Notification settings
 	APN APNPushNotificationsConfig
+	// FCM holds the Firebase Cloud Messaging settings
+	FCM FCMPushNotificationsConfig
 	// Web holds the Web Push notification settings
 	Web WebPushNotificationConfig `mapstructure:"webpush"`
 
 	// Authentication holds configuration for the Client API authentication service.
 	Authentication AuthenticationConfig
+
+	// Outbox holds the settings for the delivery of notifications from the persistent outbox.
+	Outbox NotificationOutboxConfig
+
+	// Digest holds the settings for space channels that users have set to digest mode.
+	Digest NotificationDigestConfig
+
+	// Sharding holds the settings to split the streams that are tracked over multiple notification
+	// service instances.
+	Sharding NotificationShardingConfig
+}
+
+type NotificationShardingConfig struct {
+	// Shards is the number of shards streams are divided in by the hash of their id. Each shard is
+	// held by one notification service instance at a time. All instances must use the same number
+	// of shards. If 0, sharding is disabled and the instance tracks all streams.
+	Shards int `json:",omitempty"`
+
+	// InstanceID identifies this instance in the shard leases. It must be unique over all
+	// instances. If empty, a random id is generated at startup.
+	InstanceID string `json:",omitempty"`
+
+	// LeaseDuration is how long a shard lease is valid without being renewed. Shards of an
+	// instance that stopped are taken over by other instances after their lease expired.
+	// Please access with GetLeaseDuration
+	LeaseDuration time.Duration `json:",omitempty"` // If 0, default to 30 seconds.
+}
+
+func (c *NotificationShardingConfig) GetLeaseDuration() time.Duration {
+	if c.LeaseDuration <= 0 {
+		return 30 * time.Second
+	}
+	return c.LeaseDuration
+}
+
+type NotificationDigestConfig struct {
+	// Window is how long notifications for a channel in digest mode are collected before a single
+	// notification for all collected messages is sent.
+	// Please access with GetWindow
+	Window time.Duration `json:",omitempty"` // If 0, default to 2 minutes.
+}
+
+func (c *NotificationDigestConfig) GetWindow() time.Duration {
+	if c.Window <= 0 {
+		return 2 * time.Minute
+	}
+	return c.Window
+}
+
+type NotificationOutboxConfig struct {
+	// PollInterval is how often the outbox is checked for notifications that are due.
+	// Please access with GetPollInterval
+	PollInterval time.Duration `json:",omitempty"` // If 0, default to 1 second.
+
+	// BatchSize is the number of notifications claimed from the outbox at once for each provider.
+	// Please access with GetBatchSize
+	BatchSize int `json:",omitempty"` // If 0, default to 100.
+
+	// Lease is how long claimed notifications are not claimed again, it must be longer than a delivery attempt.
+	// Please access with GetLease
+	Lease time.Duration `json:",omitempty"` // If 0, default to 1 minute.
+
+	// MaxAttempts is the number of delivery attempts before a notification is dead lettered.
+	// Please access with GetMaxAttempts
+	MaxAttempts int `json:",omitempty"` // If 0, default to 10.
+
+	// InitialBackoff is the delay before the first retry, it doubles with each attempt up to MaxBackoff.
+	// Please access with GetInitialBackoff
+	InitialBackoff time.Duration `json:",omitempty"` // If 0, default to 5 seconds.
+
+	// MaxBackoff is the maximum delay between retries.
+	// Please access with GetMaxBackoff
+	MaxBackoff time.Duration `json:",omitempty"` // If 0, default to 30 minutes.
+
+	// CircuitBreakerThreshold is the number of consecutive failed deliveries after which
+	// delivery through a provider is paused.
+	// Please access with GetCircuitBreakerThreshold
+	CircuitBreakerThreshold int `json:",omitempty"` // If 0, default to 20.
+
+	// CircuitBreakerCooldown is how long delivery through a provider is paused.
+	// Please access with GetCircuitBreakerCooldown
+	CircuitBreakerCooldown time.Duration `json:",omitempty"` // If 0, default to 30 seconds.
+
+	// DeadLetterRetention is how long dead lettered notifications are kept for inspection before they are deleted.
+	// Please access with GetDeadLetterRetention
+	DeadLetterRetention time.Duration `json:",omitempty"` // If 0, default to 7 days.
+
+	// DeliveredRetention is how long delivered notifications are kept to ignore notifications for the same event
+	// and subscription that are enqueued again, it must be longer than the shard handover window.
+	// Please access with GetDeliveredRetention
+	DeliveredRetention time.Duration `json:",omitempty"` // If 0, default to 1 hour.
+}
+
+func (c *NotificationOutboxConfig) GetPollInterval() time.Duration {
+	if c.PollInterval <= 0 {
+		return time.Second
+	}
+	return c.PollInterval
+}
+
+func (c *NotificationOutboxConfig) GetBatchSize() int {
+	if c.BatchSize <= 0 {
+		return 100
+	}
+	return c.BatchSize
+}
+
+func (c *NotificationOutboxConfig) GetLease() time.Duration {
+	if c.Lease <= 0 {
+		return time.Minute
+	}
+	return c.Lease
+}
+
+func (c *NotificationOutboxConfig) GetMaxAttempts() int {
+	if c.MaxAttempts <= 0 {
+		return 10
+	}
+	return c.MaxAttempts
+}
+
+func (c *NotificationOutboxConfig) GetInitialBackoff() time.Duration {
+	if c.InitialBackoff <= 0 {
+		return 5 * time.Second
+	}
+	return c.InitialBackoff
+}
+
+func (c *NotificationOutboxConfig) GetMaxBackoff() time.Duration {
+	if c.MaxBackoff <= 0 {
+		return 30 * time.Minute
+	}
+	return c.MaxBackoff
+}
+
+func (c *NotificationOutboxConfig) GetCircuitBreakerThreshold() int {
+	if c.CircuitBreakerThreshold <= 0 {
+		return 20
+	}
+	return c.CircuitBreakerThreshold
+}
+
+func (c *NotificationOutboxConfig) GetCircuitBreakerCooldown() time.Duration {
+	if c.CircuitBreakerCooldown <= 0 {
+		return 30 * time.Second
+	}
+	return c.CircuitBreakerCooldown
+}
+
+func (c *NotificationOutboxConfig) GetDeadLetterRetention() time.Duration {
+	if c.DeadLetterRetention <= 0 {
+		return 7 * 24 * time.Hour
+	}
+	return c.DeadLetterRetention
+}
+
+func (c *NotificationOutboxConfig) GetDeliveredRetention() time.Duration {
+	if c.DeliveredRetention <= 0 {
+		return time.Hour
+	}
+	return c.DeliveredRetention
 }
 
 type AppRegistryConfig struct {
@@ -448,6 +671,123 @@ type AppRegistryConfig struct {
 	// was added for local/unit testing only and ishould not be used in production environments,
 	// in order to prevent server side request forgery attacks.
 	AllowLoopbackWebhooks bool
+
+	// WebhookDelivery holds the settings for delivering channel messages to app webhooks.
+	WebhookDelivery AppWebhookDeliveryConfig
+
+	// SecretRotationGracePeriod is how long requests to an app service are also signed with the previous
+	// shared secret after the secret was rotated.
+	// Please access with GetSecretRotationGracePeriod
+	SecretRotationGracePeriod time.Duration `json:",omitempty"` // If 0, default to 24 hours.
+}
+
+func (c *AppRegistryConfig) GetSecretRotationGracePeriod() time.Duration {
+	if c.SecretRotationGracePeriod <= 0 {
+		return 24 * time.Hour
+	}
+	return c.SecretRotationGracePeriod
+}
+
+type AppWebhookDeliveryConfig struct {
+	// PollInterval is how often the queue is checked for events that are due.
+	// Please access with GetPollInterval
+	PollInterval time.Duration `json:",omitempty"` // If 0, default to 1 second.
+
+	// BatchSize is the number of events claimed from the queue at once.
+	// Please access with GetBatchSize
+	BatchSize int `json:",omitempty"` // If 0, default to 100.
+
+	// Timeout is the maximum duration of a webhook call.
+	// Please access with GetTimeout
+	Timeout time.Duration `json:",omitempty"` // If 0, default to 10 seconds.
+
+	// MaxAttempts is the number of delivery attempts before an event is dropped.
+	// Please access with GetMaxAttempts
+	MaxAttempts int `json:",omitempty"` // If 0, default to 10.
+
+	// InitialBackoff is the delay before the first retry, it doubles with each attempt up to MaxBackoff.
+	// Please access with GetInitialBackoff
+	InitialBackoff time.Duration `json:",omitempty"` // If 0, default to 1 second.
+
+	// MaxBackoff is the maximum delay between retries.
+	// Please access with GetMaxBackoff
+	MaxBackoff time.Duration `json:",omitempty"` // If 0, default to 5 minutes.
+
+	// LogRetention is how long delivery attempts are kept in the delivery log of an app.
+	// Please access with GetLogRetention
+	LogRetention time.Duration `json:",omitempty"` // If 0, default to 7 days.
+
+	// KeyWaitTimeout is how long an encrypted event is held until the app received the session keys
+	// to decrypt it. Events that are held longer are dropped.
+	// Please access with GetKeyWaitTimeout
+	KeyWaitTimeout time.Duration `json:",omitempty"` // If 0, default to 5 minutes.
+
+	// SessionKeyRetention is how long the sessions that were sent to an app are remembered.
+	// Please access with GetSessionKeyRetention
+	SessionKeyRetention time.Duration `json:",omitempty"` // If 0, default to 30 days.
+}
+
+func (c *AppWebhookDeliveryConfig) GetPollInterval() time.Duration {
+	if c.PollInterval <= 0 {
+		return time.Second
+	}
+	return c.PollInterval
+}
+
+func (c *AppWebhookDeliveryConfig) GetBatchSize() int {
+	if c.BatchSize <= 0 {
+		return 100
+	}
+	return c.BatchSize
+}
+
+func (c *AppWebhookDeliveryConfig) GetTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return 10 * time.Second
+	}
+	return c.Timeout
+}
+
+func (c *AppWebhookDeliveryConfig) GetMaxAttempts() int {
+	if c.MaxAttempts <= 0 {
+		return 10
+	}
+	return c.MaxAttempts
+}
+
+func (c *AppWebhookDeliveryConfig) GetInitialBackoff() time.Duration {
+	if c.InitialBackoff <= 0 {
+		return time.Second
+	}
+	return c.InitialBackoff
+}
+
+func (c *AppWebhookDeliveryConfig) GetMaxBackoff() time.Duration {
+	if c.MaxBackoff <= 0 {
+		return 5 * time.Minute
+	}
+	return c.MaxBackoff
+}
+
+func (c *AppWebhookDeliveryConfig) GetLogRetention() time.Duration {
+	if c.LogRetention <= 0 {
+		return 7 * 24 * time.Hour
+	}
+	return c.LogRetention
+}
+
+func (c *AppWebhookDeliveryConfig) GetKeyWaitTimeout() time.Duration {
+	if c.KeyWaitTimeout <= 0 {
+		return 5 * time.Minute
+	}
+	return c.KeyWaitTimeout
+}
+
+func (c *AppWebhookDeliveryConfig) GetSessionKeyRetention() time.Duration {
+	if c.SessionKeyRetention <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return c.SessionKeyRetention
 }
 
 type LogConfig struct {
@@ -486,6 +826,9 @@ type DebugEndpointsConfig struct {
 	TxPool          bool
 	CorruptStreams  bool
 
+	// NotificationOutbox shows the state of the notification outbox in notification mode.
+	NotificationOutbox bool
+
 	// Make storage statistics available via debug endpoints. This may involve running queries
 	// on the underlying database.
 	EnableStorageEndpoint bool
@@ -578,6 +921,204 @@ type StreamReconciliationConfig struct {
 	GetMiniblocksPageSize int64
 }
 
+// StreamRebalancerConfig configures moving of streams between nodes when nodes join or leave the registry.
+// Each node moves streams to itself: it replicates miniblocks from the current placement and then
+// adds itself to the on-chain stream placement.
+type StreamRebalancerConfig struct {
+	// Enabled turns on the rebalancer on this node.
+	Enabled bool
+
+	// DryRun only logs the rebalancing plan, streams are not moved.
+	DryRun bool
+
+	// MovesPerMinute limits how many stream moves this node executes per minute.
+	MovesPerMinute int
+
+	// Delay is how long the rebalancer waits after a node registry change before planning,
+	// so changes made in a short period of time are handled together.
+	Delay time.Duration
+}
+
+func (c *StreamRebalancerConfig) GetMovesPerMinute() int {
+	if c.MovesPerMinute <= 0 {
+		return 10
+	}
+	return c.MovesPerMinute
+}
+
+// EventIndexConfig configures the search index over envelope and plaintext payload fields of the events
+// of local streams. Encrypted content is not indexed.
+type EventIndexConfig struct {
+	// Enabled turns on indexing of the events in new miniblocks and the SearchEvents RPC.
+	Enabled bool
+
+	// QueueSize is the number of miniblocks waiting to be indexed.
+	// Miniblocks are not indexed if the queue is full.
+	// Please access with GetQueueSize
+	QueueSize int `json:",omitempty"` // If 0, default to 10000.
+
+	// MaxResults limits the number of events returned by a single SearchEvents call.
+	// Please access with GetMaxResults
+	MaxResults int `json:",omitempty"` // If 0, default to 1000.
+
+	// Authentication holds configuration for the authentication service that issues session tokens
+	// for SearchEvents. Only members of the searched stream can search its events.
+	Authentication AuthenticationConfig
+
+	// Admins are the addresses of users that can search the events of all streams on the node.
+	// Please access with IsAdmin
+	Admins []string
+}
+
+// IsAdmin returns true if the user can search events without being a member of the searched streams.
+func (c *EventIndexConfig) IsAdmin(user common.Address) bool {
+	for _, admin := range c.Admins {
+		if common.HexToAddress(admin) == user {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *EventIndexConfig) GetQueueSize() int {
+	if c.QueueSize <= 0 {
+		return 10000
+	}
+	return c.QueueSize
+}
+
+func (c *EventIndexConfig) GetMaxResults() int {
+	if c.MaxResults <= 0 {
+		return 1000
+	}
+	return c.MaxResults
+}
+
+type AddEventIdempotencyConfig struct {
+	// CacheSize is the maximum number of AddEvent outcomes retained for retries.
+	// Please access with GetCacheSize
+	CacheSize int `json:",omitempty"` // If 0, default to 100000.
+
+	// TTL is how long the outcome of an AddEvent request with an idempotency key is retained.
+	// Please access with GetTTL
+	TTL time.Duration `json:",omitempty"` // If 0, default to 10 minutes.
+}
+
+func (c *AddEventIdempotencyConfig) GetCacheSize() int {
+	if c.CacheSize <= 0 {
+		return 100000
+	}
+	return c.CacheSize
+}
+
+func (c *AddEventIdempotencyConfig) GetTTL() time.Duration {
+	if c.TTL <= 0 {
+		return 10 * time.Minute
+	}
+	return c.TTL
+}
+
+type AppPermissionsConfig struct {
+	// AppRegistryUrl is the url of the app registry service the permissions of apps are fetched from.
+	// App permissions and rate limits are not enforced if empty.
+	AppRegistryUrl string
+
+	// CacheDuration is how long the permissions of an app are cached and how often the set of registered
+	// apps is refreshed.
+	// Please access with GetCacheDuration
+	CacheDuration time.Duration `json:",omitempty"` // If 0, default to 1 minute.
+
+	// LookupTimeout is how long the app registry is waited for when the permissions of an app are fetched.
+	// Please access with GetLookupTimeout
+	LookupTimeout time.Duration `json:",omitempty"` // If 0, default to 2 seconds.
+
+	// EventsPerSecond is the rate at which each app can add events.
+	// Please access with GetEventsPerSecond
+	EventsPerSecond float64 `json:",omitempty"` // If 0, default to 10.
+
+	// Burst is the number of events an app can add at once before it is rate limited.
+	// Please access with GetBurst
+	Burst int `json:",omitempty"` // If 0, default to 50.
+}
+
+func (c *AppPermissionsConfig) GetCacheDuration() time.Duration {
+	if c.CacheDuration <= 0 {
+		return time.Minute
+	}
+	return c.CacheDuration
+}
+
+func (c *AppPermissionsConfig) GetLookupTimeout() time.Duration {
+	if c.LookupTimeout <= 0 {
+		return 2 * time.Second
+	}
+	return c.LookupTimeout
+}
+
+func (c *AppPermissionsConfig) GetEventsPerSecond() float64 {
+	if c.EventsPerSecond <= 0 {
+		return 10
+	}
+	return c.EventsPerSecond
+}
+
+func (c *AppPermissionsConfig) GetBurst() int {
+	if c.Burst <= 0 {
+		return 50
+	}
+	return c.Burst
+}
+
+type StreamSyncConfig struct {
+	// ResumeGracePeriod is how long a resumable sync session is kept after the client disconnected.
+	// Please access with GetResumeGracePeriod
+	ResumeGracePeriod time.Duration `json:",omitempty"` // If 0, default to 30 seconds.
+
+	// ResumeBufferSize is the number of updates a resumable sync session keeps to replay them when
+	// the client resumes the session. A disconnected session is dropped when its buffer overflows.
+	// Please access with GetResumeBufferSize
+	ResumeBufferSize int `json:",omitempty"` // If 0, default to 1024.
+
+	// ResumeBufferBytes is the max total size of the updates a resumable sync session keeps to replay them
+	// when the client resumes the session. Older updates are dropped first.
+	// Please access with GetResumeBufferBytes
+	ResumeBufferBytes int `json:",omitempty"` // If 0, default to 8 MiB.
+
+	// SessionBufferBytes is the max size of the updates that are buffered for a client that doesn't keep up with
+	// its sync session. Streams with pending updates are reset when the buffer is full, the session is cancelled
+	// if that doesn't free enough space.
+	// Please access with GetSessionBufferBytes
+	SessionBufferBytes int `json:",omitempty"` // If 0, default to 16 MiB.
+}
+
+func (c *StreamSyncConfig) GetResumeGracePeriod() time.Duration {
+	if c.ResumeGracePeriod <= 0 {
+		return 30 * time.Second
+	}
+	return c.ResumeGracePeriod
+}
+
+func (c *StreamSyncConfig) GetResumeBufferSize() int {
+	if c.ResumeBufferSize <= 0 {
+		return 1024
+	}
+	return c.ResumeBufferSize
+}
+
+func (c *StreamSyncConfig) GetResumeBufferBytes() int {
+	if c.ResumeBufferBytes <= 0 {
+		return 8 * 1024 * 1024
+	}
+	return c.ResumeBufferBytes
+}
+
+func (c *StreamSyncConfig) GetSessionBufferBytes() int {
+	if c.SessionBufferBytes <= 0 {
+		return 16 * 1024 * 1024
+	}
+	return c.SessionBufferBytes
+}
+
 type FilterConfig struct {
 	// If set, only archive streams hosted on the nodes with the specified addresses.
 	Nodes []string
@@ -68,6 +68,9 @@ func TestNotificationsConfig_SensitiveKeysDontLog(t *testing.T) {
 		APN: config.APNPushNotificationsConfig{
 			AuthKey: "APN_AUTH_KEY",
 		},
+		FCM: config.FCMPushNotificationsConfig{
+			ServiceAccount: "FCM_SERVICE_ACCOUNT",
+		},
 		Web: config.WebPushNotificationConfig{
 			Vapid: config.WebPushVapidNotificationConfig{
 				PrivateKey: "WEB_VAPID_PRIVATE_KEY",
@@ -91,6 +94,12 @@ func TestNotificationsConfig_SensitiveKeysDontLog(t *testing.T) {
 	require := require.New(t)
 
 	require.NotContains(logOutput, "APN_AUTH_KEY", "Expected APN_AUTH_KEY to be omitted from logOutput `%v`", logOutput)
+	require.NotContains(
+		logOutput,
+		"FCM_SERVICE_ACCOUNT",
+		"Expected FCM_SERVICE_ACCOUNT to be omitted from logOutput `%v`",
+		logOutput,
+	)
 	require.NotContains(
 		logOutput,
 		"WEB_VAPID_PRIVATE_KEY",
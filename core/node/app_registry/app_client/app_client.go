@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -11,13 +12,37 @@ import (
 	"github.com/towns-protocol/towns/core/node/protocol"
 )
 
+// maxResponseBodySize is the maximum number of bytes read from a webhook response.
+const maxResponseBodySize = 64 * 1024
+
 type InitializeData struct{}
 
+// EventData is sent with the "event" command for each message that is added to a channel the app is a member of.
+type EventData struct {
+	// StreamId is the hex encoded id of the channel the event was added to.
+	StreamId string `json:"streamId"`
+	// SpaceId is the hex encoded id of the space the channel belongs to, empty for DMs and GDMs.
+	SpaceId string `json:"spaceId,omitempty"`
+	// EventHash is the hex encoded hash of the event.
+	EventHash string `json:"eventHash"`
+	// Envelope is the protobuf encoded event envelope.
+	Envelope []byte `json:"envelope"`
+}
+
 type AppServiceRequestPayload struct {
 	Command string `json:"command"`
 	Data    any    `json:",omitempty"`
 }
 
+// SigningSecrets are the shared secrets requests to an app service are signed with.
+type SigningSecrets struct {
+	// Current is the current shared secret of the app, the Authorization header is signed with it.
+	Current [32]byte
+	// Previous is the shared secret before the last rotation and only set during the grace period after the
+	// rotation, the X-Previous-Authorization header is signed with it.
+	Previous *[32]byte
+}
+
 type AppClient struct {
 	httpClient *http.Client
 }
@@ -33,14 +58,14 @@ func NewAppClient(httpClient *http.Client, allowLoopback bool) *AppClient {
 
 // InitializeWebhook calls "initialize" on an app service specified by the webhook url
 // with a jwt token included in the request header that was generated from the shared
-// secret returned to the app upon registration. The caller should verify that we can
-// see a device_id and fallback key in the user stream that matches the device id and
+// secret returned to the app upon registration, see SendEvent for secret rotations. The caller should verify that
+// we can see a device_id and fallback key in the user stream that matches the device id and
 // fallback key returned in the status message.
 func (b *AppClient) InitializeWebhook(
 	ctx context.Context,
 	webhookUrl string,
 	appId common.Address,
-	hs256SharedSecret [32]byte,
+	secrets SigningSecrets,
 ) error {
 	payload := AppServiceRequestPayload{
 		Command: "initialize",
@@ -52,27 +77,9 @@ func (b *AppClient) InitializeWebhook(
 			Tag("appId", appId)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", webhookUrl, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return base.WrapRiverError(protocol.Err_INTERNAL, err).
-			Message("Error constructing request to initialize webhook").
-			Tag("appId", appId)
-	}
-
-	// Add authorization header based on the shared secret for this app.
-	if err := signRequest(req, hs256SharedSecret[:], appId); err != nil {
-		return base.WrapRiverError(protocol.Err_INTERNAL, err).
-			Message("Error signing request to initialize webhook").
-			Tag("appId", appId)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := b.httpClient.Do(req)
+	resp, err := b.post(ctx, webhookUrl, appId, secrets, jsonData)
 	if err != nil {
-		return base.WrapRiverError(protocol.Err_CANNOT_CALL_WEBHOOK, err).
-			Message("Unable to initialize the webhook").
-			Tag("appId", appId)
+		return err
 	}
 	defer resp.Body.Close()
 
@@ -89,6 +96,73 @@ func (b *AppClient) InitializeWebhook(
 	return nil
 }
 
+// SendEvent posts the given "event" command payload to the app service specified by the webhook url.
+// After a secret rotation the request is also signed with the previous secret in the X-Previous-Authorization
+// header, this allows the app service to verify requests with the previous secret during the grace period.
+// It returns the HTTP status code of the response, or 0 if no response was received.
+func (b *AppClient) SendEvent(
+	ctx context.Context,
+	webhookUrl string,
+	appId common.Address,
+	secrets SigningSecrets,
+	payload []byte,
+) (int, error) {
+	resp, err := b.post(ctx, webhookUrl, appId, secrets, payload)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, base.RiverError(protocol.Err_CANNOT_CALL_WEBHOOK, "Webhook response non-OK status").
+			Tag("appId", appId).
+			Tag("status", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// post sends the json payload to the webhook, signed with the shared secrets of the app.
+func (b *AppClient) post(
+	ctx context.Context,
+	webhookUrl string,
+	appId common.Address,
+	secrets SigningSecrets,
+	jsonData []byte,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", webhookUrl, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, base.WrapRiverError(protocol.Err_INTERNAL, err).
+			Message("Error constructing request to call webhook").
+			Tag("appId", appId)
+	}
+
+	// Add authorization headers based on the shared secrets for this app.
+	if err := signRequest(req, AuthorizationHeader, secrets.Current[:], appId); err != nil {
+		return nil, base.WrapRiverError(protocol.Err_INTERNAL, err).
+			Message("Error signing request to call webhook").
+			Tag("appId", appId)
+	}
+	if secrets.Previous != nil {
+		if err := signRequest(req, PreviousAuthorizationHeader, secrets.Previous[:], appId); err != nil {
+			return nil, base.WrapRiverError(protocol.Err_INTERNAL, err).
+				Message("Error signing request to call webhook").
+				Tag("appId", appId)
+		}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, base.WrapRiverError(protocol.Err_CANNOT_CALL_WEBHOOK, err).
+			Message("Unable to call the webhook").
+			Tag("appId", appId)
+	}
+	return resp, nil
+}
+
 // GetWebhookStatus sends an "info" message to the app service and expects a 200 with
 // version info returned.
 // TODO - implement.
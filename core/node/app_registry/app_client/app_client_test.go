@@ -0,0 +1,73 @@
+package app_client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendEventSignsWithPreviousSecret(t *testing.T) {
+	require := require.New(t)
+
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	validate := func(authorization string, secret [32]byte) {
+		token, err := jwt.Parse(strings.TrimPrefix(authorization, "Bearer "), func(*jwt.Token) (any, error) {
+			return secret[:], nil
+		})
+		require.NoError(err)
+		require.True(token.Valid)
+	}
+
+	client := NewAppClient(server.Client(), true)
+	current := [32]byte{1}
+	status, err := client.SendEvent(
+		context.Background(), server.URL, common.Address{3}, SigningSecrets{Current: current}, []byte("{}"))
+	require.NoError(err)
+	require.Equal(http.StatusAccepted, status)
+	require.Len(headers.Values(AuthorizationHeader), 1)
+	validate(headers.Get(AuthorizationHeader), current)
+	require.Empty(headers.Values(PreviousAuthorizationHeader))
+
+	// after a rotation the previous secret signs a separate header
+	previous := [32]byte{2}
+	status, err = client.SendEvent(
+		context.Background(),
+		server.URL,
+		common.Address{3},
+		SigningSecrets{Current: current, Previous: &previous},
+		[]byte("{}"),
+	)
+	require.NoError(err)
+	require.Equal(http.StatusAccepted, status)
+	require.Len(headers.Values(AuthorizationHeader), 1)
+	validate(headers.Get(AuthorizationHeader), current)
+	require.Len(headers.Values(PreviousAuthorizationHeader), 1)
+	validate(headers.Get(PreviousAuthorizationHeader), previous)
+}
+
+func TestSendEventNonOKStatus(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewAppClient(server.Client(), true)
+	status, err := client.SendEvent(
+		context.Background(), server.URL, common.Address{3}, SigningSecrets{Current: [32]byte{1}}, []byte("{}"))
+	require.Error(err)
+	require.Equal(http.StatusServiceUnavailable, status)
+}
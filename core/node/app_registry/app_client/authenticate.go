@@ -12,7 +12,16 @@ import (
 	"github.com/towns-protocol/towns/core/node/protocol"
 )
 
-func signRequest(req *http.Request, secretKey []byte, appId common.Address) error {
+const (
+	// AuthorizationHeader carries the token that is signed with the current shared secret of the app.
+	AuthorizationHeader = "Authorization"
+	// PreviousAuthorizationHeader carries the token that is signed with the previous shared secret of the app
+	// during the grace period after a secret rotation.
+	PreviousAuthorizationHeader = "X-Previous-Authorization"
+)
+
+// signRequest sets the given header to a jwt token that is signed with the secret key.
+func signRequest(req *http.Request, header string, secretKey []byte, appId common.Address) error {
 	token := jwt.New(jwt.SigningMethodHS256)
 	claims := token.Claims.(jwt.MapClaims)
 	claims["exp"] = time.Now().Add(1 * time.Hour).Unix() // token expires in 1 hour
@@ -28,8 +37,7 @@ func signRequest(req *http.Request, secretKey []byte, appId common.Address) erro
 			Tag("appId", appId)
 	}
 
-	// Attach the token to the Authorization header.
-	req.Header.Set("Authorization", "Bearer "+tokenString)
+	req.Header.Set(header, "Bearer "+tokenString)
 
 	return nil
 }
@@ -0,0 +1,202 @@
+package app_registry
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/time/rate"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
+)
+
+// validateAppPermissions returns an error if the permissions contain unknown scopes or stream types.
+func validateAppPermissions(permissions *AppPermissions) error {
+	if permissions == nil {
+		return nil
+	}
+	for _, scope := range permissions.Scopes {
+		if _, ok := AppScope_name[int32(scope)]; !ok || scope == AppScope_APP_SCOPE_UNSPECIFIED {
+			return base.RiverError(Err_INVALID_ARGUMENT, "invalid app scope", "scope", scope)
+		}
+	}
+	for _, streamType := range permissions.StreamTypes {
+		if _, ok := AppStreamType_name[int32(streamType)]; !ok ||
+			streamType == AppStreamType_APP_STREAM_TYPE_UNSPECIFIED {
+			return base.RiverError(Err_INVALID_ARGUMENT, "invalid app stream type", "streamType", streamType)
+		}
+	}
+	return nil
+}
+
+type (
+	// AppPermissionsCache is used by stream nodes to look up the permissions of event creators in the
+	// app registry and to rate limit the events added by registered apps.
+	//
+	// The set of registered apps is refreshed periodically, permissions are only looked up for creators
+	// in the set. Until the set is loaded no creator is restricted.
+	AppPermissionsCache struct {
+		client protocolconnect.AppRegistryServiceClient
+		cfg    *config.AppPermissionsConfig
+		// apps is the set of registered apps, nil until it is loaded
+		apps atomic.Pointer[mapset.Set[common.Address]]
+
+		mu        sync.Mutex
+		entries   map[common.Address]*appPermissionsEntry
+		lastSweep time.Time
+	}
+
+	appPermissionsEntry struct {
+		expiresAt   time.Time
+		registered  bool
+		permissions *AppPermissions
+		// limiter is kept when the entry is refreshed
+		limiter *rate.Limiter
+	}
+)
+
+// NewAppPermissionsCache creates a cache that fetches app permissions with the given client.
+func NewAppPermissionsCache(
+	cfg *config.AppPermissionsConfig,
+	client protocolconnect.AppRegistryServiceClient,
+) *AppPermissionsCache {
+	return &AppPermissionsCache{
+		client:  client,
+		cfg:     cfg,
+		entries: make(map[common.Address]*appPermissionsEntry),
+	}
+}
+
+// Start loads the set of registered apps and refreshes it in the background until ctx expires.
+func (c *AppPermissionsCache) Start(ctx context.Context) {
+	go c.run(ctx)
+}
+
+func (c *AppPermissionsCache) run(ctx context.Context) {
+	for {
+		if err := c.loadApps(ctx); err != nil && ctx.Err() == nil {
+			logging.FromCtx(ctx).Warnw("Unable to load registered apps", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.cfg.GetCacheDuration()):
+		}
+	}
+}
+
+func (c *AppPermissionsCache) loadApps(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.GetLookupTimeout())
+	defer cancel()
+
+	resp, err := c.client.GetApps(ctx, connect.NewRequest(&GetAppsRequest{}))
+	if err != nil {
+		return err
+	}
+
+	apps := mapset.NewThreadUnsafeSetWithSize[common.Address](len(resp.Msg.AppIds))
+	for _, app := range resp.Msg.AppIds {
+		apps.Add(common.BytesToAddress(app))
+	}
+	c.apps.Store(&apps)
+	return nil
+}
+
+// ForCreator returns the permissions of the event creator if it is a registered app, nil if the creator
+// is not restricted. It returns Err_RESOURCE_EXHAUSTED if the creator is an app that exceeded its rate
+// limit and Err_UNAVAILABLE if the creator is a known app and its permissions can't be fetched.
+//
+// Apps that were registered after the last refresh of the set of registered apps, and all apps before the
+// set is loaded, are not restricted until the next refresh.
+func (c *AppPermissionsCache) ForCreator(ctx context.Context, creator common.Address) (*AppPermissions, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	// without the set of apps every creator would be looked up, don't delay all events
+	apps := c.apps.Load()
+	if apps == nil || !(*apps).Contains(creator) {
+		return nil, nil
+	}
+
+	entry := c.get(creator)
+	if entry == nil {
+		var err error
+		if entry, err = c.fetch(ctx, creator); err != nil {
+			return nil, base.RiverErrorWithBase(Err_UNAVAILABLE, "unable to fetch app permissions", err,
+				"app", creator).Func("ForCreator")
+		}
+	}
+
+	if !entry.registered {
+		return nil, nil
+	}
+
+	if !entry.limiter.Allow() {
+		return nil, base.RiverError(Err_RESOURCE_EXHAUSTED, "app exceeded its event rate limit", "app", creator)
+	}
+
+	return entry.permissions, nil
+}
+
+// get returns the cached entry of the address, nil if there is no entry or it expired.
+func (c *AppPermissionsCache) get(address common.Address) *appPermissionsEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[address]; ok && time.Now().Before(entry.expiresAt) {
+		return entry
+	}
+	return nil
+}
+
+// fetch looks up the permissions of the address in the app registry and caches them. Failed lookups
+// are not cached.
+func (c *AppPermissionsCache) fetch(ctx context.Context, address common.Address) (*appPermissionsEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.GetLookupTimeout())
+	defer cancel()
+
+	resp, err := c.client.GetPermissions(ctx, connect.NewRequest(&GetPermissionsRequest{AppId: address[:]}))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &appPermissionsEntry{
+		registered:  resp.Msg.IsRegistered,
+		permissions: resp.Msg.Permissions,
+	}
+
+	now := time.Now()
+	entry.expiresAt = now.Add(c.cfg.GetCacheDuration())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if previous, ok := c.entries[address]; ok {
+		entry.limiter = previous.limiter
+	}
+	if entry.limiter == nil && entry.registered {
+		entry.limiter = rate.NewLimiter(rate.Limit(c.cfg.GetEventsPerSecond()), c.cfg.GetBurst())
+	}
+
+	if now.Sub(c.lastSweep) > c.cfg.GetCacheDuration() {
+		for a, e := range c.entries {
+			if now.After(e.expiresAt) {
+				delete(c.entries, a)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[address] = entry
+	return entry, nil
+}
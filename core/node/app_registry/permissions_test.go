@@ -0,0 +1,184 @@
+package app_registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
+)
+
+type fakePermissionsClient struct {
+	protocolconnect.AppRegistryServiceClient
+
+	apps  map[common.Address]*AppPermissions
+	err   error
+	calls int
+	delay time.Duration
+}
+
+func (c *fakePermissionsClient) GetApps(
+	_ context.Context,
+	_ *connect.Request[GetAppsRequest],
+) (*connect.Response[GetAppsResponse], error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	var appIds [][]byte
+	for app := range c.apps {
+		appIds = append(appIds, app.Bytes())
+	}
+	return connect.NewResponse(&GetAppsResponse{AppIds: appIds}), nil
+}
+
+func (c *fakePermissionsClient) GetPermissions(
+	ctx context.Context,
+	req *connect.Request[GetPermissionsRequest],
+) (*connect.Response[GetPermissionsResponse], error) {
+	c.calls++
+	if c.delay > 0 {
+		select {
+		case <-time.After(c.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	permissions, ok := c.apps[common.BytesToAddress(req.Msg.AppId)]
+	return connect.NewResponse(&GetPermissionsResponse{IsRegistered: ok, Permissions: permissions}), nil
+}
+
+func TestAppPermissionsCache(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	app := common.Address{1}
+	legacyApp := common.Address{2}
+	user := common.Address{3}
+	otherApp := common.Address{4}
+	permissions := &AppPermissions{Scopes: []AppScope{AppScope_APP_SCOPE_POST_MESSAGES}}
+
+	client := &fakePermissionsClient{
+		apps: map[common.Address]*AppPermissions{app: permissions, legacyApp: nil, otherApp: permissions},
+	}
+	cache := NewAppPermissionsCache(&config.AppPermissionsConfig{EventsPerSecond: 0.001, Burst: 2}, client)
+
+	// permissions are not enforced and not looked up before the apps are loaded
+	got, err := cache.ForCreator(ctx, app)
+	require.NoError(err)
+	require.Nil(got)
+	require.Equal(0, client.calls)
+
+	require.NoError(cache.loadApps(ctx))
+
+	got, err = cache.ForCreator(ctx, user)
+	require.NoError(err)
+	require.Nil(got)
+
+	got, err = cache.ForCreator(ctx, legacyApp)
+	require.NoError(err)
+	require.Nil(got)
+
+	// apps are rate limited after the burst
+	for range 2 {
+		got, err = cache.ForCreator(ctx, app)
+		require.NoError(err)
+		require.True(got.HasScope(AppScope_APP_SCOPE_POST_MESSAGES))
+		require.False(got.HasScope(AppScope_APP_SCOPE_REACT))
+	}
+	_, err = cache.ForCreator(ctx, app)
+	require.True(base.IsRiverErrorCode(err, Err_RESOURCE_EXHAUSTED))
+
+	// users are not rate limited and lookups are cached
+	for range 10 {
+		_, err = cache.ForCreator(ctx, user)
+		require.NoError(err)
+	}
+	require.Equal(2, client.calls)
+
+	// failed lookups are not cached
+	client.err = errors.New("unavailable")
+	_, err = cache.ForCreator(ctx, otherApp)
+	require.True(base.IsRiverErrorCode(err, Err_UNAVAILABLE))
+
+	client.err = nil
+	calls := client.calls
+	_, err = cache.ForCreator(ctx, otherApp)
+	require.NoError(err)
+	require.Equal(calls+1, client.calls)
+
+	// a nil cache doesn't enforce anything
+	var disabled *AppPermissionsCache
+	got, err = disabled.ForCreator(ctx, app)
+	require.NoError(err)
+	require.Nil(got)
+}
+
+func TestAppPermissionsCacheWithApps(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	app := common.Address{1}
+	otherApp := common.Address{2}
+	user := common.Address{3}
+	permissions := &AppPermissions{Scopes: []AppScope{AppScope_APP_SCOPE_POST_MESSAGES}}
+
+	client := &fakePermissionsClient{
+		apps: map[common.Address]*AppPermissions{app: permissions, otherApp: permissions},
+	}
+	cache := NewAppPermissionsCache(&config.AppPermissionsConfig{LookupTimeout: 50 * time.Millisecond}, client)
+	require.NoError(cache.loadApps(ctx))
+
+	// creators that are not apps are not looked up
+	got, err := cache.ForCreator(ctx, user)
+	require.NoError(err)
+	require.Nil(got)
+	require.Equal(0, client.calls)
+
+	got, err = cache.ForCreator(ctx, app)
+	require.NoError(err)
+	require.Equal(permissions, got)
+	require.Equal(1, client.calls)
+
+	// events of apps are rejected if their permissions can't be fetched
+	client.err = errors.New("unavailable")
+	_, err = cache.ForCreator(ctx, otherApp)
+	require.True(base.IsRiverErrorCode(err, Err_UNAVAILABLE))
+
+	// lookups time out
+	client.err = nil
+	client.delay = time.Second
+	start := time.Now()
+	_, err = cache.ForCreator(ctx, otherApp)
+	require.True(base.IsRiverErrorCode(err, Err_UNAVAILABLE))
+	require.Less(time.Since(start), time.Second)
+
+	// cached permissions are still used
+	got, err = cache.ForCreator(ctx, app)
+	require.NoError(err)
+	require.Equal(permissions, got)
+}
+
+func TestValidateAppPermissions(t *testing.T) {
+	require := require.New(t)
+
+	require.NoError(validateAppPermissions(nil))
+	require.NoError(validateAppPermissions(&AppPermissions{}))
+	require.NoError(validateAppPermissions(&AppPermissions{
+		Scopes:      []AppScope{AppScope_APP_SCOPE_READ_MESSAGES, AppScope_APP_SCOPE_MANAGE_PINS},
+		StreamTypes: []AppStreamType{AppStreamType_APP_STREAM_TYPE_DM},
+	}))
+	require.Error(validateAppPermissions(&AppPermissions{Scopes: []AppScope{AppScope_APP_SCOPE_UNSPECIFIED}}))
+	require.Error(validateAppPermissions(&AppPermissions{Scopes: []AppScope{AppScope(100)}}))
+	require.Error(validateAppPermissions(&AppPermissions{StreamTypes: []AppStreamType{AppStreamType(100)}}))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -28,6 +29,9 @@ import (
 
 const (
 	appServiceChallengePrefix = "AS_AUTH:"
+
+	defaultDeliveryLogLimit = 100
+	maxDeliveryLogLimit     = 1000
 )
 
 type (
@@ -38,6 +42,7 @@ type (
 		streamsTracker                track_streams.StreamsTracker
 		sharedSecretDataEncryptionKey [32]byte
 		appClient                     *app_client.AppClient
+		webhookDispatcher             *WebhookDispatcher
 	}
 )
 
@@ -54,6 +59,26 @@ func NewService(
 	listener track_streams.StreamEventListener,
 	httpClient *http.Client,
 ) (*Service, error) {
+	sharedSecretDataEncryptionKey, err := hex.DecodeString(cfg.SharedSecretDataEncryptionKey)
+	if err != nil || len(sharedSecretDataEncryptionKey) != 32 {
+		return nil, base.AsRiverError(err, Err_INVALID_ARGUMENT).
+			Message("AppRegistryConfig SharedSecretDataEncryptionKey must be a 32-byte key encoded as hex")
+	}
+
+	appClient := app_client.NewAppClient(httpClient, cfg.AllowLoopbackWebhooks)
+	webhookDispatcher := NewWebhookDispatcher(
+		store,
+		&cfg.WebhookDelivery,
+		appClient,
+		[32]byte(sharedSecretDataEncryptionKey),
+		metrics,
+	)
+
+	listeners := streamEventListeners{webhookDispatcher}
+	if listener != nil {
+		listeners = append(listeners, listener)
+	}
+
 	tracker, err := sync.NewAppRegistryStreamsTracker(
 		ctx,
 		cfg,
@@ -61,24 +86,20 @@ func NewService(
 		riverRegistry,
 		nodes,
 		metrics,
-		listener,
+		listeners,
+		webhookDispatcher,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	sharedSecretDataEncryptionKey, err := hex.DecodeString(cfg.SharedSecretDataEncryptionKey)
-	if err != nil || len(sharedSecretDataEncryptionKey) != 32 {
-		return nil, base.AsRiverError(err, Err_INVALID_ARGUMENT).
-			Message("AppRegistryConfig SharedSecretDataEncryptionKey must be a 32-byte key encoded as hex")
-	}
-
 	s := &Service{
 		cfg:                           cfg,
 		store:                         store,
 		streamsTracker:                tracker,
 		sharedSecretDataEncryptionKey: [32]byte(sharedSecretDataEncryptionKey),
-		appClient:                     app_client.NewAppClient(httpClient, cfg.AllowLoopbackWebhooks),
+		appClient:                     appClient,
+		webhookDispatcher:             webhookDispatcher,
 	}
 
 	if err := s.InitAuthentication(appServiceChallengePrefix, &cfg.Authentication); err != nil {
@@ -90,6 +111,8 @@ func NewService(
 func (s *Service) Start(ctx context.Context) {
 	log := logging.FromCtx(ctx)
 
+	s.webhookDispatcher.Start(ctx)
+
 	go func() {
 		for {
 			log.Infow("Start app registry streams tracker")
@@ -141,6 +164,10 @@ func (s *Service) Register(
 		)
 	}
 
+	if err := validateAppPermissions(req.Msg.Permissions); err != nil {
+		return nil, base.AsRiverError(err).Func("Register")
+	}
+
 	// Generate a secret, encrypt it, and store the app record in pg.
 	appSecret, err := genHS256SharedSecret()
 	if err != nil {
@@ -152,9 +179,10 @@ func (s *Service) Register(
 		return nil, base.AsRiverError(err, Err_INTERNAL).Message("error encrypting shared secret for app")
 	}
 
-	if err := s.store.CreateApp(ctx, owner, app, encrypted); err != nil {
+	if err := s.store.CreateApp(ctx, owner, app, encrypted, req.Msg.Permissions); err != nil {
 		return nil, base.AsRiverError(err, Err_INTERNAL).Func("Register")
 	}
+	s.webhookDispatcher.addRegisteredApp(app)
 
 	return &connect.Response[RegisterResponse]{
 		Msg: &RegisterResponse{
@@ -210,20 +238,8 @@ func (s *Service) RegisterWebhook(
 	// - no redirect params allowed in the url either
 	webhook := req.Msg.WebhookUrl
 
-	decryptedSecret, err := decryptSharedSecret(appInfo.EncryptedSecret, s.sharedSecretDataEncryptionKey)
-	if err != nil {
-		return nil, base.WrapRiverError(Err_INTERNAL, err).
-			Message("Unable to decrypt app shared secret from db").
-			Tag("appId", app)
-	}
-
-	if err := s.appClient.InitializeWebhook(
-		ctx,
-		webhook,
-		app,
-		decryptedSecret,
-	); err != nil {
-		return nil, base.WrapRiverError(Err_UNKNOWN, err).Message("Unable to initialize app service")
+	if err := s.initializeWebhook(ctx, appInfo, webhook); err != nil {
+		return nil, err
 	}
 
 	// Store the app record in pg
@@ -276,3 +292,331 @@ func (s *Service) GetStatus(
 		},
 	}, nil
 }
+
+func (s *Service) GetPermissions(
+	ctx context.Context,
+	req *connect.Request[GetPermissionsRequest],
+) (
+	*connect.Response[GetPermissionsResponse],
+	error,
+) {
+	app, err := base.BytesToAddress(req.Msg.AppId)
+	if err != nil {
+		return nil, base.WrapRiverError(Err_INVALID_ARGUMENT, err).
+			Message("invalid app id").
+			Tag("app_id", req.Msg.AppId).
+			Func("GetPermissions")
+	}
+
+	appInfo, err := s.store.GetAppInfo(ctx, app)
+	if err != nil {
+		if base.IsRiverErrorCode(err, Err_NOT_FOUND) {
+			return connect.NewResponse(&GetPermissionsResponse{IsRegistered: false}), nil
+		}
+		return nil, base.WrapRiverError(Err_INTERNAL, err).
+			Message("unable to fetch info for app").
+			Tag("app_id", app).
+			Func("GetPermissions")
+	}
+
+	return connect.NewResponse(&GetPermissionsResponse{
+		IsRegistered: true,
+		Permissions:  appInfo.Permissions,
+	}), nil
+}
+
+func (s *Service) GetApps(
+	ctx context.Context,
+	req *connect.Request[GetAppsRequest],
+) (
+	*connect.Response[GetAppsResponse],
+	error,
+) {
+	apps, err := s.store.GetApps(ctx)
+	if err != nil {
+		return nil, base.WrapRiverError(Err_INTERNAL, err).
+			Message("unable to fetch apps").
+			Func("GetApps")
+	}
+
+	appIds := make([][]byte, len(apps))
+	for i, app := range apps {
+		appIds[i] = app.Bytes()
+	}
+	return connect.NewResponse(&GetAppsResponse{AppIds: appIds}), nil
+}
+
+func (s *Service) GetDeliveryLog(
+	ctx context.Context,
+	req *connect.Request[GetDeliveryLogRequest],
+) (
+	*connect.Response[GetDeliveryLogResponse],
+	error,
+) {
+	app, err := base.BytesToAddress(req.Msg.AppId)
+	if err != nil {
+		return nil, base.WrapRiverError(Err_INVALID_ARGUMENT, err).
+			Message("invalid app id").
+			Tag("app_id", req.Msg.AppId).
+			Func("GetDeliveryLog")
+	}
+
+	appInfo, err := s.store.GetAppInfo(ctx, app)
+	if err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).
+			Message("unable to fetch info for app").
+			Tag("app_id", app).
+			Func("GetDeliveryLog")
+	}
+
+	userId := authentication.UserFromAuthenticatedContext(ctx)
+	if app != userId && appInfo.Owner != userId {
+		return nil, base.RiverError(
+			Err_PERMISSION_DENIED,
+			"authenticated user must be either app or owner",
+			"owner",
+			appInfo.Owner,
+			"app",
+			app,
+			"userId",
+			userId,
+		)
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultDeliveryLogLimit
+	}
+	limit = min(limit, maxDeliveryLogLimit)
+
+	entries, err := s.store.GetWebhookDeliveryLog(ctx, app, limit)
+	if err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Func("GetDeliveryLog")
+	}
+
+	attempts := make([]*WebhookDeliveryAttempt, len(entries))
+	for i, entry := range entries {
+		attempts[i] = &WebhookDeliveryAttempt{
+			StreamId:   entry.ChannelID[:],
+			EventHash:  entry.EventHash[:],
+			Attempt:    int32(entry.Attempt),
+			Status:     entry.Status,
+			HttpStatus: int32(entry.HttpStatus),
+			Error:      entry.Error,
+			CreatedAt:  timestamppb.New(entry.CreatedAt),
+		}
+	}
+
+	return &connect.Response[GetDeliveryLogResponse]{
+		Msg: &GetDeliveryLogResponse{Attempts: attempts},
+	}, nil
+}
+
+// initializeWebhook calls "initialize" on the app service with the given webhook.
+func (s *Service) initializeWebhook(ctx context.Context, appInfo *storage.AppInfo, webhook string) error {
+	secrets, err := decryptSigningSecrets(appInfo, s.sharedSecretDataEncryptionKey)
+	if err != nil {
+		return base.WrapRiverError(Err_INTERNAL, err).
+			Message("Unable to decrypt app shared secret from db").
+			Tag("appId", appInfo.App)
+	}
+
+	if err := s.appClient.InitializeWebhook(ctx, webhook, appInfo.App, secrets); err != nil {
+		return base.WrapRiverError(Err_UNKNOWN, err).Message("Unable to initialize app service")
+	}
+	return nil
+}
+
+// authorizeOwner returns the app with the given id if the authenticated user is the owner of the app.
+func (s *Service) authorizeOwner(
+	ctx context.Context,
+	appId []byte,
+	funcName string,
+) (*storage.AppInfo, common.Address, error) {
+	app, err := base.BytesToAddress(appId)
+	if err != nil {
+		return nil, common.Address{}, base.WrapRiverError(Err_INVALID_ARGUMENT, err).
+			Message("invalid app id").
+			Tag("app_id", appId).
+			Func(funcName)
+	}
+
+	appInfo, err := s.store.GetAppInfo(ctx, app)
+	if err != nil {
+		return nil, common.Address{}, base.AsRiverError(err, Err_INTERNAL).
+			Message("unable to fetch info for app").
+			Tag("app_id", app).
+			Func(funcName)
+	}
+
+	userId := authentication.UserFromAuthenticatedContext(ctx)
+	if appInfo.Owner != userId {
+		return nil, common.Address{}, base.RiverError(
+			Err_PERMISSION_DENIED,
+			"authenticated user must be app owner",
+			"owner",
+			appInfo.Owner,
+			"app",
+			app,
+			"userId",
+			userId,
+		).Func(funcName)
+	}
+
+	return appInfo, userId, nil
+}
+
+func (s *Service) GetInfo(
+	ctx context.Context,
+	req *connect.Request[GetInfoRequest],
+) (
+	*connect.Response[GetInfoResponse],
+	error,
+) {
+	appInfo, owner, err := s.authorizeOwner(ctx, req.Msg.AppId, "GetInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.AddAppAuditEntry(ctx, appInfo.App, owner, storage.AppAuditActionGetInfo, ""); err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Func("GetInfo")
+	}
+
+	resp := &GetInfoResponse{
+		AppId:       appInfo.App[:],
+		AppOwnerId:  appInfo.Owner[:],
+		WebhookUrl:  appInfo.WebhookUrl,
+		Disabled:    appInfo.Disabled,
+		Permissions: appInfo.Permissions,
+	}
+	if appInfo.PreviousEncryptedSecret != nil {
+		resp.PreviousSecretExpiresAt = timestamppb.New(appInfo.PreviousSecretExpiresAt)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) RotateSecret(
+	ctx context.Context,
+	req *connect.Request[RotateSecretRequest],
+) (
+	*connect.Response[RotateSecretResponse],
+	error,
+) {
+	appInfo, owner, err := s.authorizeOwner(ctx, req.Msg.AppId, "RotateSecret")
+	if err != nil {
+		return nil, err
+	}
+
+	appSecret, err := genHS256SharedSecret()
+	if err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Message("error generating shared secret for app")
+	}
+
+	encrypted, err := encryptSharedSecret(appSecret, s.sharedSecretDataEncryptionKey)
+	if err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Message("error encrypting shared secret for app")
+	}
+
+	expiresAt, err := s.store.RotateSecret(
+		ctx,
+		appInfo.App,
+		owner,
+		encrypted,
+		s.cfg.GetSecretRotationGracePeriod(),
+	)
+	if err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Func("RotateSecret")
+	}
+
+	return connect.NewResponse(&RotateSecretResponse{
+		Hs256SharedSecret:       appSecret[:],
+		PreviousSecretExpiresAt: timestamppb.New(expiresAt),
+	}), nil
+}
+
+func (s *Service) UpdateWebhook(
+	ctx context.Context,
+	req *connect.Request[UpdateWebhookRequest],
+) (
+	*connect.Response[UpdateWebhookResponse],
+	error,
+) {
+	appInfo, owner, err := s.authorizeOwner(ctx, req.Msg.AppId, "UpdateWebhook")
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := req.Msg.WebhookUrl
+	if err := s.initializeWebhook(ctx, appInfo, webhook); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.UpdateWebhook(ctx, appInfo.App, owner, webhook); err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Func("UpdateWebhook")
+	}
+
+	return connect.NewResponse(&UpdateWebhookResponse{}), nil
+}
+
+func (s *Service) DisableApp(
+	ctx context.Context,
+	req *connect.Request[DisableAppRequest],
+) (
+	*connect.Response[DisableAppResponse],
+	error,
+) {
+	appInfo, owner, err := s.authorizeOwner(ctx, req.Msg.AppId, "DisableApp")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.SetAppDisabled(ctx, appInfo.App, owner, req.Msg.Disabled); err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Func("DisableApp")
+	}
+
+	return connect.NewResponse(&DisableAppResponse{}), nil
+}
+
+func (s *Service) DeleteApp(
+	ctx context.Context,
+	req *connect.Request[DeleteAppRequest],
+) (
+	*connect.Response[DeleteAppResponse],
+	error,
+) {
+	appInfo, owner, err := s.authorizeOwner(ctx, req.Msg.AppId, "DeleteApp")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteApp(ctx, appInfo.App, owner); err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Func("DeleteApp")
+	}
+	s.webhookDispatcher.removeRegisteredApp(appInfo.App)
+
+	return connect.NewResponse(&DeleteAppResponse{}), nil
+}
+
+func (s *Service) SetPermissions(
+	ctx context.Context,
+	req *connect.Request[SetPermissionsRequest],
+) (
+	*connect.Response[SetPermissionsResponse],
+	error,
+) {
+	appInfo, owner, err := s.authorizeOwner(ctx, req.Msg.AppId, "SetPermissions")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateAppPermissions(req.Msg.Permissions); err != nil {
+		return nil, base.AsRiverError(err).Func("SetPermissions")
+	}
+
+	if err := s.store.SetAppPermissions(ctx, appInfo.App, owner, req.Msg.Permissions); err != nil {
+		return nil, base.AsRiverError(err, Err_INTERNAL).Func("SetPermissions")
+	}
+
+	return connect.NewResponse(&SetPermissionsResponse{}), nil
+}
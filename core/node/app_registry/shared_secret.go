@@ -4,6 +4,9 @@ import (
 	"crypto/aes"
 	"crypto/rand"
 	"io"
+
+	"github.com/towns-protocol/towns/core/node/app_registry/app_client"
+	"github.com/towns-protocol/towns/core/node/storage"
 )
 
 // genHS256SharedSecret generates a cryptographically secure random 32-byte key for use
@@ -46,3 +49,26 @@ func decryptSharedSecret(encryptedSecret [32]byte, dataEncryptionKey [32]byte) (
 	cipher.Decrypt(decrypted[16:], encryptedSecret[16:])
 	return decrypted, nil
 }
+
+// decryptSigningSecrets returns the secrets requests to the app service are signed with: the current
+// secret and, during the grace period after a rotation, the previous secret.
+func decryptSigningSecrets(
+	appInfo *storage.AppInfo,
+	dataEncryptionKey [32]byte,
+) (app_client.SigningSecrets, error) {
+	secret, err := decryptSharedSecret(appInfo.EncryptedSecret, dataEncryptionKey)
+	if err != nil {
+		return app_client.SigningSecrets{}, err
+	}
+	secrets := app_client.SigningSecrets{Current: secret}
+
+	if appInfo.PreviousEncryptedSecret != nil {
+		previous, err := decryptSharedSecret(*appInfo.PreviousEncryptedSecret, dataEncryptionKey)
+		if err != nil {
+			return app_client.SigningSecrets{}, err
+		}
+		secrets.Previous = &previous
+	}
+
+	return secrets, nil
+}
@@ -15,9 +15,8 @@ import (
 )
 
 type AppRegistryStreamsTracker struct {
-	// TODO: eventually this struct will contain references to whatever types of cache / storage access
-	// the TrackedStreamView for the app registry service needs.
 	track_streams.StreamsTrackerImpl
+	keyListener SessionKeyListener
 }
 
 func NewAppRegistryStreamsTracker(
@@ -28,8 +27,11 @@ func NewAppRegistryStreamsTracker(
 	nodes []nodes.NodeRegistry,
 	metricsFactory infra.MetricsFactory,
 	listener track_streams.StreamEventListener,
+	keyListener SessionKeyListener,
 ) (track_streams.StreamsTracker, error) {
-	tracker := &AppRegistryStreamsTracker{}
+	tracker := &AppRegistryStreamsTracker{
+		keyListener: keyListener,
+	}
 	if err := tracker.StreamsTrackerImpl.Init(
 		ctx,
 		onChainConfig,
@@ -60,13 +62,12 @@ func (tracker *AppRegistryStreamsTracker) NewTrackedStream(
 	cfg crypto.OnChainConfiguration,
 	stream *protocol.StreamAndCookie,
 ) (events.TrackedStreamView, error) {
-	// TODO: pass in storage to the tracked stream constructor and implement logic for updating storage
-	// and caches within the tracked stream.
 	return NewTrackedStreamForAppRegistryService(
 		ctx,
 		streamID,
 		cfg,
 		stream,
 		tracker.StreamsTrackerImpl.Listener(),
+		tracker.keyListener,
 	)
 }
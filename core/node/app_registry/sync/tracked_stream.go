@@ -3,6 +3,8 @@ package sync
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/towns-protocol/towns/core/node/crypto"
 	. "github.com/towns-protocol/towns/core/node/events"
 	. "github.com/towns-protocol/towns/core/node/protocol"
@@ -10,16 +12,30 @@ import (
 	"github.com/towns-protocol/towns/core/node/track_streams"
 )
 
+// SessionKeyListener is notified about group encryption sessions that are sent to the inbox of an app.
+type SessionKeyListener interface {
+	// IsRegisteredApp returns true if sessions sent to the inbox of the user must be passed to the listener.
+	IsRegisteredApp(user common.Address) bool
+
+	OnGroupEncryptionSessions(
+		ctx context.Context,
+		user common.Address,
+		sessions *UserInboxPayload_GroupEncryptionSessions,
+	)
+}
+
 type AppRegistryTrackedStreamView struct {
 	TrackedStreamViewImpl
-	listener track_streams.StreamEventListener
+	listener    track_streams.StreamEventListener
+	keyListener SessionKeyListener
 }
 
 func (b *AppRegistryTrackedStreamView) onNewEvent(ctx context.Context, view *StreamView, event *ParsedEvent) error {
 	streamId := view.StreamId()
 	if streamId.Type() == shared.STREAM_USER_INBOX_BIN {
-		// TODO: update app key fulfillments, possibly triggering a flurry of webhook calls
-		// that were queued up waiting for a particular fulfillment for a particular channel
+		// update app key fulfillments, this releases webhook calls that were held until the app
+		// received the session keys for the message
+		b.onInboxEvent(ctx, *streamId, event)
 		return nil
 	}
 
@@ -35,6 +51,21 @@ func (b *AppRegistryTrackedStreamView) onNewEvent(ctx context.Context, view *Str
 	return nil
 }
 
+func (b *AppRegistryTrackedStreamView) onInboxEvent(ctx context.Context, streamId shared.StreamId, event *ParsedEvent) {
+	sessions := event.Event.GetUserInboxPayload().GetGroupEncryptionSessions()
+	if sessions == nil {
+		return
+	}
+	if user := inboxUser(streamId); b.keyListener.IsRegisteredApp(user) {
+		b.keyListener.OnGroupEncryptionSessions(ctx, user, sessions)
+	}
+}
+
+// inboxUser returns the address of the user that owns the inbox stream.
+func inboxUser(streamId shared.StreamId) common.Address {
+	return common.BytesToAddress(streamId[1:21])
+}
+
 // NewTrackedStreamForAppRegistry constructs a TrackedStreamView instance from the given
 // stream, and executes callbacks to ensure that all apps' cached key fulfillments are up to date,
 // and that message events are sent to the supplied listener. It's expected that the stream cookie
@@ -45,17 +76,24 @@ func NewTrackedStreamForAppRegistryService(
 	cfg crypto.OnChainConfiguration,
 	stream *StreamAndCookie,
 	listener track_streams.StreamEventListener,
+	keyListener SessionKeyListener,
 ) (TrackedStreamView, error) {
 	trackedView := &AppRegistryTrackedStreamView{
-		listener: listener,
+		listener:    listener,
+		keyListener: keyListener,
 	}
-	_, err := trackedView.TrackedStreamViewImpl.Init(ctx, streamID, cfg, stream, trackedView.onNewEvent)
+	view, err := trackedView.TrackedStreamViewImpl.Init(ctx, streamID, cfg, stream, trackedView.onNewEvent)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: capture returned view above and update cache / storage with all new key fulfillments,
-	// iff this is an app user inbox stream.
+	// Sessions that were sent to the inbox before the stream was tracked are not passed to onNewEvent.
+	// Recording them again is harmless.
+	if streamID.Type() == shared.STREAM_USER_INBOX_BIN && keyListener.IsRegisteredApp(inboxUser(streamID)) {
+		for event := range view.AllEvents() {
+			trackedView.onInboxEvent(ctx, streamID, event)
+		}
+	}
 
 	return trackedView, nil
 }
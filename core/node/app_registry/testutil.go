@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -25,12 +26,17 @@ type TestAppServer struct {
 	url            string
 	appWallet      *crypto.Wallet
 	hs256SecretKey []byte
+	// authorizationHeader is the header that is signed with hs256SecretKey.
+	authorizationHeader string
+
+	mu     sync.Mutex
+	events []app_client.EventData
 }
 
 // validateSignature verifies that the incoming request has a HS256-encoded jwt auth token stored
 // in the header with the appropriate audience, signed by the expected secret key.
-func validateSignature(req *http.Request, secretKey []byte, appId common.Address) error {
-	authorization := req.Header.Get("Authorization")
+func validateSignature(req *http.Request, header string, secretKey []byte, appId common.Address) error {
+	authorization := req.Header.Get(header)
 	if authorization == "" {
 		return fmt.Errorf("Unauthenticated")
 	}
@@ -82,15 +88,36 @@ func NewTestAppServer(t *testing.T, appWallet *crypto.Wallet, hs256SecretKey []b
 		url:            url,
 		appWallet:      appWallet,
 		hs256SecretKey: hs256SecretKey,
+
+		authorizationHeader: app_client.AuthorizationHeader,
 	}
 
 	return b
 }
 
+// NewTestAppServerWithPreviousSecret creates an app server that wasn't updated after a secret rotation,
+// it verifies requests with the previous secret.
+func NewTestAppServerWithPreviousSecret(
+	t *testing.T,
+	appWallet *crypto.Wallet,
+	previousSecretKey []byte,
+) *TestAppServer {
+	b := NewTestAppServer(t, appWallet, previousSecretKey)
+	b.authorizationHeader = app_client.PreviousAuthorizationHeader
+	return b
+}
+
 func (b *TestAppServer) Url() string {
 	return b.url
 }
 
+// Events returns the events that were delivered to the app server.
+func (b *TestAppServer) Events() []app_client.EventData {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]app_client.EventData(nil), b.events...)
+}
+
 func (b *TestAppServer) Close() {
 	if b.httpServer != nil {
 		b.httpServer.Close()
@@ -107,8 +134,9 @@ func (b *TestAppServer) rootHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := validateSignature(r, b.hs256SecretKey, b.appWallet.Address); err != nil {
+	if err := validateSignature(r, b.authorizationHeader, b.hs256SecretKey, b.appWallet.Address); err != nil {
 		http.Error(w, "JWT Signature Invalid", http.StatusForbidden)
+		return
 	}
 
 	// Check that the Content-Type is application/json.
@@ -118,7 +146,10 @@ func (b *TestAppServer) rootHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Decode the JSON request body into the Payload struct.
-	var payload app_client.AppServiceRequestPayload
+	var payload struct {
+		Command string          `json:"command"`
+		Data    json.RawMessage `json:",omitempty"`
+	}
 	decoder := json.NewDecoder(r.Body)
 	defer r.Body.Close() // Ensure the body is closed once we're done.
 
@@ -127,6 +158,17 @@ func (b *TestAppServer) rootHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if payload.Command == "event" {
+		var event app_client.EventData
+		if err := json.Unmarshal(payload.Data, &event); err != nil {
+			http.Error(w, fmt.Sprintf("Error decoding event: %v", err), http.StatusBadRequest)
+			return
+		}
+		b.mu.Lock()
+		b.events = append(b.events, event)
+		b.mu.Unlock()
+	}
+
 	// For demonstration, print the received payload.
 	// log := logging.DefaultZapLogger(zapcore.DebugLevel)
 	// log.Infow("Received payload", "payload", payload)
@@ -0,0 +1,454 @@
+package app_registry
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/app_registry/app_client"
+	appregistrysync "github.com/towns-protocol/towns/core/node/app_registry/sync"
+	"github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/rules"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/track_streams"
+)
+
+const (
+	// webhookLogPruneInterval is how often delivery log entries and session records that passed
+	// the retention are removed.
+	webhookLogPruneInterval = time.Hour
+
+	// heldWebhookExpiryInterval is how often events that are held too long for session keys are dropped.
+	heldWebhookExpiryInterval = time.Minute
+
+	// registeredAppsRefreshInterval is how often the set of registered apps is reloaded to pick up apps
+	// that were registered or deleted by other instances.
+	registeredAppsRefreshInterval = time.Minute
+)
+
+// WebhookDispatcher delivers messages from channels to the webhooks of the apps that are a member of the
+// channel. Messages are added to a persistent queue and delivered in order per app and channel. Failed
+// deliveries are retried with an exponential backoff until the maximum number of attempts is reached.
+// Each delivery attempt is recorded in the delivery log of the app.
+//
+// Encrypted messages are held until the app received the session keys to decrypt them in its user inbox,
+// apps would otherwise receive messages they can't read until someone answers their key solicitation. Held
+// messages are dropped when the keys are not received within the configured wait.
+type WebhookDispatcher struct {
+	store             storage.AppRegistryStore
+	cfg               *config.AppWebhookDeliveryConfig
+	appClient         *app_client.AppClient
+	dataEncryptionKey [32]byte
+	wake              chan struct{}
+	// apps are the registered apps, group encryption sessions sent to other inboxes are ignored.
+	// It is nil until the apps are loaded, all inboxes are considered until then.
+	apps atomic.Pointer[mapset.Set[common.Address]]
+
+	deliveries *prometheus.CounterVec
+}
+
+var (
+	_ track_streams.StreamEventListener  = (*WebhookDispatcher)(nil)
+	_ appregistrysync.SessionKeyListener = (*WebhookDispatcher)(nil)
+)
+
+// NewWebhookDispatcher creates a dispatcher that delivers events with the given app client. The data encryption
+// key is used to decrypt the shared secrets of apps that webhook calls are signed with.
+func NewWebhookDispatcher(
+	store storage.AppRegistryStore,
+	cfg *config.AppWebhookDeliveryConfig,
+	appClient *app_client.AppClient,
+	dataEncryptionKey [32]byte,
+	metrics infra.MetricsFactory,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		store:             store,
+		cfg:               cfg,
+		appClient:         appClient,
+		dataEncryptionKey: dataEncryptionKey,
+		wake:              make(chan struct{}, 1),
+		deliveries: metrics.NewCounterVecEx(
+			"app_webhook_deliveries",
+			"Number of attempts to deliver events to app webhooks",
+			"result",
+		),
+	}
+}
+
+// OnMessageEvent adds message events to the queue of each app that is a member of the channel.
+func (d *WebhookDispatcher) OnMessageEvent(
+	ctx context.Context,
+	channelID shared.StreamId,
+	spaceID *shared.StreamId,
+	members mapset.Set[string],
+	event *events.ParsedEvent,
+) {
+	if !isMessageEvent(event) {
+		return
+	}
+
+	log := logging.FromCtx(ctx).With("channel", channelID, "event", event.Hash)
+
+	envelope, err := event.GetEnvelopeBytes()
+	if err != nil {
+		log.Errorw("Unable to encode event envelope", "err", err)
+		return
+	}
+
+	data := app_client.EventData{
+		StreamId:  channelID.String(),
+		EventHash: hex.EncodeToString(event.Hash[:]),
+		Envelope:  envelope,
+	}
+	if spaceID != nil {
+		data.SpaceId = spaceID.String()
+	}
+
+	payload, err := json.Marshal(app_client.AppServiceRequestPayload{Command: "event", Data: data})
+	if err != nil {
+		log.Errorw("Unable to encode webhook payload", "err", err)
+		return
+	}
+
+	memberAddresses := make([]common.Address, 0, members.Cardinality())
+	members.Each(func(member string) bool {
+		memberAddresses = append(memberAddresses, common.HexToAddress(member))
+		return false
+	})
+
+	apps, err := d.store.EnqueueWebhookEvent(
+		ctx,
+		channelID,
+		rules.AppStreamTypeForStreamId(channelID),
+		event.Hash,
+		common.BytesToAddress(event.Event.CreatorAddress),
+		memberAddresses,
+		messageSessionID(event),
+		payload,
+	)
+	if err != nil {
+		log.Errorw("Unable to add event to webhook queue", "err", err)
+		return
+	}
+
+	if len(apps) > 0 {
+		d.wakeUp()
+	}
+}
+
+// IsRegisteredApp returns true if user is a registered app. Apps that were registered by another instance
+// are known after the next refresh of the registered apps.
+func (d *WebhookDispatcher) IsRegisteredApp(user common.Address) bool {
+	apps := d.apps.Load()
+	return apps == nil || (*apps).Contains(user)
+}
+
+// addRegisteredApp marks the app as registered until the next refresh of the registered apps.
+func (d *WebhookDispatcher) addRegisteredApp(app common.Address) {
+	if apps := d.apps.Load(); apps != nil {
+		(*apps).Add(app)
+	}
+}
+
+// removeRegisteredApp marks the app as deleted until the next refresh of the registered apps.
+func (d *WebhookDispatcher) removeRegisteredApp(app common.Address) {
+	if apps := d.apps.Load(); apps != nil {
+		(*apps).Remove(app)
+	}
+}
+
+// loadRegisteredApps reloads the set of registered apps.
+func (d *WebhookDispatcher) loadRegisteredApps(ctx context.Context) error {
+	apps, err := d.store.GetApps(ctx)
+	if err != nil {
+		return err
+	}
+	set := mapset.NewSet(apps...)
+	d.apps.Store(&set)
+	return nil
+}
+
+// OnGroupEncryptionSessions records the sessions that were sent to the inbox of an app and delivers the
+// messages that were held for them.
+func (d *WebhookDispatcher) OnGroupEncryptionSessions(
+	ctx context.Context,
+	user common.Address,
+	sessions *UserInboxPayload_GroupEncryptionSessions,
+) {
+	sessionIDs := make([]string, len(sessions.GetSessionIds()))
+	for i, sessionID := range sessions.GetSessionIds() {
+		sessionIDs[i] = strings.ToLower(sessionID)
+	}
+
+	held, err := d.store.AddAppSessionKeys(ctx, user, sessionIDs)
+	if err != nil {
+		logging.FromCtx(ctx).Errorw("Unable to record app session keys", "user", user, "err", err)
+		return
+	}
+
+	if held {
+		d.wakeUp()
+	}
+}
+
+func (d *WebhookDispatcher) wakeUp() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// messageSessionID returns the id of the session the message is encrypted with, or an empty string
+// if the message is not encrypted with a group session.
+func messageSessionID(event *events.ParsedEvent) string {
+	var message *EncryptedData
+	switch payload := event.Event.Payload.(type) {
+	case *StreamEvent_ChannelPayload:
+		message = payload.ChannelPayload.GetMessage()
+	case *StreamEvent_DmChannelPayload:
+		message = payload.DmChannelPayload.GetMessage()
+	case *StreamEvent_GdmChannelPayload:
+		message = payload.GdmChannelPayload.GetMessage()
+	}
+
+	if len(message.GetSessionIdBytes()) > 0 {
+		return hex.EncodeToString(message.GetSessionIdBytes())
+	}
+	return strings.ToLower(message.GetSessionId())
+}
+
+// isMessageEvent returns true if the event is a message in a space channel, DM or GDM.
+func isMessageEvent(event *events.ParsedEvent) bool {
+	switch payload := event.Event.Payload.(type) {
+	case *StreamEvent_ChannelPayload:
+		return payload.ChannelPayload.GetMessage() != nil
+	case *StreamEvent_DmChannelPayload:
+		return payload.DmChannelPayload.GetMessage() != nil
+	case *StreamEvent_GdmChannelPayload:
+		return payload.GdmChannelPayload.GetMessage() != nil
+	default:
+		return false
+	}
+}
+
+// Start loads the registered apps and delivers queued events in the background until the context is cancelled.
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	if err := d.loadRegisteredApps(ctx); err != nil {
+		logging.FromCtx(ctx).Warnw("Unable to load registered apps", "err", err)
+	}
+
+	go d.run(ctx)
+	go d.maintain(ctx)
+}
+
+func (d *WebhookDispatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.GetPollInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+
+		// keep going while full batches are claimed to drain a backlog without waiting for the ticker
+		for d.deliverDue(ctx) {
+		}
+	}
+}
+
+// deliverDue delivers a batch of due events and returns true if more events are likely due.
+func (d *WebhookDispatcher) deliverDue(ctx context.Context) bool {
+	// the lease covers the webhook call and recording the result
+	lease := d.cfg.GetTimeout() + 30*time.Second
+
+	deliveries, err := d.store.ClaimWebhookDeliveries(ctx, d.cfg.GetBatchSize(), lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			logging.FromCtx(ctx).Errorw("Unable to claim webhook deliveries", "err", err)
+		}
+		return false
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.deliver(ctx, delivery)
+		}()
+	}
+	wg.Wait()
+
+	return len(deliveries) == d.cfg.GetBatchSize()
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery *storage.AppWebhookDelivery) {
+	log := logging.FromCtx(ctx).With(
+		"app", delivery.App,
+		"channel", delivery.ChannelID,
+		"event", delivery.EventHash,
+		"attempt", delivery.Attempts,
+	)
+
+	appInfo, err := d.store.GetAppInfo(ctx, delivery.App)
+	if err != nil {
+		if base.IsRiverErrorCode(err, Err_NOT_FOUND) {
+			d.deadLetter(ctx, log, delivery, 0, "app does not exist")
+			return
+		}
+		d.retry(ctx, log, delivery, 0, err)
+		return
+	}
+
+	if appInfo.WebhookUrl == "" {
+		d.deadLetter(ctx, log, delivery, 0, "app has no webhook")
+		return
+	}
+
+	if appInfo.Disabled {
+		d.deadLetter(ctx, log, delivery, 0, "app is disabled")
+		return
+	}
+
+	secrets, err := decryptSigningSecrets(appInfo, d.dataEncryptionKey)
+	if err != nil {
+		d.retry(ctx, log, delivery, 0, err)
+		return
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, d.cfg.GetTimeout())
+	status, err := d.appClient.SendEvent(callCtx, appInfo.WebhookUrl, delivery.App, secrets, delivery.Payload)
+	cancel()
+
+	if err != nil {
+		d.retry(ctx, log, delivery, status, err)
+		return
+	}
+
+	d.deliveries.WithLabelValues("delivered").Inc()
+	if err := d.store.CompleteWebhookDelivery(ctx, delivery, status); err != nil {
+		log.Errorw("Unable to remove delivered event from webhook queue", "err", err)
+	}
+}
+
+// retry schedules the next delivery attempt, or drops the event when the maximum number of attempts is reached.
+func (d *WebhookDispatcher) retry(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	delivery *storage.AppWebhookDelivery,
+	status int,
+	deliveryErr error,
+) {
+	if delivery.Attempts >= d.cfg.GetMaxAttempts() {
+		d.deadLetter(ctx, log, delivery, status, deliveryErr.Error())
+		return
+	}
+
+	d.deliveries.WithLabelValues("failed").Inc()
+
+	delay := d.backoff(delivery.Attempts)
+	log.Warnw("Unable to deliver event to webhook, retry later", "status", status, "retryIn", delay, "err", deliveryErr)
+	if err := d.store.RetryWebhookDelivery(ctx, delivery, delay, status, deliveryErr.Error()); err != nil {
+		log.Warnw("Unable to schedule webhook delivery retry", "err", err)
+	}
+}
+
+func (d *WebhookDispatcher) deadLetter(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	delivery *storage.AppWebhookDelivery,
+	status int,
+	reason string,
+) {
+	d.deliveries.WithLabelValues("dead_lettered").Inc()
+
+	log.Warnw("Drop event for webhook", "status", status, "reason", reason)
+	if err := d.store.DeadLetterWebhookDelivery(ctx, delivery, status, reason); err != nil {
+		log.Warnw("Unable to drop event from webhook queue", "err", err)
+	}
+}
+
+// backoff returns the delay before the next attempt after the given number of attempts.
+func (d *WebhookDispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.GetInitialBackoff()
+	for i := 1; i < attempts && delay < d.cfg.GetMaxBackoff(); i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.GetMaxBackoff())
+}
+
+// maintain drops events that are held too long for session keys and prunes the delivery log and session
+// records until the context is cancelled.
+func (d *WebhookDispatcher) maintain(ctx context.Context) {
+	var (
+		log         = logging.FromCtx(ctx)
+		expiry      = time.NewTicker(heldWebhookExpiryInterval)
+		pruneTicker = time.NewTicker(webhookLogPruneInterval)
+		appsTicker  = time.NewTicker(registeredAppsRefreshInterval)
+	)
+	defer expiry.Stop()
+	defer pruneTicker.Stop()
+	defer appsTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-expiry.C:
+			expired, err := d.store.ExpireHeldWebhookDeliveries(ctx, d.cfg.GetKeyWaitTimeout())
+			if err != nil && ctx.Err() == nil {
+				log.Warnw("Unable to expire held webhook deliveries", "err", err)
+			}
+			if expired > 0 {
+				d.deliveries.WithLabelValues("expired").Add(float64(expired))
+				log.Infow("Dropped events for which apps didn't receive session keys", "count", expired)
+				// the events after the dropped events can be delivered now
+				d.wakeUp()
+			}
+		case <-appsTicker.C:
+			if err := d.loadRegisteredApps(ctx); err != nil && ctx.Err() == nil {
+				log.Warnw("Unable to load registered apps", "err", err)
+			}
+		case <-pruneTicker.C:
+			if err := d.store.PruneWebhookDeliveryLog(ctx, d.cfg.GetLogRetention()); err != nil && ctx.Err() == nil {
+				log.Warnw("Unable to prune webhook delivery log", "err", err)
+			}
+			if err := d.store.PruneAppSessionKeys(ctx, d.cfg.GetSessionKeyRetention()); err != nil && ctx.Err() == nil {
+				log.Warnw("Unable to prune app session keys", "err", err)
+			}
+		}
+	}
+}
+
+// streamEventListeners passes stream events to multiple listeners.
+type streamEventListeners []track_streams.StreamEventListener
+
+func (l streamEventListeners) OnMessageEvent(
+	ctx context.Context,
+	streamID shared.StreamId,
+	parentStreamID *shared.StreamId,
+	members mapset.Set[string],
+	event *events.ParsedEvent,
+) {
+	for _, listener := range l {
+		listener.OnMessageEvent(ctx, streamID, parentStreamID, members, event)
+	}
+}
@@ -0,0 +1,100 @@
+package app_registry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/infra"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+func TestWebhookBackoff(t *testing.T) {
+	require := require.New(t)
+
+	d := NewWebhookDispatcher(nil, &config.AppWebhookDeliveryConfig{
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	}, nil, [32]byte{}, infra.NewMetricsFactory(nil, "", ""))
+
+	require.Equal(time.Second, d.backoff(1))
+	require.Equal(2*time.Second, d.backoff(2))
+	require.Equal(4*time.Second, d.backoff(3))
+	require.Equal(8*time.Second, d.backoff(4))
+	require.Equal(10*time.Second, d.backoff(5))
+	require.Equal(10*time.Second, d.backoff(100))
+}
+
+func TestIsRegisteredApp(t *testing.T) {
+	require := require.New(t)
+
+	d := NewWebhookDispatcher(nil, &config.AppWebhookDeliveryConfig{}, nil, [32]byte{},
+		infra.NewMetricsFactory(nil, "", ""))
+
+	var (
+		app  = common.Address{1}
+		user = common.Address{2}
+	)
+
+	// all inboxes are considered until the registered apps are loaded
+	require.True(d.IsRegisteredApp(user))
+
+	apps := mapset.NewSet(app)
+	d.apps.Store(&apps)
+	require.True(d.IsRegisteredApp(app))
+	require.False(d.IsRegisteredApp(user))
+
+	d.addRegisteredApp(user)
+	require.True(d.IsRegisteredApp(user))
+	d.removeRegisteredApp(app)
+	require.False(d.IsRegisteredApp(app))
+}
+
+func TestIsMessageEvent(t *testing.T) {
+	require := require.New(t)
+
+	wallet, err := crypto.NewWallet(context.Background())
+	require.NoError(err)
+
+	message, err := events.MakeParsedEventWithPayload(wallet, events.Make_ChannelPayload_Message("hi"), nil)
+	require.NoError(err)
+	require.True(isMessageEvent(message))
+
+	membership, err := events.MakeParsedEventWithPayload(
+		wallet,
+		events.Make_ChannelPayload_Membership(MembershipOp_SO_JOIN, wallet.Address.Hex(), "", nil),
+		nil,
+	)
+	require.NoError(err)
+	require.False(isMessageEvent(membership))
+}
+
+func TestMessageSessionID(t *testing.T) {
+	require := require.New(t)
+
+	wallet, err := crypto.NewWallet(context.Background())
+	require.NoError(err)
+
+	message := func(data *EncryptedData) *events.ParsedEvent {
+		event, err := events.MakeParsedEventWithPayload(
+			wallet,
+			&StreamEvent_ChannelPayload{
+				ChannelPayload: &ChannelPayload{Content: &ChannelPayload_Message{Message: data}},
+			},
+			nil,
+		)
+		require.NoError(err)
+		return event
+	}
+
+	require.Equal("", messageSessionID(message(&EncryptedData{Ciphertext: "plain"})))
+	require.Equal("abcd", messageSessionID(message(&EncryptedData{SessionId: "ABCD"})))
+	require.Equal("0102", messageSessionID(message(&EncryptedData{SessionIdBytes: []byte{1, 2}, SessionId: "ignored"})))
+}
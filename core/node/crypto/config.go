@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -41,6 +42,11 @@ const (
 	StreamMinEventsPerSnapshotUserSettingsConfigKey = "stream.minEventsPerSnapshot.a5"
 	StreamMinEventsPerSnapshotUserConfigKey         = "stream.minEventsPerSnapshot.a8"
 	StreamMinEventsPerSnapshotUserDeviceConfigKey   = "stream.minEventsPerSnapshot.ad"
+	StreamDefaultHistorySnapshotsConfigKey          = "stream.defaultHistorySnapshots"
+	StreamHistorySnapshotsUserInboxConfigKey        = "stream.historySnapshots.a1"
+	StreamHistorySnapshotsUserSettingsConfigKey     = "stream.historySnapshots.a5"
+	StreamHistorySnapshotsUserConfigKey             = "stream.historySnapshots.a8"
+	StreamHistorySnapshotsUserDeviceConfigKey       = "stream.historySnapshots.ad"
 	StreamCacheExpirationMsConfigKey                = "stream.cacheExpirationMs"
 	StreamCacheExpirationPollIntervalMsConfigKey    = "stream.cacheExpirationPollIntervalMs"
 	StreamGetMiniblocksMaxPageSizeConfigKey         = "stream.getMiniblocksMaxPageSize"
@@ -49,6 +55,12 @@ const (
 	XChainBlockchainsConfigKey                      = "xchain.blockchains"
 	StreamMiniblockRegistrationFrequencyKey         = "stream.miniblockRegistrationFrequency"
 	StreamEphemeralStreamTTLMsKey                   = "stream.ephemeralStreamTTLMs"
+	// StreamPlacementStrategyConfigKey selects how nodes are chosen for new streams, see StreamPlacementSettings.
+	StreamPlacementStrategyConfigKey = "stream.placement.strategy"
+	// StreamPlacementNodesConfigKey holds operator-declared node capacity weights and failure domains.
+	StreamPlacementNodesConfigKey = "stream.placement.nodes"
+	// StreamHistoryTrimUnarchivedConfigKey allows trimming stream history that no archive node archived yet.
+	StreamHistoryTrimUnarchivedConfigKey = "stream.historySnapshots.trimUnarchived"
 )
 
 var (
@@ -93,8 +105,14 @@ type OnChainSettings struct {
 
 	ReplicationFactor uint64 `mapstructure:"stream.replicationFactor"`
 
+	// StreamPlacement selects the strategy used to choose nodes for new streams.
+	StreamPlacement StreamPlacementSettings `mapstructure:",squash"`
+
 	MinSnapshotEvents MinSnapshotEventsSettings `mapstructure:",squash"`
 
+	// HistorySnapshots is the stream history retention policy for non-archive nodes.
+	HistorySnapshots HistorySnapshotsSettings `mapstructure:",squash"`
+
 	// StreamMiniblockRegistrationFrequency indicates how often miniblocks are registered.
 	// E.g. StreamMiniblockRegistrationFrequency=5 means that only 1 out of 5 miniblocks for a stream are registered.
 	StreamMiniblockRegistrationFrequency uint64 `mapstructure:"stream.miniblockRegistrationFrequency"`
@@ -138,6 +156,90 @@ func (m MinSnapshotEventsSettings) ForType(streamType byte) uint64 {
 	}
 }
 
+// HistorySnapshotsSettings holds the number of the latest snapshots for which miniblocks are kept
+// by non-archive nodes. Miniblocks before the oldest kept snapshot are trimmed from storage.
+// 0 means that the full stream history is kept.
+type HistorySnapshotsSettings struct {
+	Default      uint64 `mapstructure:"stream.defaultHistorySnapshots"`
+	UserInbox    uint64 `mapstructure:"stream.historySnapshots.a1"`
+	UserSettings uint64 `mapstructure:"stream.historySnapshots.a5"`
+	User         uint64 `mapstructure:"stream.historySnapshots.a8"`
+	UserDevice   uint64 `mapstructure:"stream.historySnapshots.ad"`
+
+	// TrimUnarchived is 1 if miniblocks are trimmed without an archive node reporting that it archived them,
+	// for deployments without archive nodes. Otherwise only archived miniblocks are trimmed.
+	TrimUnarchived uint64 `mapstructure:"stream.historySnapshots.trimUnarchived"`
+}
+
+func (m HistorySnapshotsSettings) ForType(streamType byte) uint64 {
+	switch streamType {
+	case shared.STREAM_USER_INBOX_BIN:
+		return m.UserInbox
+	case shared.STREAM_USER_SETTINGS_BIN:
+		return m.UserSettings
+	case shared.STREAM_USER_BIN:
+		return m.User
+	case shared.STREAM_USER_METADATA_KEY_BIN:
+		return m.UserDevice
+	case shared.STREAM_MEDIA_BIN:
+		// Media streams are never trimmed, all chunks are required to read the media.
+		return 0
+	default:
+		return m.Default
+	}
+}
+
+const (
+	// StreamPlacementStrategyHash places streams on nodes chosen by the FNV hash of the stream id.
+	StreamPlacementStrategyHash = "hash"
+	// StreamPlacementStrategyWeighted places streams on nodes proportionally to their capacity weights
+	// and never places replicas of a stream in the same zone.
+	StreamPlacementStrategyWeighted = "weighted"
+)
+
+// StreamPlacementSettings configures how nodes are chosen for new streams.
+type StreamPlacementSettings struct {
+	// Strategy is either StreamPlacementStrategyHash or StreamPlacementStrategyWeighted.
+	// Empty value means StreamPlacementStrategyHash, other values are rejected as a config error.
+	Strategy string `mapstructure:"stream.placement.strategy"`
+
+	// Nodes is a JSON object that maps node addresses to their placement labels, e.g.
+	// {"0x1234...": {"zone": "us-east-1a", "weight": 4}}.
+	// Nodes without labels have weight 1 and their own zone.
+	Nodes string `mapstructure:"stream.placement.nodes"`
+}
+
+// NodePlacementLabels are operator-declared placement labels of a node.
+type NodePlacementLabels struct {
+	// Zone is the failure domain of the node. Replicas of a stream are never placed in the same zone.
+	Zone string `json:"zone"`
+	// Weight is the relative capacity of the node. Nodes with weight 0 don't receive new streams.
+	Weight *uint64 `json:"weight"`
+}
+
+// ParseNodes parses node placement labels.
+func (s StreamPlacementSettings) ParseNodes() (map[common.Address]NodePlacementLabels, error) {
+	if s.Nodes == "" {
+		return nil, nil
+	}
+	var raw map[string]NodePlacementLabels
+	if err := json.Unmarshal([]byte(s.Nodes), &raw); err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).
+			Message("Failed to parse stream placement node labels").
+			Tag("key", StreamPlacementNodesConfigKey)
+	}
+	labels := make(map[common.Address]NodePlacementLabels, len(raw))
+	for addr, l := range raw {
+		if !common.IsHexAddress(addr) {
+			return nil, RiverError(Err_BAD_CONFIG, "Invalid node address in stream placement node labels").
+				Tag("key", StreamPlacementNodesConfigKey).
+				Tag("address", addr)
+		}
+		labels[common.HexToAddress(addr)] = l
+	}
+	return labels, nil
+}
+
 type MembershipLimitsSettings struct {
 	GDM uint64 `mapstructure:"media.streamMembershipLimits.77"`
 	DM  uint64 `mapstructure:"media.streamMembershipLimits.88"`
@@ -2,6 +2,7 @@ package events
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"slices"
 
@@ -11,6 +12,7 @@ import (
 
 	. "github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/events/migrations"
+	"github.com/towns-protocol/towns/core/node/logging"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/shared"
 )
@@ -863,3 +865,41 @@ func applyKeyFulfillment(member *MemberPayload_Snapshot_Member, keyFulfillment *
 		}
 	}
 }
+
+// SnapshotAtMiniblock reconstructs the snapshot at the end of the last given miniblock.
+// The first miniblock must contain a snapshot and miniblocks must be consecutive.
+// Events of the following miniblocks are applied to a copy of this snapshot the same way as
+// when a new snapshot is created, i.e. events that can't be applied are logged and skipped.
+func SnapshotAtMiniblock(ctx context.Context, miniblocks []*MiniblockInfo) (*Snapshot, error) {
+	if len(miniblocks) == 0 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "no miniblocks").Func("SnapshotAtMiniblock")
+	}
+
+	base := miniblocks[0].Header().GetSnapshot()
+	if base == nil {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "first miniblock has no snapshot").
+			Tag("miniblockNum", miniblocks[0].Ref.Num).
+			Func("SnapshotAtMiniblock")
+	}
+	snapshot := proto.Clone(base).(*Snapshot)
+
+	for i, mb := range miniblocks[1:] {
+		header := mb.Header()
+		if header.MiniblockNum != miniblocks[0].Ref.Num+int64(i)+1 {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "miniblocks are not consecutive").
+				Tags("expectedMiniblockNum", miniblocks[0].Ref.Num+int64(i)+1, "miniblockNum", header.MiniblockNum).
+				Func("SnapshotAtMiniblock")
+		}
+		for j, e := range mb.Events() {
+			if err := Update_Snapshot(snapshot, e, header.MiniblockNum, header.EventNumOffset+int64(j)); err != nil {
+				logging.FromCtx(ctx).Errorw("Failed to update snapshot",
+					"error", err,
+					"miniblockNum", header.MiniblockNum,
+					"event", e.ShortDebugStr(),
+				)
+			}
+		}
+	}
+
+	return snapshot, nil
+}
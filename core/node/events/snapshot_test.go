@@ -287,3 +287,60 @@ func TestUpdateSnapshotFailsIfInception(t *testing.T) {
 	err = Update_Snapshot(snapshot, inception, 0, 1)
 	assert.Error(t, err)
 }
+
+func TestSnapshotAtMiniblock(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	wallet, _ := crypto.NewWallet(ctx)
+	streamId := UserStreamIdFromAddr(wallet.Address)
+	inception := make_Space_Inception(wallet, streamId, t)
+	userId, err := AddressHex(inception.Event.CreatorAddress)
+	require.NoError(err)
+
+	genesis, err := MakeGenesisMiniblock(wallet, []*ParsedEvent{inception})
+	require.NoError(err)
+	mb0, err := NewMiniblockInfoFromProto(genesis, NewParsedMiniblockInfoOpts())
+	require.NoError(err)
+
+	makeMiniblock := func(prev *MiniblockInfo, events ...*ParsedEvent) *MiniblockInfo {
+		mb, err := NewMiniblockInfoFromHeaderAndParsed(wallet, &MiniblockHeader{
+			MiniblockNum:      prev.Ref.Num + 1,
+			PrevMiniblockHash: prev.Ref.Hash[:],
+			EventNumOffset:    prev.Header().EventNumOffset + int64(len(prev.Events())) + 1,
+		}, events)
+		require.NoError(err)
+		return mb
+	}
+	mb1 := makeMiniblock(
+		mb0,
+		make_Space_Membership(wallet, MembershipOp_SO_JOIN, userId, mb0.Ref, t),
+		make_Space_Username(wallet, "bob", mb0.Ref, t),
+	)
+	mb2 := makeMiniblock(mb1, make_Space_Username(wallet, "alice", mb1.Ref, t))
+
+	snapshot, err := SnapshotAtMiniblock(ctx, []*MiniblockInfo{mb0})
+	require.NoError(err)
+	require.True(proto.Equal(mb0.Header().Snapshot, snapshot))
+
+	snapshot, err = SnapshotAtMiniblock(ctx, []*MiniblockInfo{mb0, mb1})
+	require.NoError(err)
+	member, err := findMember(snapshot.Members.Joined, inception.Event.CreatorAddress)
+	require.NoError(err)
+	require.Equal("bob", member.Username.Data.Ciphertext)
+
+	snapshot, err = SnapshotAtMiniblock(ctx, []*MiniblockInfo{mb0, mb1, mb2})
+	require.NoError(err)
+	member, err = findMember(snapshot.Members.Joined, inception.Event.CreatorAddress)
+	require.NoError(err)
+	require.Equal("alice", member.Username.Data.Ciphertext)
+
+	// Snapshot in the header is not modified.
+	_, err = findMember(mb0.Header().Snapshot.Members.Joined, inception.Event.CreatorAddress)
+	require.Error(err)
+
+	_, err = SnapshotAtMiniblock(ctx, []*MiniblockInfo{mb0, mb2})
+	require.Error(err)
+	_, err = SnapshotAtMiniblock(ctx, []*MiniblockInfo{mb1, mb2})
+	require.Error(err)
+}
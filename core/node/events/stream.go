@@ -86,6 +86,17 @@ type localStreamState struct {
 	// are never scrubbed will not have this value modified.
 	lastScrubbedTime time.Time
 
+	// lastTrimmedSnapshotNum is the number of the latest snapshot miniblock for which the
+	// stream history retention policy was applied.
+	lastTrimmedSnapshotNum int64
+
+	// trimmedBefore is the miniblock number before which the stream history was trimmed.
+	trimmedBefore int64
+
+	// archivedMiniblocks is the number of miniblocks an archive node reported as archived,
+	// miniblocks from it on are not trimmed unless the retention policy allows trimming unarchived miniblocks.
+	archivedMiniblocks int64
+
 	receivers mapset.Set[SyncResultReceiver]
 
 	// pendingCandidates contains list of miniblocks that should be applied immediately when candidate is received.
@@ -267,6 +278,7 @@ func (s *Stream) importMiniblocksLocked(
 	}
 
 	s.setView(currentView)
+	s.indexMiniblocks(miniblocks...)
 	newSyncCookie := s.view().SyncCookie(s.params.Wallet.Address)
 	s.notifySubscribersLocked(allNewEvents, newSyncCookie)
 	return nil
@@ -319,6 +331,7 @@ func (s *Stream) applyMiniblockImplLocked(
 	}
 
 	s.setView(newSV)
+	s.indexMiniblocks(miniblock)
 	newSyncCookie := s.view().SyncCookie(s.params.Wallet.Address)
 
 	newEvents = append(newEvents, miniblock.headerEvent.Envelope)
@@ -326,6 +339,13 @@ func (s *Stream) applyMiniblockImplLocked(
 	return nil
 }
 
+// indexMiniblocks passes miniblocks written to storage to the event indexer if it is enabled.
+func (s *Stream) indexMiniblocks(miniblocks ...*MiniblockInfo) {
+	if s.params.EventIndexer != nil && len(miniblocks) > 0 {
+		s.params.EventIndexer.IndexMiniblocks(s.streamId, miniblocks)
+	}
+}
+
 // promoteCandidate is thread-safe.
 func (s *Stream) promoteCandidate(ctx context.Context, mb *MiniblockRef) error {
 	s.mu.Lock()
@@ -444,6 +464,7 @@ func (s *Stream) initFromGenesis(
 		return err
 	}
 	s.setView(view)
+	s.indexMiniblocks(genesisInfo)
 
 	return nil
 }
@@ -497,6 +518,7 @@ func (s *Stream) initFromBlockchain(ctx context.Context) error {
 		return err
 	}
 	s.setView(view)
+	s.indexMiniblocks(view.blocks...)
 	return nil
 }
 
@@ -646,6 +668,71 @@ func (s *Stream) GetMiniblocks(
 	return miniblocks, terminus, nil
 }
 
+// GetSnapshotAt reconstructs the stream snapshot at the end of the given miniblock from the nearest
+// preceding miniblock with a snapshot. Miniblocks are read directly from storage, bypassing the cache.
+// Returns the snapshot, the requested miniblock and the number of the miniblock the snapshot was
+// reconstructed from.
+// GetSnapshotAt is thread-safe.
+func (s *Stream) GetSnapshotAt(ctx context.Context, miniblockNum int64) (*Snapshot, *MiniblockInfo, int64, error) {
+	if miniblockNum < 0 {
+		return nil, nil, 0, RiverError(Err_INVALID_ARGUMENT, "invalid miniblock number").
+			Tags("streamId", s.streamId, "miniblockNum", miniblockNum).
+			Func("GetSnapshotAt")
+	}
+
+	blocks, err := s.params.Storage.ReadMiniblocks(ctx, s.streamId, miniblockNum, miniblockNum+1)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if len(blocks) == 0 {
+		return nil, nil, 0, RiverError(Err_NOT_FOUND, "miniblock not found").
+			Tags("streamId", s.streamId, "miniblockNum", miniblockNum).
+			Func("GetSnapshotAt")
+	}
+	target, err := NewMiniblockInfoFromBytes(blocks[0], miniblockNum)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	snapshotMiniblockNum := miniblockNum
+	if !target.IsSnapshot() {
+		snapshotMiniblockNum = target.Header().PrevSnapshotMiniblockNum
+	}
+
+	miniblocks := make([]*MiniblockInfo, 0, miniblockNum-snapshotMiniblockNum+1)
+	if snapshotMiniblockNum < miniblockNum {
+		blocks, err = s.params.Storage.ReadMiniblocks(ctx, s.streamId, snapshotMiniblockNum, miniblockNum)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if int64(len(blocks)) != miniblockNum-snapshotMiniblockNum {
+			return nil, nil, 0, RiverError(
+				Err_NOT_FOUND,
+				"miniblocks since the preceding snapshot are not available",
+			).Tags(
+				"streamId", s.streamId,
+				"miniblockNum", miniblockNum,
+				"snapshotMiniblockNum", snapshotMiniblockNum,
+				"numMiniblocks", len(blocks),
+			).Func("GetSnapshotAt")
+		}
+		for i, bytes := range blocks {
+			mb, err := NewMiniblockInfoFromBytes(bytes, snapshotMiniblockNum+int64(i))
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			miniblocks = append(miniblocks, mb)
+		}
+	}
+	miniblocks = append(miniblocks, target)
+
+	snapshot, err := SnapshotAtMiniblock(ctx, miniblocks)
+	if err != nil {
+		return nil, nil, 0, AsRiverError(err).Tag("streamId", s.streamId)
+	}
+	return snapshot, target, snapshotMiniblockNum, nil
+}
+
 // AddEvent adds an event to the stream.
 // AddEvent is thread-safe.
 func (s *Stream) AddEvent(ctx context.Context, event *ParsedEvent) error {
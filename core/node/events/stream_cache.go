@@ -32,6 +32,12 @@ type Scrubber interface {
 	Scrub(channelId StreamId) bool
 }
 
+// EventIndexer receives miniblocks of local streams after they are written to storage.
+// IndexMiniblocks must not block.
+type EventIndexer interface {
+	IndexMiniblocks(streamId StreamId, miniblocks []*MiniblockInfo)
+}
+
 type StreamCacheParams struct {
 	Storage                 storage.StreamStorage
 	Wallet                  *crypto.Wallet
@@ -46,6 +52,7 @@ type StreamCacheParams struct {
 	Scrubber                Scrubber
 	NodeRegistry            NodeRegistry
 	Tracer                  trace.Tracer
+	EventIndexer            EventIndexer // optional, nil if the event index is disabled
 }
 
 type StreamCache struct {
@@ -147,6 +154,8 @@ func (s *StreamCache) Start(ctx context.Context) error {
 
 	go s.runCacheCleanup(ctx)
 
+	go s.runHistoryTrimming(ctx)
+
 	go func() {
 		<-ctx.Done()
 		s.onlineSyncWorkerPool.Stop()
@@ -175,9 +184,11 @@ func (s *StreamCache) onBlockWithLogs(ctx context.Context, blockNum crypto.Block
 		default:
 			stream, ok := s.cache.Load(streamId)
 			if !ok {
+				s.onStreamPlacedOnLocalNode(ctx, streamId, events, blockNum)
 				continue
 			}
 			stream.applyStreamEvents(ctx, events, blockNum)
+			s.onLocalPlacementChanged(ctx, stream, blockNum)
 		}
 	}
 
@@ -392,6 +403,7 @@ func (s *StreamCache) createStreamStorage(
 			return nil, false, err
 		}
 		stream.setView(view)
+		stream.indexMiniblocks(view.blocks...)
 
 		return stream, true, nil
 	} else {
@@ -0,0 +1,142 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// historyTrimInterval is how often local streams are checked for history that can be trimmed.
+const historyTrimInterval = time.Minute
+
+// runHistoryTrimming periodically trims history of local streams according to the
+// stream history retention policy from the on-chain configuration.
+func (s *StreamCache) runHistoryTrimming(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+
+	for {
+		select {
+		case <-time.After(historyTrimInterval):
+			s.TrimHistory(ctx)
+		case <-ctx.Done():
+			log.Debugw("stream cache history trimming shutdown")
+			return
+		}
+	}
+}
+
+// TrimHistory removes miniblocks that are older than the configured number of snapshots
+// from storage for all local streams with a loaded view.
+// Streams are only trimmed once per new snapshot.
+// Miniblocks that no archive node reported as archived are kept, see Stream.ReportArchived.
+func (s *StreamCache) TrimHistory(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+	cfg := s.params.ChainConfig.Get()
+
+	s.cache.Range(func(streamId StreamId, stream *Stream) bool {
+		snapshotsToKeep := cfg.HistorySnapshots.ForType(streamId.Type())
+		if snapshotsToKeep == 0 {
+			return true
+		}
+		if err := stream.trimHistory(ctx, snapshotsToKeep, cfg.HistorySnapshots.TrimUnarchived == 1); err != nil {
+			log.Warnw("Failed to trim stream history", "streamId", streamId, "err", err)
+		}
+		return ctx.Err() == nil
+	})
+}
+
+// ReportArchived records that an archive node archived the first archivedMiniblocks miniblocks of the stream.
+// ReportArchived is thread-safe.
+func (s *Stream) ReportArchived(archivedMiniblocks int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.local != nil {
+		s.local.archivedMiniblocks = max(s.local.archivedMiniblocks, archivedMiniblocks)
+	}
+}
+
+// trimHistory removes miniblocks before the snapshotsToKeep-th latest snapshot from storage.
+// Unless trimUnarchived is set miniblocks that are not archived yet are kept, the snapshot is then
+// trimmed again after the archive node reported more archived miniblocks.
+// trimHistory is thread-safe.
+func (s *Stream) trimHistory(ctx context.Context, snapshotsToKeep uint64, trimUnarchived bool) error {
+	s.mu.RLock()
+	if s.local == nil || s.view() == nil {
+		s.mu.RUnlock()
+		return nil
+	}
+	view := s.view()
+	lastTrimmedSnapshot := s.local.lastTrimmedSnapshotNum
+	archivedMiniblocks := s.local.archivedMiniblocks
+	trimmedBefore := s.local.trimmedBefore
+	s.mu.RUnlock()
+
+	snapshot := view.blocks[view.snapshotIndex]
+	if snapshot.Ref.Num <= lastTrimmedSnapshot {
+		return nil
+	}
+
+	trimBefore := snapshot.Ref.Num
+	prevSnapshot := snapshot.Header().PrevSnapshotMiniblockNum
+	for i := uint64(1); i < snapshotsToKeep && trimBefore > 0; i++ {
+		trimBefore = prevSnapshot
+		if trimBefore == 0 {
+			break
+		}
+
+		mb, err := s.readHistoryMiniblock(ctx, view, trimBefore)
+		if err != nil {
+			if IsRiverErrorCode(err, Err_MINIBLOCKS_TRIMMED) {
+				// Stream is already trimmed past this point.
+				trimBefore = 0
+				break
+			}
+			return err
+		}
+		prevSnapshot = mb.Header().PrevSnapshotMiniblockNum
+	}
+
+	// miniblocks that are not archived yet are kept, the rest of the snapshot is trimmed on a later run
+	done := true
+	if !trimUnarchived && trimBefore > archivedMiniblocks {
+		trimBefore = archivedMiniblocks
+		done = false
+	}
+
+	if trimBefore > trimmedBefore {
+		if err := s.params.Storage.TrimStream(ctx, s.streamId, trimBefore); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	if s.local != nil {
+		s.local.trimmedBefore = max(s.local.trimmedBefore, trimBefore)
+		if done {
+			s.local.lastTrimmedSnapshotNum = max(s.local.lastTrimmedSnapshotNum, snapshot.Ref.Num)
+		}
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+// readHistoryMiniblock returns the miniblock with the given number from the view if present,
+// or reads it from storage otherwise.
+func (s *Stream) readHistoryMiniblock(ctx context.Context, view *StreamView, num int64) (*MiniblockInfo, error) {
+	if first := view.blocks[0].Ref.Num; num >= first {
+		return view.blocks[num-first], nil
+	}
+
+	blocks, err := s.params.Storage.ReadMiniblocks(ctx, s.streamId, num, num+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, RiverError(Err_NOT_FOUND, "Miniblock not found", "streamId", s.streamId, "miniblockNum", num)
+	}
+	return NewMiniblockInfoFromBytes(blocks[0], num)
+}
@@ -0,0 +1,143 @@
+package events
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/towns-protocol/towns/core/contracts/river"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/registries"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// ReplicateStream copies miniblocks of the stream from the nodes in its current placement into
+// local storage, so this node can be added to the stream placement without losing history.
+// Miniblocks that are already in local storage are not fetched again.
+func (s *StreamCache) ReplicateStream(ctx context.Context, record *registries.GetStreamResult) error {
+	if slices.Contains(record.Nodes, s.params.Wallet.Address) {
+		return RiverError(Err_FAILED_PRECONDITION, "Stream is already placed on local node").
+			Tag("streamId", record.StreamId).
+			Func("ReplicateStream")
+	}
+
+	// Stream is not in the cache as a local stream until it is placed on this node,
+	// so detached stream object is used to import miniblocks into storage.
+	stream := &Stream{
+		params:              s.params,
+		streamId:            record.StreamId,
+		lastAppliedBlockNum: s.params.AppliedBlockNum,
+		lastAccessedTime:    time.Now(),
+		local:               &localStreamState{},
+	}
+	stream.nodesLocked.Reset(record.Nodes, s.params.Wallet.Address)
+
+	lastMiniblockNum, err := s.params.Storage.GetLastMiniblockNumber(ctx, record.StreamId)
+	if err != nil {
+		if !IsRiverErrorCode(err, Err_NOT_FOUND) {
+			return err
+		}
+		lastMiniblockNum = -1
+	}
+
+	fromInclusive := lastMiniblockNum + 1
+	toExclusive := int64(record.LastMiniblockNum) + 1
+	if fromInclusive >= toExclusive {
+		return nil
+	}
+
+	remote := stream.GetStickyPeer()
+	nextFromInclusive := fromInclusive
+	for range record.Nodes {
+		nextFromInclusive, err = s.syncStreamFromSinglePeer(ctx, stream, remote, nextFromInclusive, toExclusive)
+		if err == nil && nextFromInclusive >= toExclusive {
+			return nil
+		}
+		remote = stream.AdvanceStickyPeer(remote)
+	}
+
+	return AsRiverError(err, Err_UNAVAILABLE).
+		Tags("streamId", record.StreamId, "missingFromInclusive", nextFromInclusive, "missingToExclusive", toExclusive).
+		Message("No peer could provide miniblocks for stream replication")
+}
+
+// onStreamPlacedOnLocalNode is called when stream that is not in the cache is placed on the local node,
+// i.e. when the stream is moved to this node. Stream is added to the cache and missing miniblocks
+// are synced from peers.
+func (s *StreamCache) onStreamPlacedOnLocalNode(
+	ctx context.Context,
+	streamId StreamId,
+	events []river.EventWithStreamId,
+	blockNum crypto.BlockNumber,
+) {
+	placed := slices.ContainsFunc(events, func(e river.EventWithStreamId) bool {
+		event, ok := e.(*river.StreamPlacementUpdated)
+		return ok && event.IsAdded && event.NodeAddress == s.params.Wallet.Address
+	})
+	if !placed {
+		return
+	}
+
+	record, err := s.params.Registry.GetStream(ctx, streamId, blockNum)
+	if err != nil {
+		logging.FromCtx(ctx).Errorw("Failed to read stream placed on local node", "streamId", streamId, "err", err)
+		return
+	}
+
+	stream := &Stream{
+		params:              s.params,
+		streamId:            streamId,
+		lastAppliedBlockNum: blockNum,
+		lastAccessedTime:    time.Now(),
+		local:               &localStreamState{},
+	}
+	stream.nodesLocked.Reset(record.Nodes, s.params.Wallet.Address)
+	if !stream.nodesLocked.IsLocal() {
+		// Local node was removed from the placement in the same block.
+		return
+	}
+
+	if _, loaded := s.cache.LoadOrStore(streamId, stream); loaded {
+		return
+	}
+	s.submitSyncStreamTask(ctx, s.onlineSyncWorkerPool, stream, record)
+}
+
+// onLocalPlacementChanged updates the local state of the stream after the stream was placed on
+// or removed from the local node.
+func (s *StreamCache) onLocalPlacementChanged(ctx context.Context, stream *Stream, blockNum crypto.BlockNumber) {
+	stream.mu.Lock()
+	isLocal := stream.nodesLocked.IsLocal()
+	wasLocal := stream.local != nil
+	if isLocal == wasLocal {
+		stream.mu.Unlock()
+		return
+	}
+
+	if isLocal {
+		stream.local = &localStreamState{}
+		stream.mu.Unlock()
+
+		record, err := s.params.Registry.GetStream(ctx, stream.streamId, blockNum)
+		if err != nil {
+			logging.FromCtx(ctx).Errorw("Failed to read stream placed on local node", "streamId", stream.streamId, "err", err)
+			return
+		}
+		s.submitSyncStreamTask(ctx, s.onlineSyncWorkerPool, stream, record)
+		return
+	}
+
+	// Stream was moved away from this node, subscribers have to resubscribe to the new placement.
+	stream.setView(nil)
+	if stream.local.receivers != nil {
+		err := RiverError(Err_UNAVAILABLE, "Stream was moved from this node", "streamId", stream.streamId)
+		for r := range stream.local.receivers.Iter() {
+			r.OnSyncError(err)
+		}
+	}
+	stream.local = nil
+	stream.mu.Unlock()
+}
@@ -873,6 +873,21 @@ func (r *StreamView) CopyAndPrependMiniblocks(mbs []*MiniblockInfo) (*StreamView
 	}, nil
 }
 
+// HasEvent returns true if the event with the given hash is in the minipool or in one of the miniblocks of the view.
+func (r *StreamView) HasEvent(hash common.Hash) bool {
+	if r.minipool.events.Has(hash) {
+		return true
+	}
+	for i := len(r.blocks) - 1; i >= 0; i-- {
+		for _, e := range r.blocks[i].Events() {
+			if e.Hash == hash {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (r *StreamView) AllEvents() iter.Seq[*ParsedEvent] {
 	return func(yield func(*ParsedEvent) bool) {
 		for _, block := range r.blocks {
@@ -0,0 +1,178 @@
+package nodes
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// StreamPlacementStrategy chooses nodes that should store a new stream.
+type StreamPlacementStrategy interface {
+	// ChooseStreamNodes returns replFactor addresses from the given operational nodes.
+	// The nodes slice may be reordered.
+	ChooseStreamNodes(streamId StreamId, nodes []common.Address, replFactor int) ([]common.Address, error)
+}
+
+// NewStreamPlacementStrategy returns the placement strategy configured in the given on-chain settings.
+// Empty strategy selects the hash strategy, unknown strategies are a config error.
+func NewStreamPlacementStrategy(settings crypto.StreamPlacementSettings) (StreamPlacementStrategy, error) {
+	switch settings.Strategy {
+	case "", crypto.StreamPlacementStrategyHash:
+		return hashPlacement{}, nil
+	case crypto.StreamPlacementStrategyWeighted:
+		labels, err := settings.ParseNodes()
+		if err != nil {
+			return nil, err
+		}
+		return &weightedPlacement{labels: labels}, nil
+	default:
+		return nil, RiverError(Err_BAD_CONFIG, "Unknown stream placement strategy").
+			Tag("key", crypto.StreamPlacementStrategyConfigKey).
+			Tag("strategy", settings.Strategy)
+	}
+}
+
+// hashPlacement chooses nodes using FNV hash of the stream id over all operational nodes.
+type hashPlacement struct{}
+
+func (hashPlacement) ChooseStreamNodes(
+	streamId StreamId,
+	nodes []common.Address,
+	replFactor int,
+) ([]common.Address, error) {
+	if len(nodes) < replFactor {
+		return nil, RiverError(
+			Err_BAD_CONFIG,
+			"replication factor is greater than number of operational nodes",
+			"replication_factor",
+			replFactor,
+			"num_nodes",
+			len(nodes),
+		)
+	}
+
+	h := fnv.New64a()
+	addrs := make([]common.Address, replFactor)
+	for i := 0; i < replFactor; i++ {
+		h.Write(streamId[:])
+		index := i + int(h.Sum64()%uint64(len(nodes)-i))
+		tt := nodes[index]
+		nodes[index] = nodes[i]
+		nodes[i] = tt
+		addrs[i] = nodes[i]
+	}
+
+	return addrs, nil
+}
+
+// weightedPlacement chooses nodes using weighted rendezvous hashing: each node gets a score
+// derived from the hash of the stream id and the node address scaled by the node weight,
+// and nodes with the highest scores are chosen. Nodes with larger weights win proportionally
+// more often. Only one node is chosen from each zone.
+//
+// Rendezvous hashing keeps placement of most streams stable when nodes are added or removed.
+type weightedPlacement struct {
+	labels map[common.Address]crypto.NodePlacementLabels
+}
+
+// zone returns the failure domain of the node. Nodes without zone label are in their own zone.
+func (p *weightedPlacement) zone(addr common.Address) string {
+	if zone := p.labels[addr].Zone; zone != "" {
+		return zone
+	}
+	return "node:" + addr.Hex()
+}
+
+// weight returns the capacity weight of the node. Nodes without weight label have weight 1.
+func (p *weightedPlacement) weight(addr common.Address) uint64 {
+	if w := p.labels[addr].Weight; w != nil {
+		return *w
+	}
+	return 1
+}
+
+// rendezvousScore returns the weighted rendezvous hashing score of the node for the stream.
+func rendezvousScore(streamId StreamId, addr common.Address, weight uint64) float64 {
+	h := sha256.New()
+	h.Write(streamId[:])
+	h.Write(addr[:])
+	sum := binary.BigEndian.Uint64(h.Sum(nil))
+
+	// Map hash to (0, 1) and compute -weight / ln(u), so the probability of the node having
+	// the highest score is proportional to its weight.
+	u := (float64(sum>>11) + 0.5) / float64(uint64(1)<<53)
+	return -float64(weight) / math.Log(u)
+}
+
+func (p *weightedPlacement) ChooseStreamNodes(
+	streamId StreamId,
+	nodes []common.Address,
+	replFactor int,
+) ([]common.Address, error) {
+	type candidate struct {
+		addr  common.Address
+		zone  string
+		score float64
+	}
+
+	candidates := make([]candidate, 0, len(nodes))
+	zones := make(map[string]struct{})
+	for _, n := range nodes {
+		weight := p.weight(n)
+		if weight == 0 {
+			continue
+		}
+		c := candidate{
+			addr:  n,
+			zone:  p.zone(n),
+			score: rendezvousScore(streamId, n, weight),
+		}
+		candidates = append(candidates, c)
+		zones[c.zone] = struct{}{}
+	}
+
+	if len(zones) < replFactor {
+		return nil, RiverError(
+			Err_BAD_CONFIG,
+			"replication factor is greater than number of zones with operational nodes",
+			"replication_factor",
+			replFactor,
+			"num_zones",
+			len(zones),
+			"num_nodes",
+			len(candidates),
+		)
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		return a.addr.Cmp(b.addr)
+	})
+
+	addrs := make([]common.Address, 0, replFactor)
+	usedZones := make(map[string]struct{}, replFactor)
+	for _, c := range candidates {
+		if _, used := usedZones[c.zone]; used {
+			continue
+		}
+		usedZones[c.zone] = struct{}{}
+		addrs = append(addrs, c.addr)
+		if len(addrs) == replFactor {
+			break
+		}
+	}
+
+	return addrs, nil
+}
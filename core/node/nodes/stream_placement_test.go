@@ -0,0 +1,117 @@
+package nodes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func makePlacementTestNodes(n int) []common.Address {
+	nodes := make([]common.Address, n)
+	for i := range nodes {
+		nodes[i] = common.BytesToAddress([]byte(fmt.Sprintf("node%d", i)))
+	}
+	return nodes
+}
+
+func TestHashPlacementIsDefault(t *testing.T) {
+	require := require.New(t)
+
+	strategy, err := NewStreamPlacementStrategy(crypto.StreamPlacementSettings{})
+	require.NoError(err)
+	require.IsType(hashPlacement{}, strategy)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	addrs, err := strategy.ChooseStreamNodes(streamId, makePlacementTestNodes(5), 3)
+	require.NoError(err)
+	require.Len(addrs, 3)
+
+	// Placement is deterministic.
+	again, err := strategy.ChooseStreamNodes(streamId, makePlacementTestNodes(5), 3)
+	require.NoError(err)
+	require.Equal(addrs, again)
+
+	_, err = strategy.ChooseStreamNodes(streamId, makePlacementTestNodes(2), 3)
+	require.Equal(Err_BAD_CONFIG, AsRiverError(err).Code)
+}
+
+func TestUnknownPlacementStrategy(t *testing.T) {
+	require := require.New(t)
+
+	strategy, err := NewStreamPlacementStrategy(crypto.StreamPlacementSettings{
+		Strategy: crypto.StreamPlacementStrategyHash,
+	})
+	require.NoError(err)
+	require.IsType(hashPlacement{}, strategy)
+
+	_, err = NewStreamPlacementStrategy(crypto.StreamPlacementSettings{Strategy: "wieghted"})
+	require.Equal(Err_BAD_CONFIG, AsRiverError(err).Code)
+}
+
+func TestWeightedPlacement(t *testing.T) {
+	require := require.New(t)
+
+	nodes := makePlacementTestNodes(6)
+	settings := crypto.StreamPlacementSettings{
+		Strategy: crypto.StreamPlacementStrategyWeighted,
+		Nodes: fmt.Sprintf(
+			`{"%s": {"zone": "a", "weight": 3}, "%s": {"zone": "a"}, "%s": {"zone": "b"},`+
+				`"%s": {"zone": "b"}, "%s": {"zone": "c", "weight": 0}}`,
+			nodes[0].Hex(),
+			nodes[1].Hex(),
+			nodes[2].Hex(),
+			nodes[3].Hex(),
+			nodes[4].Hex(),
+		),
+	}
+	strategy, err := NewStreamPlacementStrategy(settings)
+	require.NoError(err)
+
+	zoneOf := map[common.Address]string{
+		nodes[0]: "a",
+		nodes[1]: "a",
+		nodes[2]: "b",
+		nodes[3]: "b",
+		nodes[5]: "unlabeled",
+	}
+
+	counts := map[common.Address]int{}
+	for range 4000 {
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		addrs, err := strategy.ChooseStreamNodes(streamId, nodes, 3)
+		require.NoError(err)
+		require.Len(addrs, 3)
+
+		zones := map[string]bool{}
+		for _, addr := range addrs {
+			zone, ok := zoneOf[addr]
+			require.True(ok, "node with weight 0 must not be chosen")
+			require.False(zones[zone], "replicas must be in different zones")
+			zones[zone] = true
+			counts[addr]++
+		}
+	}
+
+	// Node with weight 3 receives about 3 times more streams than its zone peer with weight 1.
+	ratio := float64(counts[nodes[0]]) / float64(counts[nodes[1]])
+	require.InDelta(3.0, ratio, 0.5)
+
+	// Only 3 zones have nodes with non-zero weight.
+	_, err = strategy.ChooseStreamNodes(testutils.FakeStreamId(STREAM_CHANNEL_BIN), nodes, 4)
+	require.Equal(Err_BAD_CONFIG, AsRiverError(err).Code)
+
+	// Bad labels are reported.
+	_, err = NewStreamPlacementStrategy(crypto.StreamPlacementSettings{
+		Strategy: crypto.StreamPlacementStrategyWeighted,
+		Nodes:    `{"not an address": {}}`,
+	})
+	require.Equal(Err_BAD_CONFIG, AsRiverError(err).Code)
+}
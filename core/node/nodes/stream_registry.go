@@ -2,14 +2,11 @@ package nodes
 
 import (
 	"context"
-	"hash/fnv"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/towns-protocol/towns/core/contracts/river"
-	. "github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/crypto"
-	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/registries"
 	. "github.com/towns-protocol/towns/core/node/shared"
 )
@@ -100,37 +97,19 @@ func (sr *streamRegistryImpl) AddStream(
 
 func (sr *streamRegistryImpl) ChooseStreamNodes(streamId StreamId) ([]common.Address, error) {
 	allNodes := sr.nodeRegistry.GetAllNodes()
-	nodes := make([]*NodeRecord, 0, len(allNodes))
+	nodes := make([]common.Address, 0, len(allNodes))
 
 	for _, n := range allNodes {
 		if n.Status() == river.NodeStatus_Operational {
-			nodes = append(nodes, n)
+			nodes = append(nodes, n.Address())
 		}
 	}
 
-	replFactor := int(sr.onChainConfig.Get().ReplicationFactor)
-
-	if len(nodes) < replFactor {
-		return nil, RiverError(
-			Err_BAD_CONFIG,
-			"replication factor is greater than number of operational nodes",
-			"replication_factor",
-			replFactor,
-			"num_nodes",
-			len(nodes),
-		)
-	}
-
-	h := fnv.New64a()
-	addrs := make([]common.Address, replFactor)
-	for i := 0; i < replFactor; i++ {
-		h.Write(streamId[:])
-		index := i + int(h.Sum64()%uint64(len(nodes)-i))
-		tt := nodes[index]
-		nodes[index] = nodes[i]
-		nodes[i] = tt
-		addrs[i] = nodes[i].Address()
+	cfg := sr.onChainConfig.Get()
+	strategy, err := NewStreamPlacementStrategy(cfg.StreamPlacement)
+	if err != nil {
+		return nil, err
 	}
 
-	return addrs, nil
+	return strategy.ChooseStreamNodes(streamId, nodes, int(cfg.ReplicationFactor))
 }
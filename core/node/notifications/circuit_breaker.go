@@ -0,0 +1,68 @@
+package notifications
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	circuitClosed   = "closed"
+	circuitOpen     = "open"
+	circuitHalfOpen = "half_open"
+)
+
+// circuitBreaker pauses deliveries through a push provider after too many consecutive failures.
+// After the cooldown a single trial delivery is allowed, if it succeeds the circuit is closed again,
+// otherwise the circuit stays open for another cooldown period.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	threshold           int
+	cooldown            time.Duration
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// limit returns the number of deliveries that are allowed, given that batchSize is allowed when the circuit is closed.
+func (cb *circuitBreaker) limit(now time.Time, batchSize int) int {
+	switch cb.state(now) {
+	case circuitOpen:
+		return 0
+	case circuitHalfOpen:
+		return 1
+	default:
+		return batchSize
+	}
+}
+
+func (cb *circuitBreaker) state(now time.Time) string {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch {
+	case cb.consecutiveFailures < cb.threshold:
+		return circuitClosed
+	case now.Before(cb.openUntil):
+		return circuitOpen
+	default:
+		return circuitHalfOpen
+	}
+}
+
+func (cb *circuitBreaker) success() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.consecutiveFailures = 0
+}
+
+func (cb *circuitBreaker) failure(now time.Time) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.consecutiveFailures++
+	if cb.consecutiveFailures >= cb.threshold {
+		cb.openUntil = now.Add(cb.cooldown)
+	}
+}
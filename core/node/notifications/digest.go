@@ -0,0 +1,187 @@
+package notifications
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+const (
+	// digestPollInterval is how often due digests are looked up when no digest window closed.
+	digestPollInterval = 5 * time.Second
+	// digestBatchSize is the maximum number of digests that are claimed at once.
+	digestBatchSize = 100
+	// digestClaimLease is how long a claimed digest is not returned to other instances.
+	digestClaimLease = time.Minute
+)
+
+type (
+	// channelDigest collects the messages in a space channel for which a user wants a single notification.
+	channelDigest struct {
+		user      common.Address
+		spaceID   shared.StreamId
+		channelID shared.StreamId
+		// event is the latest message of the digest, it is included in the notification.
+		event   *events.ParsedEvent
+		kind    string
+		members mapset.Set[string]
+		// count is the number of messages the digest notification is sent for.
+		count int
+	}
+
+	// channelDigester collapses notifications per user and channel. The first message for a user and channel
+	// opens a window, when the window closes flush is called with all messages that were added in the window.
+	//
+	// Digests are persisted in the store and survive restarts and shard moves. Flush returns false when the
+	// digest must be sent by another instance, the digest is then claimed again after its claim expired.
+	channelDigester struct {
+		store  storage.NotificationDigestStore
+		window time.Duration
+		flush  func(*channelDigest) bool
+		wake   chan struct{}
+	}
+)
+
+func newChannelDigester(
+	store storage.NotificationDigestStore,
+	window time.Duration,
+	flush func(*channelDigest) bool,
+) *channelDigester {
+	return &channelDigester{
+		store:  store,
+		window: window,
+		flush:  flush,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// add adds the message to the digest of the user for the channel and returns the number of messages
+// that are in the digest. If lease is not nil the message is only added when the shard lease is held.
+func (d *channelDigester) add(
+	ctx context.Context,
+	lease *storage.ShardLease,
+	user common.Address,
+	spaceID shared.StreamId,
+	channelID shared.StreamId,
+	event *events.ParsedEvent,
+	kind string,
+	members mapset.Set[string],
+) (int, error) {
+	envelope, err := proto.Marshal(event.Envelope)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := d.store.AddToDigest(ctx, lease, &storage.NotificationDigest{
+		UserID:    user,
+		SpaceID:   spaceID,
+		ChannelID: channelID,
+		Event:     envelope,
+		Kind:      kind,
+		Members:   members.ToSlice(),
+	}, d.window)
+	if err != nil {
+		return 0, err
+	}
+
+	// flush the digest as soon as its window closes instead of waiting for the next poll
+	if count == 1 {
+		time.AfterFunc(d.window, func() {
+			select {
+			case d.wake <- struct{}{}:
+			default:
+			}
+		})
+	}
+
+	return count, nil
+}
+
+// run flushes due digests until ctx is cancelled.
+func (d *channelDigester) run(ctx context.Context) {
+	ticker := time.NewTicker(digestPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+
+		// keep going while full batches are claimed to drain a backlog without waiting for the ticker
+		for d.flushDue(ctx) {
+		}
+	}
+}
+
+// flushDue flushes a batch of due digests and returns true if more digests are likely due.
+func (d *channelDigester) flushDue(ctx context.Context) bool {
+	log := logging.FromCtx(ctx)
+
+	digests, err := d.store.ClaimDigests(ctx, digestBatchSize, digestClaimLease)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Errorw("Unable to claim notification digests", "err", err)
+		}
+		return false
+	}
+
+	for _, stored := range digests {
+		digest, err := parseChannelDigest(stored)
+		if err != nil {
+			// the digest can never be sent, drop it
+			log.Errorw("Unable to parse notification digest",
+				"user", stored.UserID, "channel", stored.ChannelID, "err", err)
+		} else if !d.flush(digest) {
+			continue
+		}
+
+		if err := d.store.CompleteDigest(ctx, stored, d.window); err != nil {
+			log.Errorw("Unable to complete notification digest",
+				"user", stored.UserID, "channel", stored.ChannelID, "err", err)
+		}
+	}
+
+	return len(digests) == digestBatchSize
+}
+
+func parseChannelDigest(digest *storage.NotificationDigest) (*channelDigest, error) {
+	var envelope Envelope
+	if err := proto.Unmarshal(digest.Event, &envelope); err != nil {
+		return nil, err
+	}
+	event, err := events.ParseEvent(&envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channelDigest{
+		user:      digest.UserID,
+		spaceID:   digest.SpaceID,
+		channelID: digest.ChannelID,
+		event:     event,
+		kind:      digest.Kind,
+		members:   mapset.NewSet(digest.Members...),
+		count:     digest.Count,
+	}, nil
+}
+
+// digestCollapseID returns the id that is used to collapse the digest notifications of a channel on the
+// device. It is a valid APN collapse id (max 64 bytes) and Web Push topic (max 32 URL safe base64 characters).
+func digestCollapseID(channelID shared.StreamId) string {
+	h := sha256.Sum256(channelID[:])
+	return base64.RawURLEncoding.EncodeToString(h[:24])
+}
@@ -0,0 +1,244 @@
+package notifications
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+// memoryDigestStore is an in-memory storage.NotificationDigestStore.
+type memoryDigestStore struct {
+	mu      sync.Mutex
+	digests map[channelDigestKey]*memoryDigest
+}
+
+type (
+	channelDigestKey struct {
+		user    common.Address
+		channel shared.StreamId
+	}
+
+	memoryDigest struct {
+		digest    storage.NotificationDigest
+		deliverAt time.Time
+	}
+)
+
+func newMemoryDigestStore() *memoryDigestStore {
+	return &memoryDigestStore{digests: make(map[channelDigestKey]*memoryDigest)}
+}
+
+func (s *memoryDigestStore) AddToDigest(
+	_ context.Context,
+	_ *storage.ShardLease,
+	digest *storage.NotificationDigest,
+	window time.Duration,
+) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := channelDigestKey{user: digest.UserID, channel: digest.ChannelID}
+	d, ok := s.digests[key]
+	if !ok {
+		d = &memoryDigest{deliverAt: time.Now().Add(window)}
+		s.digests[key] = d
+	}
+	count := d.digest.Count + 1
+	d.digest = *digest
+	d.digest.Count = count
+	return count, nil
+}
+
+func (s *memoryDigestStore) ClaimDigests(
+	_ context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*storage.NotificationDigest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var claimed []*storage.NotificationDigest
+	for _, d := range s.digests {
+		if len(claimed) == limit || d.deliverAt.After(time.Now()) {
+			continue
+		}
+		d.deliverAt = time.Now().Add(lease)
+		digest := d.digest
+		claimed = append(claimed, &digest)
+	}
+	return claimed, nil
+}
+
+func (s *memoryDigestStore) CompleteDigest(
+	_ context.Context,
+	digest *storage.NotificationDigest,
+	window time.Duration,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := channelDigestKey{user: digest.UserID, channel: digest.ChannelID}
+	d, ok := s.digests[key]
+	if !ok {
+		return nil
+	}
+	if d.digest.Count <= digest.Count {
+		delete(s.digests, key)
+		return nil
+	}
+	d.digest.Count -= digest.Count
+	d.deliverAt = time.Now().Add(window)
+	return nil
+}
+
+func (s *memoryDigestStore) numDigests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.digests)
+}
+
+func TestChannelDigester(t *testing.T) {
+	var (
+		req            = require.New(t)
+		ctx, ctxCloser = test.NewTestContext()
+		store          = newMemoryDigestStore()
+		flushed        = make(chan *channelDigest, 10)
+	)
+	defer ctxCloser()
+
+	digester := newChannelDigester(store, 100*time.Millisecond, func(digest *channelDigest) bool {
+		flushed <- digest
+		return true
+	})
+	go digester.run(ctx)
+
+	wallet, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+
+	newEvent := func(msg string) *events.ParsedEvent {
+		event, err := events.MakeParsedEventWithPayload(wallet, events.Make_ChannelPayload_Message(msg), nil)
+		req.NoError(err)
+		return event
+	}
+
+	var (
+		user1    = common.Address{1}
+		user2    = common.Address{2}
+		spaceID  = testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		channel1 = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		channel2 = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		members  = mapset.NewSet("member")
+		event1   = newEvent("1")
+		event2   = newEvent("2")
+		event3   = newEvent("3")
+	)
+
+	add := func(user common.Address, channelID shared.StreamId, event *events.ParsedEvent) int {
+		count, err := digester.add(ctx, nil, user, spaceID, channelID, event, "new_message", members)
+		req.NoError(err)
+		return count
+	}
+
+	req.Equal(1, add(user1, channel1, event1))
+	req.Equal(2, add(user1, channel1, event2))
+	req.Equal(1, add(user1, channel2, event3))
+	req.Equal(1, add(user2, channel1, event1))
+
+	results := make(map[channelDigestKey]*channelDigest)
+	for range 3 {
+		select {
+		case digest := <-flushed:
+			results[channelDigestKey{user: digest.user, channel: digest.channelID}] = digest
+		case <-time.After(5 * time.Second):
+			req.FailNow("digest not flushed")
+		}
+	}
+
+	digest := results[channelDigestKey{user: user1, channel: channel1}]
+	req.NotNil(digest)
+	req.Equal(2, digest.count)
+	req.Equal(event2.Hash, digest.event.Hash, "digest includes the latest message")
+	req.Equal(spaceID, digest.spaceID)
+	req.True(digest.members.Contains("member"))
+
+	digest = results[channelDigestKey{user: user1, channel: channel2}]
+	req.NotNil(digest)
+	req.Equal(1, digest.count)
+	req.Equal(event3.Hash, digest.event.Hash)
+
+	digest = results[channelDigestKey{user: user2, channel: channel1}]
+	req.NotNil(digest)
+	req.Equal(1, digest.count)
+
+	req.Eventually(func() bool { return store.numDigests() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	// a new window is opened after the digest was flushed
+	req.Equal(1, add(user1, channel1, event3))
+	select {
+	case digest := <-flushed:
+		req.Equal(1, digest.count)
+	case <-time.After(5 * time.Second):
+		req.FailNow("digest not flushed")
+	}
+}
+
+func TestChannelDigesterKeepsDigestForOwner(t *testing.T) {
+	var (
+		req            = require.New(t)
+		ctx, ctxCloser = test.NewTestContext()
+		store          = newMemoryDigestStore()
+	)
+	defer ctxCloser()
+
+	wallet, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+	event, err := events.MakeParsedEventWithPayload(wallet, events.Make_ChannelPayload_Message("msg"), nil)
+	req.NoError(err)
+
+	// a digest that is added by an instance that stops before the window closes is sent by another instance
+	stopped := newChannelDigester(store, time.Millisecond, func(*channelDigest) bool { return false })
+	_, err = stopped.add(ctx, nil, common.Address{1}, testutils.FakeStreamId(shared.STREAM_SPACE_BIN),
+		testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN), event, "new_message", mapset.NewSet[string]())
+	req.NoError(err)
+	time.Sleep(10 * time.Millisecond)
+
+	req.False(stopped.flushDue(ctx))
+	req.Equal(1, store.numDigests(), "digest is kept when it is not sent")
+
+	store.mu.Lock()
+	for _, d := range store.digests {
+		d.deliverAt = time.Now()
+	}
+	store.mu.Unlock()
+
+	var sent []*channelDigest
+	owner := newChannelDigester(store, time.Millisecond, func(digest *channelDigest) bool {
+		sent = append(sent, digest)
+		return true
+	})
+	owner.flushDue(ctx)
+	req.Len(sent, 1)
+	req.Equal(event.Hash, sent[0].event.Hash)
+	req.Equal(0, store.numDigests())
+}
+
+func TestDigestCollapseID(t *testing.T) {
+	req := require.New(t)
+
+	channel1 := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	channel2 := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+	id := digestCollapseID(channel1)
+	req.Len(id, 32, "web push topics are limited to 32 characters")
+	req.Equal(id, digestCollapseID(channel1))
+	req.NotEqual(id, digestCollapseID(channel2))
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"slices"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/towns-protocol/towns/core/node/notifications/types"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
 )
 
 // MaxWebPushAllowedNotificationStreamEventPayloadSize is the max length of a serialized stream
@@ -49,6 +51,12 @@ const MaxWebPushAllowedNotificationStreamEventPayloadSize = 3 * 1024
 // and immediately strip the stream event from the notification payload before trying it.
 const MaxAPNAllowedNotificationStreamEventPayloadSize = 4096
 
+// MaxFCMAllowedNotificationDataSize is the max size of the data in an FCM notification. FCM refuses
+// messages with more than 4096 bytes of data, the stream event and tags are dropped from the
+// notification content until it fits, leaving room for the other data keys.
+// https://firebase.google.com/docs/cloud-messaging/concept-options#notifications_and_data_messages
+const MaxFCMAllowedNotificationDataSize = 4000
+
 // MessageToNotificationsProcessor implements events.StreamEventListener and for each stream event determines
 // if it needs to send a notification, to who and sends it.
 type MessageToNotificationsProcessor struct {
@@ -56,6 +64,9 @@ type MessageToNotificationsProcessor struct {
 	cache                  UserPreferencesStore
 	subscriptionExpiration time.Duration
 	notifier               push.MessageNotifier
+	outbox                 *Outbox
+	shards                 *ShardManager
+	digester               *channelDigester
 	log                    *zap.SugaredLogger
 }
 
@@ -66,19 +77,53 @@ func NewNotificationMessageProcessor(
 	userPreferences UserPreferencesStore,
 	config config.NotificationsConfig,
 	notifier push.MessageNotifier,
+	outbox *Outbox,
+	shards *ShardManager,
+	digests storage.NotificationDigestStore,
 ) *MessageToNotificationsProcessor {
 	subscriptionExpiration := 90 * 24 * time.Hour // 90 days default
 	if config.SubscriptionExpirationDuration > time.Duration(0) {
 		subscriptionExpiration = config.SubscriptionExpirationDuration
 	}
 
-	return &MessageToNotificationsProcessor{
+	p := &MessageToNotificationsProcessor{
 		ctx:                    ctx,
 		notifier:               notifier,
+		outbox:                 outbox,
+		shards:                 shards,
 		cache:                  userPreferences,
 		subscriptionExpiration: subscriptionExpiration,
 		log:                    logging.FromCtx(ctx),
 	}
+	p.digester = newChannelDigester(digests, config.Digest.GetWindow(), p.sendDigest)
+	go p.digester.run(ctx)
+	go p.pruneUnreadCountEvents(ctx)
+
+	return p
+}
+
+// unreadCountEventsPruneInterval is how often counted events that are too old to be replayed are removed.
+const unreadCountEventsPruneInterval = time.Hour
+
+func (p *MessageToNotificationsProcessor) pruneUnreadCountEvents(ctx context.Context) {
+	ticker := time.NewTicker(unreadCountEventsPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pruned, err := p.cache.PruneUnreadCountEvents(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					p.log.Warnw("Unable to prune unread count events", "err", err)
+				}
+				continue
+			}
+			p.log.Debugw("Pruned unread count events", "count", pruned)
+		}
+	}
 }
 
 // OnMessageEvent sends a notification to the given user for the given event when needed.
@@ -104,6 +149,12 @@ func (p *MessageToNotificationsProcessor) OnMessageEvent(
 	}
 	l.Debugw("Process event")
 
+	// the stream is synced until the tracker is refreshed after the shard was moved to another instance
+	if !p.shards.Owns(channelID) {
+		l.Debugw("Shard not held, skip event")
+		return
+	}
+
 	kind := "new_message"
 	tags := event.Event.GetTags()
 
@@ -127,6 +178,8 @@ func (p *MessageToNotificationsProcessor) OnMessageEvent(
 	usersToNotify := make(map[common.Address]*types.UserPreferences)
 	recipients := mapset.NewSet[common.Address]()
 	sender := common.BytesToAddress(event.Event.CreatorAddress)
+	unread := make(map[common.Address]bool) // user -> mentioned
+	countsAsUnread := isUnreadMessage(event)
 
 	if slices.Contains(tags.GetGroupMentionTypes(), GroupMentionType_GROUP_MENTION_TYPE_AT_CHANNEL) {
 		kind = "@channel"
@@ -222,16 +275,114 @@ func (p *MessageToNotificationsProcessor) OnMessageEvent(
 			}
 		}
 
+		if countsAsUnread && recipients.Contains(participant) && !pref.IsChannelMuted(spaceID, channelID) {
+			unread[participant] = isMentioned(participant, tags.GetGroupMentionTypes(), tags.GetMentionedUserAddresses())
+		}
+
 		return false
 	})
 
 	recipients.Remove(sender)
+	delete(unread, sender)
+
+	// the unread counters are maintained for users that have subscribed for notifications,
+	// the total number of unread messages is sent as badge with the notification.
+	// the lease is checked in the same transaction so counters are not changed after the shard moved.
+	badges, err := p.cache.IncrementUnreadCounts(ctx, p.shards.Lease(channelID), channelID, event.Hash, unread)
+	if err != nil {
+		p.log.Errorw("Unable to update unread counts", "channel", channelID, "event", event.Hash, "err", err)
+	}
 
+	now := time.Now()
 	for user, userPref := range usersToNotify {
-		p.sendNotification(ctx, user, userPref, spaceID, channelID, event, kind, members)
+		// quiet hours and snoozes suppress notifications, optionally with the exception of mentions
+		mentioned := isMentioned(user, tags.GetGroupMentionTypes(), tags.GetMentionedUserAddresses())
+		if userPref.IsMuted(now, spaceID, mentioned) {
+			p.log.Debugw("User muted notifications", "user", user, "event", event.Hash, "mentioned", mentioned)
+			continue
+		}
+
+		// messages in channels in digest mode are collapsed into a single notification
+		participating := isParticipating(user, tags.GetParticipatingUserAddresses())
+		if spaceID != nil && shared.ValidChannelStreamId(&channelID) &&
+			userPref.WantsDigestForSpaceChannelMessage(*spaceID, channelID, mentioned, participating) {
+			count, err := p.digester.add(ctx, p.shards.Lease(channelID), user, *spaceID, channelID, event, kind, members)
+			if err != nil {
+				p.log.Errorw("Unable to add message to digest", "user", user, "event", event.Hash, "err", err)
+			} else {
+				p.log.Debugw("Added message to digest", "user", user, "event", event.Hash, "count", count)
+			}
+			continue
+		}
+
+		var opts notificationOptions
+		if badge, ok := badges[user]; ok {
+			opts.badge = &badge
+		}
+
+		p.sendNotification(ctx, user, userPref, spaceID, channelID, event, kind, members, opts)
 	}
 }
 
+// isUnreadMessage returns true if the event is a message that makes the channel unread for its members.
+// Reactions, tips and channel updates don't change the unread state.
+func isUnreadMessage(event *events.ParsedEvent) bool {
+	if event.Event.GetTags().GetMessageInteractionType() == MessageInteractionType_MESSAGE_INTERACTION_TYPE_REACTION {
+		return false
+	}
+
+	switch payload := event.Event.Payload.(type) {
+	case *StreamEvent_DmChannelPayload:
+		return payload.DmChannelPayload.GetMessage() != nil
+	case *StreamEvent_GdmChannelPayload:
+		return payload.GdmChannelPayload.GetMessage() != nil
+	case *StreamEvent_ChannelPayload:
+		return payload.ChannelPayload.GetMessage() != nil
+	default:
+		return false
+	}
+}
+
+// sendDigest sends a single notification for the messages in the digest. The notification replaces earlier
+// digest notifications of the channel on the users device. It returns false when the digest must be sent later
+// or by the instance that holds the shard of the channel.
+func (p *MessageToNotificationsProcessor) sendDigest(digest *channelDigest) bool {
+	if !p.shards.Owns(digest.channelID) {
+		return false
+	}
+
+	userPref, err := p.cache.GetUserPreferences(p.ctx, digest.user)
+	if err != nil {
+		p.log.Errorw("Unable to retrieve user preferences for digest",
+			"user", digest.user, "channel", digest.channelID, "err", err)
+		return false
+	}
+
+	// quiet hours or a snooze could have started while the digest was collected
+	if userPref.IsMuted(time.Now(), &digest.spaceID, false) {
+		p.log.Debugw("User muted notifications, drop digest",
+			"user", digest.user, "channel", digest.channelID, "count", digest.count)
+		return true
+	}
+
+	kind := digest.kind
+	if digest.count > 1 {
+		kind = "digest"
+	}
+
+	opts := notificationOptions{digestCount: digest.count}
+	if counts, err := p.cache.GetUnreadCounts(p.ctx, digest.user); err == nil {
+		badge := int(types.UnreadCountsToProtobuf(counts).GetTotalUnread())
+		opts.badge = &badge
+	} else {
+		p.log.Errorw("Unable to retrieve unread counts for digest", "user", digest.user, "err", err)
+	}
+
+	p.sendNotification(p.ctx, digest.user, userPref, &digest.spaceID, digest.channelID,
+		digest.event, kind, digest.members, opts)
+	return true
+}
+
 func (p *MessageToNotificationsProcessor) onDMChannelPayload(
 	streamID shared.StreamId,
 	participant common.Address,
@@ -350,7 +501,8 @@ func (p *MessageToNotificationsProcessor) onSpaceChannelPayload(
 	return false
 }
 
-func (p *MessageToNotificationsProcessor) apnPayloadV1(
+// pushPayloadV1 returns the APN and FCM notification content for subscriptions with push version 1.
+func (p *MessageToNotificationsProcessor) pushPayloadV1(
 	channelID shared.StreamId,
 	spaceID *shared.StreamId,
 	event *events.ParsedEvent,
@@ -389,7 +541,8 @@ func (p *MessageToNotificationsProcessor) apnPayloadV1(
 	return apnPayload, nil
 }
 
-func (p *MessageToNotificationsProcessor) apnPayloadV2(
+// pushPayloadV2 returns the APN and FCM notification content for subscriptions with push version 2.
+func (p *MessageToNotificationsProcessor) pushPayloadV2(
 	channelID shared.StreamId,
 	spaceID *shared.StreamId,
 	event *events.ParsedEvent,
@@ -437,6 +590,17 @@ func (p *MessageToNotificationsProcessor) apnPayloadV2(
 	return apnPayload, nil
 }
 
+// notificationOptions holds the optional properties of a notification.
+type notificationOptions struct {
+	// digestCount is the number of messages a digest notification is sent for and 0 for regular notifications.
+	// Digest notifications include the latest message and are collapsed per channel on the device.
+	digestCount int
+	// badge is the total number of unread messages of the user, nil if unknown.
+	badge *int
+}
+
+// sendNotification adds a notification for each of the user's subscriptions to the outbox.
+// Notifications are delivered from the outbox by Deliver.
 func (p *MessageToNotificationsProcessor) sendNotification(
 	ctx context.Context,
 	user common.Address,
@@ -446,6 +610,7 @@ func (p *MessageToNotificationsProcessor) sendNotification(
 	event *events.ParsedEvent,
 	kind string,
 	members mapset.Set[string],
+	opts notificationOptions,
 ) {
 	eventBytes, err := proto.Marshal(event.Event)
 	if err != nil {
@@ -458,6 +623,18 @@ func (p *MessageToNotificationsProcessor) sendNotification(
 		receivers = members.ToSlice()
 	}
 
+	var (
+		outbox     []*storage.NotificationOutboxEntry
+		collapseID string
+		apnTitle   string
+	)
+	if opts.digestCount > 0 {
+		collapseID = digestCollapseID(channelID)
+	}
+	if opts.digestCount > 1 {
+		apnTitle = fmt.Sprintf("%d new messages", opts.digestCount)
+	}
+
 	if len(userPref.Subscriptions.WebPush) > 0 {
 		eventBytesHex := hex.EncodeToString(eventBytes)
 
@@ -483,6 +660,23 @@ func (p *MessageToNotificationsProcessor) sendNotification(
 			webPayload["threadId"] = hex.EncodeToString(threadID)
 		}
 
+		if opts.digestCount > 0 {
+			webPayload["count"] = opts.digestCount
+		}
+
+		if opts.badge != nil {
+			webPayload["badge"] = *opts.badge
+		}
+
+		payload, err := json.Marshal(map[string]interface{}{
+			"channelId": channelID,
+			"payload":   webPayload,
+		})
+		if err != nil {
+			p.log.Errorw("Unable to prepare web push payload", "err", err)
+			return
+		}
+
 		for _, sub := range userPref.Subscriptions.WebPush {
 			if time.Since(sub.LastSeen) >= p.subscriptionExpiration {
 				p.log.Warnw("Ignore WebPush subscription due to no activity",
@@ -496,29 +690,20 @@ func (p *MessageToNotificationsProcessor) sendNotification(
 				continue
 			}
 
-			subscriptionExpired, err := p.sendWebPushNotification(ctx, channelID, sub.Sub, event, webPayload)
-			if err == nil {
-				p.log.Infow("Successfully sent web push notification",
-					"user", user,
-					"event", event.Hash,
-					"channelID", channelID,
-					"user", user,
-				)
-			} else if !subscriptionExpired {
-				p.log.Errorw("Unable to send web push notification",
-					"user", user,
-					"err", err,
-					"event", event.Hash,
-					"channelID", channelID,
-				)
-			} else {
-				if err := p.cache.RemoveExpiredWebPushSubscription(ctx, userPref.UserID, sub.Sub); err != nil {
-					p.log.Errorw("Unable to remove expired webpush subscription",
-						"user", userPref.UserID, "err", err)
-				} else {
-					p.log.Infow("Removed expired webpush subscription", "user", userPref.UserID)
-				}
+			target, err := json.Marshal(sub.Sub)
+			if err != nil {
+				p.log.Errorw("Unable to encode web push subscription", "user", user, "err", err)
+				continue
 			}
+
+			outbox = append(outbox, &storage.NotificationOutboxEntry{
+				Provider:   ProviderWebPush,
+				UserID:     userPref.UserID,
+				EventHash:  event.Hash,
+				Target:     target,
+				Payload:    payload,
+				CollapseID: collapseID,
+			})
 		}
 	}
 
@@ -556,9 +741,9 @@ func (p *MessageToNotificationsProcessor) sendNotification(
 				p.log.Errorw("Unspecified APN push version in subscription", "deviceToken", sub.DeviceToken)
 				continue
 			case NotificationPushVersion_NOTIFICATION_PUSH_VERSION_1:
-				apnPayload, err = p.apnPayloadV1(channelID, spaceID, event, kind, receivers)
+				apnPayload, err = p.pushPayloadV1(channelID, spaceID, event, kind, receivers)
 			case NotificationPushVersion_NOTIFICATION_PUSH_VERSION_2:
-				apnPayload, err = p.apnPayloadV2(channelID, spaceID, event, kind, eventHash, receivers)
+				apnPayload, err = p.pushPayloadV2(channelID, spaceID, event, kind, eventHash, receivers)
 			default:
 				p.log.Warnw("Ignore APN subscription due to unsupported push payload format",
 					"pushVersion", sub.PushVersion)
@@ -570,99 +755,418 @@ func (p *MessageToNotificationsProcessor) sendNotification(
 				continue
 			}
 
-			subscriptionExpired, statusCode, err := p.sendAPNNotification(channelID, sub, event, apnPayload, sub.PushVersion)
+			if opts.digestCount > 0 {
+				apnPayload["count"] = opts.digestCount
+			}
 
-			// APN can return an error that the payload is too large, drop the (stream)event from the payload and retry.
-			// The client can handle notifications with no (stream)event and doesn't show a preview to the user.
-			if err != nil && statusCode == http.StatusRequestEntityTooLarge {
-				if _, exists := apnPayload["event"]; exists {
-					delete(apnPayload, "event")
-					p.log.Infow("Payload too large, retry notification with event stripped", "event", event.Hash)
-					subscriptionExpired, statusCode, err = p.sendAPNNotification(channelID, sub, event, apnPayload, sub.PushVersion)
-
-					if err != nil && statusCode == http.StatusRequestEntityTooLarge {
-						if _, exists := apnPayload["tags"]; exists {
-							delete(apnPayload, "tags")
-							p.log.Infow("Payload too large, retry notification with tags stripped", "event", event.Hash)
-							subscriptionExpired, _, err = p.sendAPNNotification(channelID, sub, event, apnPayload, sub.PushVersion)
-						}
-					}
-				}
+			target, err := json.Marshal(&apnOutboxTarget{
+				DeviceToken: sub.DeviceToken,
+				Environment: sub.Environment,
+				PushVersion: sub.PushVersion,
+			})
+			if err != nil {
+				p.log.Errorw("Unable to encode APN subscription", "user", user, "err", err)
+				continue
 			}
 
-			if err == nil {
-				p.log.Debugw("Successfully sent APN notification",
+			payload, err := json.Marshal(&apnOutboxPayload{
+				ChannelID: channelID.String(),
+				Title:     apnTitle,
+				Badge:     opts.badge,
+				Content:   apnPayload,
+			})
+			if err != nil {
+				p.log.Errorw("Unable to encode APN payload", "user", user, "err", err)
+				continue
+			}
+
+			outbox = append(outbox, &storage.NotificationOutboxEntry{
+				Provider:   ProviderAPN,
+				UserID:     userPref.UserID,
+				EventHash:  event.Hash,
+				Target:     target,
+				Payload:    payload,
+				CollapseID: collapseID,
+			})
+		}
+	}
+
+	if len(userPref.Subscriptions.FCMPush) > 0 {
+		eventHash := hex.EncodeToString(crypto.RiverHash(eventBytes).Bytes())
+
+		for _, sub := range userPref.Subscriptions.FCMPush {
+			if time.Since(sub.LastSeen) >= p.subscriptionExpiration {
+				if err := p.cache.RemoveFCMSubscription(ctx, sub.Token, userPref.UserID); err != nil {
+					p.log.Errorw("Unable to remove expired FCM subscription",
+						"user", userPref.UserID, "err", err)
+					continue
+				}
+
+				p.log.Infow("Removed FCM subscription due to no activity",
 					"user", user,
 					"event", event.Hash,
 					"channelID", channelID,
-					"deviceToken", sub.DeviceToken,
-					"env", sub.Environment,
-					"version", sub.PushVersion,
+					"lastSeen", sub.LastSeen,
+					"since", time.Since(sub.LastSeen),
+					"sub.expiration", p.subscriptionExpiration,
 				)
-			} else if !subscriptionExpired {
-				p.log.Errorw("Unable to send APN notification",
-					"user", user,
-					"user", user,
-					"event", event.Hash,
-					"channelID", channelID,
-					"deviceToken", sub.DeviceToken,
-					"env", sub.Environment,
-					"version", sub.PushVersion,
-					"err", err)
-			} else {
-				if err := p.cache.RemoveAPNSubscription(ctx, sub.DeviceToken, userPref.UserID); err != nil {
-					p.log.Errorw("Unable to remove expired APN subscription",
-						"user", userPref.UserID, "err", err)
-				} else {
-					p.log.Infow("Removed expired APN subscription", "user", userPref.UserID)
+
+				continue
+			}
+
+			var (
+				content map[string]interface{}
+				err     error
+			)
+
+			switch sub.PushVersion {
+			case NotificationPushVersion_NOTIFICATION_PUSH_VERSION_1:
+				content, err = p.pushPayloadV1(channelID, spaceID, event, kind, receivers)
+			case NotificationPushVersion_NOTIFICATION_PUSH_VERSION_2:
+				content, err = p.pushPayloadV2(channelID, spaceID, event, kind, eventHash, receivers)
+			default:
+				p.log.Warnw("Ignore FCM subscription due to unsupported push payload format",
+					"pushVersion", sub.PushVersion)
+				continue
+			}
+
+			if err != nil {
+				p.log.Errorw("Unable to prepare FCM payload", "err", err)
+				continue
+			}
+
+			if opts.digestCount > 0 {
+				content["count"] = opts.digestCount
+			}
+
+			if opts.badge != nil {
+				content["badge"] = *opts.badge
+			}
+
+			data, err := fcmData(channelID, content)
+			if err != nil {
+				p.log.Errorw("Unable to prepare FCM payload", "err", err)
+				continue
+			}
+
+			target, err := json.Marshal(&fcmOutboxTarget{
+				Token:       sub.Token,
+				PushVersion: sub.PushVersion,
+			})
+			if err != nil {
+				p.log.Errorw("Unable to encode FCM subscription", "user", user, "err", err)
+				continue
+			}
+
+			payload, err := json.Marshal(data)
+			if err != nil {
+				p.log.Errorw("Unable to encode FCM payload", "user", user, "err", err)
+				continue
+			}
+
+			outbox = append(outbox, &storage.NotificationOutboxEntry{
+				Provider:   ProviderFCM,
+				UserID:     userPref.UserID,
+				EventHash:  event.Hash,
+				Target:     target,
+				Payload:    payload,
+				CollapseID: collapseID,
+			})
+		}
+	}
+
+	if err := p.outbox.Enqueue(ctx, p.shards.Lease(channelID), outbox); err != nil {
+		if !p.shards.Owns(channelID) {
+			p.log.Infow("Shard not held anymore, drop notifications",
+				"user", user,
+				"event", event.Hash,
+				"channelID", channelID,
+			)
+			return
+		}
+		p.log.Errorw("Unable to add notifications to outbox",
+			"user", user,
+			"event", event.Hash,
+			"channelID", channelID,
+			"err", err,
+		)
+	}
+}
+
+type (
+	// apnOutboxTarget is the outbox target of APN notifications.
+	apnOutboxTarget struct {
+		DeviceToken []byte                  `json:"deviceToken"`
+		Environment APNEnvironment          `json:"environment"`
+		PushVersion NotificationPushVersion `json:"pushVersion"`
+	}
+
+	// apnOutboxPayload is the outbox payload of APN notifications.
+	apnOutboxPayload struct {
+		ChannelID string `json:"channelId"`
+		// Title overwrites the default alert title if set.
+		Title string `json:"title,omitempty"`
+		// Badge is the number shown on the app icon, the badge is left unchanged if nil.
+		Badge   *int                   `json:"badge,omitempty"`
+		Content map[string]interface{} `json:"content"`
+	}
+
+	// fcmOutboxTarget is the outbox target of FCM notifications, the payload is the FCM data.
+	fcmOutboxTarget struct {
+		Token       string                  `json:"token"`
+		PushVersion NotificationPushVersion `json:"pushVersion"`
+	}
+)
+
+// fcmData returns the data of an FCM notification. FCM data values must be strings, the content is
+// JSON encoded. The stream event and tags are dropped from the content if the data is too large.
+func fcmData(channelID shared.StreamId, content map[string]interface{}) (map[string]string, error) {
+	data := map[string]string{"channelId": channelID.String()}
+	for _, strip := range []string{"event", "tags", ""} {
+		encoded, err := json.Marshal(content)
+		if err != nil {
+			return nil, base.AsRiverError(err, Err_INTERNAL)
+		}
+		data["content"] = string(encoded)
+
+		if len(data["channelId"])+len(data["content"]) <= MaxFCMAllowedNotificationDataSize {
+			return data, nil
+		}
+		if strip != "" {
+			delete(content, strip)
+		}
+	}
+
+	return nil, base.RiverError(Err_INTERNAL, "FCM notification content too large", "channelId", channelID)
+}
+
+// Deliver implements OutboxDeliverer and sends a notification from the outbox.
+func (p *MessageToNotificationsProcessor) Deliver(ctx context.Context, entry *storage.NotificationOutboxEntry) error {
+	switch entry.Provider {
+	case ProviderWebPush:
+		return p.deliverWebPush(ctx, entry)
+	case ProviderAPN:
+		return p.deliverAPN(ctx, entry)
+	case ProviderFCM:
+		return p.deliverFCM(ctx, entry)
+	default:
+		return permanentDeliveryError("unknown_provider",
+			base.RiverError(Err_INTERNAL, "Unknown notification provider", "provider", entry.Provider))
+	}
+}
+
+func (p *MessageToNotificationsProcessor) deliverWebPush(
+	ctx context.Context,
+	entry *storage.NotificationOutboxEntry,
+) error {
+	var sub webpush.Subscription
+	if err := json.Unmarshal(entry.Target, &sub); err != nil {
+		return permanentDeliveryError("bad_target", err)
+	}
+
+	subscriptionExpired, statusCode, err := p.notifier.SendWebPushNotification(
+		ctx, &sub, entry.EventHash, entry.Payload, entry.CollapseID)
+	if err == nil {
+		p.log.Infow("Successfully sent web push notification",
+			"user", entry.UserID,
+			"event", entry.EventHash,
+		)
+		return nil
+	}
+
+	if subscriptionExpired {
+		if err := p.cache.RemoveExpiredWebPushSubscription(ctx, entry.UserID, &sub); err != nil {
+			p.log.Errorw("Unable to remove expired webpush subscription",
+				"user", entry.UserID, "err", err)
+		} else {
+			p.log.Infow("Removed expired webpush subscription", "user", entry.UserID)
+		}
+		return nil
+	}
+
+	// no response from the push service
+	if statusCode == 0 {
+		return retryableDeliveryError("send_failed", err)
+	}
+
+	// the push service rejects requests it can never accept, like bad requests, invalid VAPID
+	// credentials or too large payloads, with a 4xx status
+	reason := fmt.Sprintf("http_%d", statusCode)
+	if statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError {
+		return retryableDeliveryError(reason, err)
+	}
+	return permanentDeliveryError(reason, err)
+}
+
+func (p *MessageToNotificationsProcessor) deliverAPN(
+	ctx context.Context,
+	entry *storage.NotificationOutboxEntry,
+) error {
+	var target apnOutboxTarget
+	if err := json.Unmarshal(entry.Target, &target); err != nil {
+		return permanentDeliveryError("bad_target", err)
+	}
+
+	var apnPayload apnOutboxPayload
+	decoder := json.NewDecoder(bytes.NewReader(entry.Payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&apnPayload); err != nil {
+		return permanentDeliveryError("bad_payload", err)
+	}
+
+	channelID, err := shared.StreamIdFromString(apnPayload.ChannelID)
+	if err != nil {
+		return permanentDeliveryError("bad_payload", err)
+	}
+
+	sub := &types.APNPushSubscription{
+		DeviceToken: target.DeviceToken,
+		Environment: target.Environment,
+		PushVersion: target.PushVersion,
+	}
+	content := apnPayload.Content
+
+	subscriptionExpired, statusCode, err := p.sendAPNNotification(ctx, channelID, sub, entry, &apnPayload)
+
+	// APN can return an error that the payload is too large, drop the (stream)event from the payload and retry.
+	// The client can handle notifications with no (stream)event and doesn't show a preview to the user.
+	if err != nil && statusCode == http.StatusRequestEntityTooLarge {
+		if _, exists := content["event"]; exists {
+			delete(content, "event")
+			p.log.Infow("Payload too large, retry notification with event stripped", "event", entry.EventHash)
+			subscriptionExpired, statusCode, err = p.sendAPNNotification(ctx, channelID, sub, entry, &apnPayload)
+
+			if err != nil && statusCode == http.StatusRequestEntityTooLarge {
+				if _, exists := content["tags"]; exists {
+					delete(content, "tags")
+					p.log.Infow("Payload too large, retry notification with tags stripped", "event", entry.EventHash)
+					subscriptionExpired, statusCode, err = p.sendAPNNotification(
+						ctx, channelID, sub, entry, &apnPayload)
 				}
 			}
 		}
 	}
+
+	if err == nil {
+		p.log.Debugw("Successfully sent APN notification",
+			"user", entry.UserID,
+			"event", entry.EventHash,
+			"channelID", channelID,
+			"deviceToken", sub.DeviceToken,
+			"env", sub.Environment,
+			"version", sub.PushVersion,
+		)
+		return nil
+	}
+
+	if subscriptionExpired {
+		if err := p.cache.RemoveAPNSubscription(ctx, sub.DeviceToken, entry.UserID); err != nil {
+			p.log.Errorw("Unable to remove expired APN subscription",
+				"user", entry.UserID, "err", err)
+		} else {
+			p.log.Infow("Removed expired APN subscription", "user", entry.UserID)
+		}
+		return nil
+	}
+
+	reason := fmt.Sprintf("http_%d", statusCode)
+	if statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError {
+		return retryableDeliveryError(reason, err)
+	}
+	return permanentDeliveryError(reason, err)
 }
 
-func (p *MessageToNotificationsProcessor) sendWebPushNotification(
+func (p *MessageToNotificationsProcessor) deliverFCM(
 	ctx context.Context,
-	streamID shared.StreamId,
-	sub *webpush.Subscription,
-	event *events.ParsedEvent,
-	content map[string]interface{},
-) (bool, error) {
-	payload, _ := json.Marshal(map[string]interface{}{
-		"channelId": streamID,
-		"payload":   content,
-	})
+	entry *storage.NotificationOutboxEntry,
+) error {
+	var target fcmOutboxTarget
+	if err := json.Unmarshal(entry.Target, &target); err != nil {
+		return permanentDeliveryError("bad_target", err)
+	}
+
+	var data map[string]string
+	if err := json.Unmarshal(entry.Payload, &data); err != nil {
+		return permanentDeliveryError("bad_payload", err)
+	}
+
+	sub := &types.FCMPushSubscription{
+		Token:       target.Token,
+		PushVersion: target.PushVersion,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
 
-	return p.notifier.SendWebPushNotification(ctx, sub, event.Hash, payload)
+	subscriptionExpired, statusCode, err := p.notifier.SendFCMNotification(
+		ctx, sub, entry.EventHash, data, entry.CollapseID)
+	if err == nil {
+		p.log.Debugw("Successfully sent FCM notification",
+			"user", entry.UserID,
+			"event", entry.EventHash,
+			"version", sub.PushVersion,
+		)
+		return nil
+	}
+
+	if subscriptionExpired {
+		if err := p.cache.RemoveFCMSubscription(ctx, sub.Token, entry.UserID); err != nil {
+			p.log.Errorw("Unable to remove expired FCM subscription",
+				"user", entry.UserID, "err", err)
+		} else {
+			p.log.Infow("Removed expired FCM subscription", "user", entry.UserID)
+		}
+		return nil
+	}
+
+	reason := fmt.Sprintf("http_%d", statusCode)
+	if statusCode == http.StatusTooManyRequests ||
+		(statusCode >= http.StatusInternalServerError && statusCode != http.StatusNotImplemented) {
+		return retryableDeliveryError(reason, err)
+	}
+	return permanentDeliveryError(reason, err)
 }
 
 func (p *MessageToNotificationsProcessor) sendAPNNotification(
+	ctx context.Context,
 	streamID shared.StreamId,
 	sub *types.APNPushSubscription,
-	event *events.ParsedEvent,
-	content map[string]interface{},
-	payloadVersion NotificationPushVersion,
+	entry *storage.NotificationOutboxEntry,
+	apnPayload *apnOutboxPayload,
 ) (bool, int, error) {
-	// lint:ignore context.Background() is fine here
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
+	var (
+		eventHash = entry.EventHash
+		content   = apnPayload.Content
+		title     = "You have a new message"
+	)
+	if apnPayload.Title != "" {
+		title = apnPayload.Title
+	}
+
 	notificationPayload := payload.NewPayload().
-		AlertTitle("You have a new message").
+		AlertTitle(title).
 		Custom("content", content).
 		ThreadID(streamID.String()).
 		ContentAvailable().
 		MutableContent().
 		Sound("default")
 
+	if apnPayload.Badge != nil {
+		notificationPayload.Badge(*apnPayload.Badge)
+	}
+
 	if p.log.Level() <= zap.DebugLevel {
 		p.log.Debugw("APN Notification",
-			"from", common.BytesToAddress(event.Event.GetCreatorAddress()),
+			"event", eventHash,
 			"notification", notificationPayload)
 	}
 
 	_, containsStreamEvent := content["event"]
 
 	return p.notifier.SendApplePushNotification(
-		ctx, sub, event.Hash, notificationPayload, containsStreamEvent)
+		ctx, sub, eventHash, notificationPayload, containsStreamEvent, entry.CollapseID)
 }
@@ -0,0 +1,356 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+const (
+	ProviderWebPush = "webpush"
+	ProviderAPN     = "apn"
+	ProviderFCM     = "fcm"
+)
+
+const (
+	// outboxStatsInterval is how often the outbox depth metric is updated.
+	outboxStatsInterval = 30 * time.Second
+	// outboxSweepInterval is how often expired delivered and dead lettered notifications are deleted.
+	outboxSweepInterval = time.Hour
+)
+
+type (
+	// DeliveryError is returned by an OutboxDeliverer if a notification can't be delivered.
+	DeliveryError struct {
+		// Reason is a short description of the failure used in metrics, for example "http_503".
+		Reason string
+		// Retryable is true if a later attempt can succeed, for example when the provider is unavailable.
+		Retryable bool
+		Err       error
+	}
+
+	// OutboxDeliverer delivers notifications from the outbox.
+	OutboxDeliverer interface {
+		// Deliver sends the notification to the subscription. It returns nil if the notification was
+		// delivered or doesn't need to be delivered anymore, for example because the subscription expired.
+		Deliver(ctx context.Context, entry *storage.NotificationOutboxEntry) error
+	}
+
+	// Outbox delivers notifications from the persistent notification outbox. Failed deliveries are
+	// retried with exponential backoff until the max number of attempts is reached, after which the
+	// notification is dead lettered. Deliveries through a provider are paused when too many consecutive
+	// deliveries through the provider failed.
+	Outbox struct {
+		store     storage.NotificationOutboxStore
+		cfg       *config.NotificationOutboxConfig
+		providers []string
+		breakers  map[string]*circuitBreaker
+		wake      chan struct{}
+
+		depth       *prometheus.GaugeVec
+		deliveries  *prometheus.CounterVec
+		failures    *prometheus.CounterVec
+		circuitOpen *prometheus.GaugeVec
+	}
+
+	// OutboxStatus is the state of the outbox as shown on the debug endpoint.
+	OutboxStatus struct {
+		Providers []*OutboxProviderStatus `json:"providers"`
+	}
+
+	OutboxProviderStatus struct {
+		Provider     string           `json:"provider"`
+		Circuit      string           `json:"circuit"`
+		Pending      int64            `json:"pending"`
+		DeadLettered int64            `json:"dead_lettered"`
+		Failures     map[string]int64 `json:"failures"`
+	}
+)
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Reason, e.Err)
+}
+
+func (e *DeliveryError) Unwrap() error {
+	return e.Err
+}
+
+func retryableDeliveryError(reason string, err error) error {
+	return &DeliveryError{Reason: reason, Retryable: true, Err: err}
+}
+
+func permanentDeliveryError(reason string, err error) error {
+	return &DeliveryError{Reason: reason, Retryable: false, Err: err}
+}
+
+// NewOutbox creates an outbox that delivers notifications through the given providers.
+func NewOutbox(
+	store storage.NotificationOutboxStore,
+	cfg *config.NotificationOutboxConfig,
+	metrics infra.MetricsFactory,
+	providers ...string,
+) *Outbox {
+	o := &Outbox{
+		store:     store,
+		cfg:       cfg,
+		providers: providers,
+		breakers:  make(map[string]*circuitBreaker, len(providers)),
+		wake:      make(chan struct{}, 1),
+		depth: metrics.NewGaugeVecEx(
+			"notification_outbox_depth",
+			"Number of notifications in the outbox",
+			"provider", "state",
+		),
+		deliveries: metrics.NewCounterVecEx(
+			"notification_outbox_deliveries",
+			"Number of delivery attempts of notifications from the outbox",
+			"provider", "result",
+		),
+		failures: metrics.NewCounterVecEx(
+			"notification_outbox_failures",
+			"Number of failed delivery attempts of notifications from the outbox",
+			"provider", "reason",
+		),
+		circuitOpen: metrics.NewGaugeVecEx(
+			"notification_outbox_circuit_open",
+			"1 if deliveries through the provider are paused",
+			"provider",
+		),
+	}
+	for _, provider := range providers {
+		o.breakers[provider] = newCircuitBreaker(cfg.GetCircuitBreakerThreshold(), cfg.GetCircuitBreakerCooldown())
+	}
+	return o
+}
+
+// Enqueue adds the notifications to the outbox and wakes up the delivery loop. If lease is not nil
+// the notifications are only added when this instance holds the shard lease.
+func (o *Outbox) Enqueue(
+	ctx context.Context,
+	lease *storage.ShardLease,
+	entries []*storage.NotificationOutboxEntry,
+) error {
+	if err := o.store.EnqueueNotifications(ctx, lease, entries); err != nil {
+		return err
+	}
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Start delivers notifications from the outbox with the given deliverer until the context is cancelled.
+func (o *Outbox) Start(ctx context.Context, deliverer OutboxDeliverer) {
+	go o.run(ctx, deliverer)
+	go o.reportStats(ctx)
+	go o.sweep(ctx)
+}
+
+func (o *Outbox) run(ctx context.Context, deliverer OutboxDeliverer) {
+	ticker := time.NewTicker(o.cfg.GetPollInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-o.wake:
+		}
+
+		for _, provider := range o.providers {
+			// keep going while full batches are claimed to drain a backlog without waiting for the ticker
+			for o.deliverDue(ctx, provider, deliverer) {
+			}
+		}
+	}
+}
+
+// deliverDue delivers a batch of due notifications for the provider and returns true if more
+// notifications are likely due.
+func (o *Outbox) deliverDue(ctx context.Context, provider string, deliverer OutboxDeliverer) bool {
+	log := logging.FromCtx(ctx)
+	breaker := o.breakers[provider]
+
+	limit := breaker.limit(time.Now(), o.cfg.GetBatchSize())
+	if limit == 0 {
+		o.circuitOpen.WithLabelValues(provider).Set(1)
+		return false
+	}
+	o.circuitOpen.WithLabelValues(provider).Set(0)
+
+	entries, err := o.store.ClaimNotifications(ctx, provider, limit, o.cfg.GetLease())
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Errorw("Unable to claim notifications from outbox", "provider", provider, "err", err)
+		}
+		return false
+	}
+
+	var wg sync.WaitGroup
+	for _, entry := range entries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			o.onDelivered(ctx, breaker, entry, deliverer.Deliver(ctx, entry))
+		}()
+	}
+	wg.Wait()
+
+	return len(entries) == o.cfg.GetBatchSize() && ctx.Err() == nil
+}
+
+func (o *Outbox) onDelivered(
+	ctx context.Context,
+	breaker *circuitBreaker,
+	entry *storage.NotificationOutboxEntry,
+	err error,
+) {
+	log := logging.FromCtx(ctx).With(
+		"provider", entry.Provider,
+		"id", entry.ID,
+		"user", entry.UserID,
+		"event", entry.EventHash,
+		"attempts", entry.Attempts,
+	)
+
+	if err == nil {
+		breaker.success()
+		o.deliveries.WithLabelValues(entry.Provider, "delivered").Inc()
+		if err := o.store.CompleteNotification(ctx, entry.ID); err != nil {
+			log.Errorw("Unable to remove delivered notification from outbox", "err", err)
+		}
+		return
+	}
+
+	var deliveryErr *DeliveryError
+	if !errors.As(err, &deliveryErr) {
+		deliveryErr = &DeliveryError{Reason: "error", Retryable: true, Err: err}
+	}
+	o.failures.WithLabelValues(entry.Provider, deliveryErr.Reason).Inc()
+
+	// permanent errors are caused by the notification or subscription, not by the provider
+	if deliveryErr.Retryable {
+		breaker.failure(time.Now())
+	}
+
+	if !deliveryErr.Retryable || entry.Attempts >= o.cfg.GetMaxAttempts() {
+		o.deliveries.WithLabelValues(entry.Provider, "dead_lettered").Inc()
+		log.Warnw("Dead letter notification", "reason", deliveryErr.Reason, "err", err)
+		if err := o.store.DeadLetterNotification(ctx, entry.ID, deliveryErr.Reason, err.Error()); err != nil {
+			log.Errorw("Unable to dead letter notification", "err", err)
+		}
+		return
+	}
+
+	o.deliveries.WithLabelValues(entry.Provider, "retry").Inc()
+	backoff := o.backoff(entry.Attempts)
+	log.Infow("Retry notification", "reason", deliveryErr.Reason, "backoff", backoff, "err", err)
+	if err := o.store.RetryNotification(ctx, entry.ID, backoff, deliveryErr.Reason, err.Error()); err != nil {
+		log.Errorw("Unable to schedule notification retry", "err", err)
+	}
+}
+
+// backoff returns the delay before the next attempt after the given number of failed attempts.
+func (o *Outbox) backoff(attempts int) time.Duration {
+	backoff := o.cfg.GetInitialBackoff()
+	for i := 1; i < attempts && backoff < o.cfg.GetMaxBackoff(); i++ {
+		backoff *= 2
+	}
+	return min(backoff, o.cfg.GetMaxBackoff())
+}
+
+func (o *Outbox) reportStats(ctx context.Context) {
+	ticker := time.NewTicker(outboxStatsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stats, err := o.store.GetNotificationOutboxStats(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					logging.FromCtx(ctx).Warnw("Unable to get notification outbox stats", "err", err)
+				}
+				continue
+			}
+			o.depth.Reset()
+			for _, s := range stats {
+				o.depth.With(prometheus.Labels{"provider": s.Provider, "state": "pending"}).Set(float64(s.Pending))
+				o.depth.With(prometheus.Labels{"provider": s.Provider, "state": "dead_lettered"}).
+					Set(float64(s.DeadLettered))
+			}
+		}
+	}
+}
+
+// sweep deletes delivered and dead lettered notifications after their retention period.
+func (o *Outbox) sweep(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+	deliveredRetention := o.cfg.GetDeliveredRetention()
+	deadLetterRetention := o.cfg.GetDeadLetterRetention()
+	ticker := time.NewTicker(min(outboxSweepInterval, deliveredRetention, deadLetterRetention))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := o.store.DeleteDeliveredNotifications(ctx, deliveredRetention); err != nil && ctx.Err() == nil {
+				log.Warnw("Unable to delete expired delivered notifications", "err", err)
+			}
+
+			deleted, err := o.store.DeleteDeadLetteredNotifications(ctx, deadLetterRetention)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Warnw("Unable to delete expired dead lettered notifications", "err", err)
+				}
+				continue
+			}
+			if deleted > 0 {
+				log.Infow("Deleted expired dead lettered notifications", "count", deleted)
+			}
+		}
+	}
+}
+
+// Status returns the number of notifications in the outbox, the failure reasons and the circuit breaker state
+// for each provider.
+func (o *Outbox) Status(ctx context.Context) (*OutboxStatus, error) {
+	stats, err := o.store.GetNotificationOutboxStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &OutboxStatus{}
+	now := time.Now()
+	for _, provider := range o.providers {
+		providerStatus := &OutboxProviderStatus{
+			Provider: provider,
+			Circuit:  o.breakers[provider].state(now),
+			Failures: map[string]int64{},
+		}
+		for _, s := range stats {
+			if s.Provider == provider {
+				providerStatus.Pending = s.Pending
+				providerStatus.DeadLettered = s.DeadLettered
+				providerStatus.Failures = s.Failures
+			}
+		}
+		status.Providers = append(status.Providers, providerStatus)
+	}
+	return status, nil
+}
@@ -0,0 +1,327 @@
+package notifications_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/notifications"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+// memoryOutboxStore is an in-memory storage.NotificationOutboxStore.
+type memoryOutboxStore struct {
+	mu           sync.Mutex
+	nextID       int64
+	entries      map[int64]*memoryOutboxEntry
+	claimLimits  []int
+	retryDelays  []time.Duration
+	deadLettered []string
+}
+
+type memoryOutboxEntry struct {
+	entry          storage.NotificationOutboxEntry
+	nextAttempt    time.Time
+	reason         string
+	deadLettered   bool
+	deadLetteredAt time.Time
+}
+
+func newMemoryOutboxStore() *memoryOutboxStore {
+	return &memoryOutboxStore{entries: make(map[int64]*memoryOutboxEntry)}
+}
+
+func (s *memoryOutboxStore) EnqueueNotifications(
+	_ context.Context,
+	_ *storage.ShardLease,
+	entries []*storage.NotificationOutboxEntry,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range entries {
+		s.nextID++
+		entry := *e
+		entry.ID = s.nextID
+		s.entries[entry.ID] = &memoryOutboxEntry{entry: entry, nextAttempt: time.Now()}
+	}
+	return nil
+}
+
+func (s *memoryOutboxStore) ClaimNotifications(
+	_ context.Context,
+	provider string,
+	limit int,
+	lease time.Duration,
+) ([]*storage.NotificationOutboxEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.claimLimits = append(s.claimLimits, limit)
+
+	var claimed []*storage.NotificationOutboxEntry
+	for id := int64(1); id <= s.nextID && len(claimed) < limit; id++ {
+		e, ok := s.entries[id]
+		if !ok || e.deadLettered || e.entry.Provider != provider || e.nextAttempt.After(time.Now()) {
+			continue
+		}
+		e.entry.Attempts++
+		e.nextAttempt = time.Now().Add(lease)
+		entry := e.entry
+		claimed = append(claimed, &entry)
+	}
+	return claimed, nil
+}
+
+func (s *memoryOutboxStore) CompleteNotification(_ context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, id)
+	return nil
+}
+
+func (s *memoryOutboxStore) RetryNotification(
+	_ context.Context,
+	id int64,
+	delay time.Duration,
+	reason string,
+	_ string,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retryDelays = append(s.retryDelays, delay)
+	s.entries[id].nextAttempt = time.Now()
+	s.entries[id].reason = reason
+	return nil
+}
+
+func (s *memoryOutboxStore) DeadLetterNotification(_ context.Context, id int64, reason string, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deadLettered = append(s.deadLettered, reason)
+	s.entries[id].deadLettered = true
+	s.entries[id].deadLetteredAt = time.Now()
+	s.entries[id].reason = reason
+	return nil
+}
+
+// DeleteDeliveredNotifications is a no-op, delivered entries are removed by CompleteNotification.
+func (s *memoryOutboxStore) DeleteDeliveredNotifications(context.Context, time.Duration) (int64, error) {
+	return 0, nil
+}
+
+func (s *memoryOutboxStore) DeleteDeadLetteredNotifications(_ context.Context, olderThan time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var deleted int64
+	for id, e := range s.entries {
+		if e.deadLettered && time.Since(e.deadLetteredAt) > olderThan {
+			delete(s.entries, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (s *memoryOutboxStore) GetNotificationOutboxStats(
+	_ context.Context,
+) ([]*storage.NotificationOutboxStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := make(map[string]*storage.NotificationOutboxStats)
+	for _, e := range s.entries {
+		st, ok := stats[e.entry.Provider]
+		if !ok {
+			st = &storage.NotificationOutboxStats{Provider: e.entry.Provider, Failures: map[string]int64{}}
+			stats[e.entry.Provider] = st
+		}
+		if e.deadLettered {
+			st.DeadLettered++
+		} else {
+			st.Pending++
+		}
+		if e.reason != "" {
+			st.Failures[e.reason]++
+		}
+	}
+	var result []*storage.NotificationOutboxStats
+	for _, st := range stats {
+		result = append(result, st)
+	}
+	return result, nil
+}
+
+func (s *memoryOutboxStore) numEntries() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
+
+func (s *memoryOutboxStore) numDeadLettered() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.deadLettered)
+}
+
+// deliverFunc implements notifications.OutboxDeliverer.
+type deliverFunc func(ctx context.Context, entry *storage.NotificationOutboxEntry) error
+
+func (f deliverFunc) Deliver(ctx context.Context, entry *storage.NotificationOutboxEntry) error {
+	return f(ctx, entry)
+}
+
+func newTestOutbox(store storage.NotificationOutboxStore, cfg *config.NotificationOutboxConfig) *notifications.Outbox {
+	return notifications.NewOutbox(
+		store,
+		cfg,
+		infra.NewMetricsFactory(nil, "", ""),
+		notifications.ProviderWebPush,
+		notifications.ProviderAPN,
+	)
+}
+
+func TestOutboxRetriesUntilDelivered(t *testing.T) {
+	var (
+		req            = require.New(t)
+		ctx, ctxCloser = test.NewTestContext()
+		store          = newMemoryOutboxStore()
+		cfg            = &config.NotificationOutboxConfig{
+			PollInterval:   10 * time.Millisecond,
+			InitialBackoff: time.Second,
+			MaxBackoff:     3 * time.Second,
+		}
+		outbox = newTestOutbox(store, cfg)
+	)
+	defer ctxCloser()
+
+	var mu sync.Mutex
+	attempts := 0
+	outbox.Start(ctx, deliverFunc(func(ctx context.Context, entry *storage.NotificationOutboxEntry) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts < 4 {
+			return &notifications.DeliveryError{Reason: "http_503", Retryable: true, Err: errors.New("unavailable")}
+		}
+		return nil
+	}))
+
+	req.NoError(outbox.Enqueue(ctx, nil, []*storage.NotificationOutboxEntry{{Provider: notifications.ProviderAPN}}))
+
+	req.Eventually(func() bool { return store.numEntries() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	req.Equal([]time.Duration{time.Second, 2 * time.Second, 3 * time.Second}, store.retryDelays)
+	req.Empty(store.deadLettered)
+}
+
+func TestOutboxDeadLetters(t *testing.T) {
+	var (
+		req            = require.New(t)
+		ctx, ctxCloser = test.NewTestContext()
+		store          = newMemoryOutboxStore()
+		cfg            = &config.NotificationOutboxConfig{
+			PollInterval:   10 * time.Millisecond,
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+		}
+		outbox = newTestOutbox(store, cfg)
+	)
+	defer ctxCloser()
+
+	outbox.Start(ctx, deliverFunc(func(ctx context.Context, entry *storage.NotificationOutboxEntry) error {
+		if string(entry.Payload) == "permanent" {
+			return &notifications.DeliveryError{Reason: "http_400", Err: errors.New("bad request")}
+		}
+		return errors.New("unavailable")
+	}))
+
+	req.NoError(outbox.Enqueue(ctx, nil, []*storage.NotificationOutboxEntry{
+		{Provider: notifications.ProviderWebPush, Payload: []byte("permanent")},
+		{Provider: notifications.ProviderWebPush, Payload: []byte("retryable")},
+	}))
+
+	req.Eventually(func() bool { return store.numDeadLettered() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	status, err := outbox.Status(ctx)
+	req.NoError(err)
+	req.Len(status.Providers, 2)
+	req.Equal(notifications.ProviderWebPush, status.Providers[0].Provider)
+	req.EqualValues(2, status.Providers[0].DeadLettered)
+	req.EqualValues(0, status.Providers[0].Pending)
+	req.Equal(map[string]int64{"http_400": 1, "error": 1}, status.Providers[0].Failures)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	// the permanent failure is dead lettered immediately, the other after max attempts
+	req.Len(store.retryDelays, 2)
+}
+
+func TestOutboxDeadLetterRetention(t *testing.T) {
+	var (
+		req            = require.New(t)
+		ctx, ctxCloser = test.NewTestContext()
+		store          = newMemoryOutboxStore()
+		cfg            = &config.NotificationOutboxConfig{
+			PollInterval:        10 * time.Millisecond,
+			DeadLetterRetention: 50 * time.Millisecond,
+		}
+		outbox = newTestOutbox(store, cfg)
+	)
+	defer ctxCloser()
+
+	outbox.Start(ctx, deliverFunc(func(ctx context.Context, entry *storage.NotificationOutboxEntry) error {
+		return &notifications.DeliveryError{Reason: "http_403", Err: errors.New("forbidden")}
+	}))
+
+	req.NoError(outbox.Enqueue(ctx, nil, []*storage.NotificationOutboxEntry{{Provider: notifications.ProviderWebPush}}))
+
+	req.Eventually(func() bool { return store.numDeadLettered() == 1 }, 5*time.Second, 10*time.Millisecond)
+	// the dead lettered notification is deleted after the retention period
+	req.Eventually(func() bool { return store.numEntries() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestOutboxCircuitBreaker(t *testing.T) {
+	var (
+		req            = require.New(t)
+		ctx, ctxCloser = test.NewTestContext()
+		store          = newMemoryOutboxStore()
+		cfg            = &config.NotificationOutboxConfig{
+			PollInterval:            10 * time.Millisecond,
+			BatchSize:               1,
+			InitialBackoff:          time.Millisecond,
+			CircuitBreakerThreshold: 2,
+			CircuitBreakerCooldown:  time.Hour,
+		}
+		outbox = newTestOutbox(store, cfg)
+	)
+	defer ctxCloser()
+
+	var mu sync.Mutex
+	delivered := 0
+	outbox.Start(ctx, deliverFunc(func(ctx context.Context, entry *storage.NotificationOutboxEntry) error {
+		mu.Lock()
+		defer mu.Unlock()
+		delivered++
+		return &notifications.DeliveryError{Reason: "http_503", Retryable: true, Err: errors.New("unavailable")}
+	}))
+
+	req.NoError(outbox.Enqueue(ctx, nil, []*storage.NotificationOutboxEntry{{Provider: notifications.ProviderAPN}}))
+
+	req.Eventually(func() bool {
+		status, err := outbox.Status(ctx)
+		return err == nil && status.Providers[1].Circuit == "open"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// no deliveries are attempted while the circuit is open
+	time.Sleep(100 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	req.Equal(2, delivered)
+}
@@ -0,0 +1,272 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/protocol"
+)
+
+const (
+	// fcmScope is the OAuth2 scope required to send messages with the FCM HTTP v1 API.
+	fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+	// fcmSendURL is the FCM HTTP v1 API endpoint to send messages, the argument is the Firebase project id.
+	fcmSendURL = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+	// fcmDefaultTokenURI is used when the service account doesn't specify a token URI.
+	fcmDefaultTokenURI = "https://oauth2.googleapis.com/token"
+	// fcmErrorUnregistered is the FCM error code for registration tokens that are no longer valid.
+	fcmErrorUnregistered = "UNREGISTERED"
+)
+
+type (
+	// fcmServiceAccount holds the fields of a Google service account key that are used to authenticate with FCM.
+	fcmServiceAccount struct {
+		ProjectID    string `json:"project_id"`
+		PrivateKeyID string `json:"private_key_id"`
+		PrivateKey   string `json:"private_key"`
+		ClientEmail  string `json:"client_email"`
+		TokenURI     string `json:"token_uri"`
+	}
+
+	// fcmClient sends messages with the FCM HTTP v1 API. It authenticates with an OAuth2 access token
+	// that is obtained with a signed JWT from the service account and cached until it expires.
+	fcmClient struct {
+		httpClient *http.Client
+		sendURL    string
+		account    fcmServiceAccount
+		signKey    *rsa.PrivateKey
+		expiration time.Duration
+
+		mu                sync.Mutex
+		accessToken       string
+		accessTokenExpiry time.Time
+	}
+
+	fcmMessage struct {
+		Token   string            `json:"token"`
+		Data    map[string]string `json:"data"`
+		Android fcmAndroidConfig  `json:"android"`
+	}
+
+	fcmAndroidConfig struct {
+		Priority    string `json:"priority"`
+		TTL         string `json:"ttl"`
+		CollapseKey string `json:"collapse_key,omitempty"`
+	}
+
+	fcmErrorResponse struct {
+		Error struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+			Status  string `json:"status"`
+			Details []struct {
+				Type      string `json:"@type"`
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+)
+
+func newFCMClient(cfg *config.FCMPushNotificationsConfig) (*fcmClient, error) {
+	var account fcmServiceAccount
+	if err := json.Unmarshal([]byte(cfg.ServiceAccount), &account); err != nil {
+		return nil, AsRiverError(err, protocol.Err_BAD_CONFIG).
+			Message("Unable to parse FCM service account").
+			Func("newFCMClient")
+	}
+
+	if account.ClientEmail == "" {
+		return nil, RiverError(protocol.Err_BAD_CONFIG, "Missing FCM service account client email").
+			Func("newFCMClient")
+	}
+
+	signKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, AsRiverError(err, protocol.Err_BAD_CONFIG).
+			Message("Invalid FCM service account private key").
+			Func("newFCMClient")
+	}
+
+	projectID := cfg.ProjectID
+	if projectID == "" {
+		projectID = account.ProjectID
+	}
+	if projectID == "" {
+		return nil, RiverError(protocol.Err_BAD_CONFIG, "Missing FCM project id").
+			Func("newFCMClient")
+	}
+
+	if account.TokenURI == "" {
+		account.TokenURI = fcmDefaultTokenURI
+	}
+
+	expiration := 12 * time.Hour // default
+	if cfg.Expiration > 0 {
+		expiration = cfg.Expiration
+	}
+
+	return &fcmClient{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		sendURL:    fmt.Sprintf(fcmSendURL, url.PathEscape(projectID)),
+		account:    account,
+		signKey:    signKey,
+		expiration: expiration,
+	}, nil
+}
+
+// getAccessToken returns a cached access token or requests a new one if the cached token is about to expire.
+func (c *fcmClient) getAccessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken != "" && time.Until(c.accessTokenExpiry) > time.Minute {
+		return c.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   c.account.ClientEmail,
+		"scope": fcmScope,
+		"aud":   c.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	assertion.Header["kid"] = c.account.PrivateKeyID
+
+	signedAssertion, err := assertion.SignedString(c.signKey)
+	if err != nil {
+		return "", AsRiverError(err).Message("Unable to sign FCM token request").Func("getAccessToken")
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {signedAssertion},
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, c.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", AsRiverError(err).Func("getAccessToken")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", AsRiverError(err, protocol.Err_UNAVAILABLE).
+			Message("Unable to obtain FCM access token").
+			Func("getAccessToken")
+	}
+	defer res.Body.Close()
+
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return "", RiverError(protocol.Err_UNAVAILABLE, "Unable to obtain FCM access token",
+			"statusCode", res.StatusCode,
+			"msg", string(body),
+		).Func("getAccessToken")
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil || token.AccessToken == "" {
+		return "", RiverError(protocol.Err_UNAVAILABLE, "Invalid FCM access token response").
+			Func("getAccessToken")
+	}
+
+	c.accessToken = token.AccessToken
+	c.accessTokenExpiry = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return c.accessToken, nil
+}
+
+// send sends the data message to the app instance with the given registration token. Pending messages
+// with the same non-empty collapse key are replaced. It returns the HTTP status code of the FCM response
+// and the FCM error code if the message wasn't sent.
+func (c *fcmClient) send(
+	ctx context.Context,
+	registrationToken string,
+	data map[string]string,
+	collapseKey string,
+) (int, string, error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return http.StatusBadGateway, "", err
+	}
+
+	body, err := json.Marshal(map[string]*fcmMessage{
+		"message": {
+			Token: registrationToken,
+			Data:  data,
+			Android: fcmAndroidConfig{
+				Priority:    "HIGH",
+				TTL:         fmt.Sprintf("%ds", int64(c.expiration.Seconds())),
+				CollapseKey: collapseKey,
+			},
+		},
+	})
+	if err != nil {
+		return http.StatusBadRequest, "", AsRiverError(err, protocol.Err_INTERNAL).Func("fcmClient.send")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.sendURL, bytes.NewReader(body))
+	if err != nil {
+		return http.StatusBadRequest, "", AsRiverError(err).Func("fcmClient.send")
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return http.StatusBadGateway, "", AsRiverError(err, protocol.Err_UNAVAILABLE).
+			Message("Send notification to FCM failed").
+			Func("fcmClient.send")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return res.StatusCode, "", nil
+	}
+
+	resBody, _ := io.ReadAll(res.Body)
+
+	var (
+		fcmErr    fcmErrorResponse
+		errorCode string
+	)
+	if err := json.Unmarshal(resBody, &fcmErr); err == nil {
+		errorCode = fcmErr.Error.Status
+		for _, detail := range fcmErr.Error.Details {
+			if detail.ErrorCode != "" {
+				errorCode = detail.ErrorCode
+			}
+		}
+	}
+
+	// force a new access token on the next attempt in case the cached token was revoked
+	if res.StatusCode == http.StatusUnauthorized {
+		c.mu.Lock()
+		c.accessToken = ""
+		c.mu.Unlock()
+	}
+
+	return res.StatusCode, errorCode, RiverError(protocol.Err_UNAVAILABLE,
+		"Send notification to FCM failed",
+		"statusCode", res.StatusCode,
+		"errorCode", errorCode,
+		"msg", string(resBody),
+	).Func("fcmClient.send")
+}
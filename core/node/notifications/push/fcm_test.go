@@ -0,0 +1,120 @@
+package push
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	"github.com/towns-protocol/towns/core/node/protocol"
+)
+
+func TestFCMPushNotification(t *testing.T) {
+	req := require.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	req.NoError(err)
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	req.NoError(err)
+
+	var tokenRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			tokenRequests.Add(1)
+			req.NoError(r.ParseForm())
+			assertion, err := jwt.Parse(r.PostForm.Get("assertion"), func(*jwt.Token) (any, error) {
+				return &key.PublicKey, nil
+			})
+			req.NoError(err)
+			req.Equal("fcm@test.iam.gserviceaccount.com", assertion.Claims.(jwt.MapClaims)["iss"])
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "access", "expires_in": 3600})
+		case "/send":
+			req.Equal("Bearer access", r.Header.Get("Authorization"))
+			var msg map[string]*fcmMessage
+			req.NoError(json.NewDecoder(r.Body).Decode(&msg))
+			req.Equal("HIGH", msg["message"].Android.Priority)
+			req.Equal("43200s", msg["message"].Android.TTL)
+			if msg["message"].Token == "expired" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error": {"code": 404, "status": "NOT_FOUND", "details": [
+					{"@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError", "errorCode": "UNREGISTERED"}]}}`))
+				return
+			}
+			req.Equal("hi", msg["message"].Data["content"])
+			_, _ = w.Write([]byte(`{"name": "projects/test/messages/1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	serviceAccount, err := json.Marshal(&fcmServiceAccount{
+		ProjectID:    "test",
+		PrivateKeyID: "key",
+		PrivateKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})),
+		ClientEmail:  "fcm@test.iam.gserviceaccount.com",
+		TokenURI:     server.URL + "/token",
+	})
+	req.NoError(err)
+
+	fcm, err := newFCMClient(&config.FCMPushNotificationsConfig{ServiceAccount: string(serviceAccount)})
+	req.NoError(err)
+	req.Equal("https://fcm.googleapis.com/v1/projects/test/messages:send", fcm.sendURL)
+	fcm.sendURL = server.URL + "/send"
+
+	notifier := &MessageNotifications{
+		fcm: fcm,
+		fcmSent: infra.NewMetricsFactory(nil, "", "").NewCounterVecEx(
+			"fcm_sent", "", "status", "payload_version"),
+	}
+
+	ctx := context.Background()
+	sub := &types.FCMPushSubscription{
+		Token:       "valid",
+		PushVersion: protocol.NotificationPushVersion_NOTIFICATION_PUSH_VERSION_2,
+	}
+
+	expired, statusCode, err := notifier.SendFCMNotification(
+		ctx, sub, common.Hash{1}, map[string]string{"content": "hi"}, "channel")
+	req.NoError(err)
+	req.False(expired)
+	req.Equal(http.StatusOK, statusCode)
+
+	sub.Token = "expired"
+	expired, statusCode, err = notifier.SendFCMNotification(
+		ctx, sub, common.Hash{2}, map[string]string{"content": "hi"}, "channel")
+	req.Error(err)
+	req.True(expired)
+	req.Equal(http.StatusNotFound, statusCode)
+
+	// the access token is cached
+	req.EqualValues(1, tokenRequests.Load())
+}
+
+func TestFCMNotConfigured(t *testing.T) {
+	req := require.New(t)
+
+	notifier := &MessageNotifications{}
+	expired, statusCode, err := notifier.SendFCMNotification(
+		context.Background(), &types.FCMPushSubscription{Token: "token"}, common.Hash{}, nil, "")
+	req.Error(err)
+	req.False(expired)
+	req.Equal(http.StatusNotImplemented, statusCode)
+
+	_, err = newFCMClient(&config.FCMPushNotificationsConfig{ServiceAccount: "{}"})
+	req.Error(err)
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/SherClockHolmes/webpush-go"
@@ -29,7 +30,8 @@ import (
 type (
 	MessageNotifier interface {
 		// SendWebPushNotification sends a web push message to the browser using the
-		// VAPID protocol to authenticate the message.
+		// VAPID protocol to authenticate the message. It returns true if the subscription expired
+		// and the HTTP status code of the push service response, 0 if no response was received.
 		SendWebPushNotification(
 			ctx context.Context,
 		// subscription object as returned by the browser on enabling subscriptions.
@@ -38,7 +40,9 @@ type (
 			eventHash common.Hash,
 		// payload of the message
 			payload []byte,
-		) (expired bool, err error)
+		// collapseID is sent as topic and replaces pending messages with the same topic, empty if not collapsed
+			collapseID string,
+		) (expired bool, statusCode int, err error)
 
 		// SendApplePushNotification sends a push notification to the iOS app
 		SendApplePushNotification(
@@ -51,6 +55,23 @@ type (
 			payload *payload2.Payload,
 		// payloadIncludesStreamEvent is true if the payload includes the stream event
 			payloadIncludesStreamEvent bool,
+		// collapseID replaces displayed notifications with the same collapse id, empty if not collapsed
+			collapseID string,
+		) (bool, int, error)
+
+		// SendFCMNotification sends a data message to the Android app through Firebase Cloud Messaging.
+		// It returns true if the registration token is no longer valid and the HTTP status code of the
+		// FCM response.
+		SendFCMNotification(
+			ctx context.Context,
+		// sub FCM
+			sub *types.FCMPushSubscription,
+		// event hash
+			eventHash common.Hash,
+		// data is sent to the APP
+			data map[string]string,
+		// collapseID is sent as collapse key and replaces pending messages with the same key, empty if not collapsed
+			collapseID string,
 		) (bool, int, error)
 	}
 
@@ -66,20 +87,27 @@ type (
 		vapidPublicKey  string
 		vapidSubject    string
 
+		// fcm is nil if FCM isn't configured
+		fcm *fcmClient
+
 		// metrics
 		webPushSent *prometheus.CounterVec
 		apnSent     *prometheus.CounterVec
+		fcmSent     *prometheus.CounterVec
 	}
 
 	// MessageNotificationsSimulator implements MessageNotifier but doesn't send
 	// the actual notification but only writes a log statement and captures the notification
 	// in its internal state. This is intended for development and testing purposes.
 	MessageNotificationsSimulator struct {
+		mu                             sync.Mutex
 		WebPushNotificationsByEndpoint map[string][][]byte
+		FCMNotificationsByToken        map[string][]map[string]string
 
 		// metrics
 		webPushSent *prometheus.CounterVec
 		apnSent     *prometheus.CounterVec
+		fcmSent     *prometheus.CounterVec
 	}
 )
 
@@ -101,10 +129,18 @@ func NewMessageNotificationsSimulator(metricsFactory infra.MetricsFactory) *Mess
 		"status",
 	)
 
+	fcmSent := metricsFactory.NewCounterVecEx(
+		"fcm_sent",
+		"Number of notifications send over FCM",
+		"status",
+	)
+
 	return &MessageNotificationsSimulator{
 		webPushSent:                    webPushSent,
 		apnSent:                        apnSent,
+		fcmSent:                        fcmSent,
 		WebPushNotificationsByEndpoint: make(map[string][][]byte),
+		FCMNotificationsByToken:        make(map[string][]map[string]string),
 	}
 }
 
@@ -160,6 +196,13 @@ func NewMessageNotifier(
 			Func("NewMessageNotifier")
 	}
 
+	var fcm *fcmClient
+	if cfg.FCM.ServiceAccount != "" {
+		if fcm, err = newFCMClient(&cfg.FCM); err != nil {
+			return nil, err
+		}
+	}
+
 	webPushSend := metricsFactory.NewCounterVecEx(
 		"webpush_sent",
 		"Number of notifications send over web push",
@@ -172,6 +215,12 @@ func NewMessageNotifier(
 		"status", "payload_stripped", "payload_version",
 	)
 
+	fcmSent := metricsFactory.NewCounterVecEx(
+		"fcm_sent",
+		"Number of notifications send over FCM",
+		"status", "payload_version",
+	)
+
 	return &MessageNotifications{
 		apnsAppBundleID: cfg.APN.AppBundleID,
 		apnExpiration:   apnExpiration,
@@ -181,8 +230,10 @@ func NewMessageNotifier(
 		vapidPrivateKey: cfg.Web.Vapid.PrivateKey,
 		vapidPublicKey:  cfg.Web.Vapid.PublicKey,
 		vapidSubject:    cfg.Web.Vapid.Subject,
+		fcm:             fcm,
 		webPushSent:     webPushSend,
 		apnSent:         apnSent,
+		fcmSent:         fcmSent,
 	}, nil
 }
 
@@ -191,9 +242,11 @@ func (n *MessageNotifications) SendWebPushNotification(
 	subscription *webpush.Subscription,
 	eventHash common.Hash,
 	payload []byte,
-) (expired bool, err error) {
+	collapseID string,
+) (expired bool, statusCode int, err error) {
 	options := &webpush.Options{
 		Subscriber:      n.vapidSubject,
+		Topic:           collapseID,
 		TTL:             30,
 		Urgency:         webpush.UrgencyHigh,
 		VAPIDPublicKey:  n.vapidPublicKey,
@@ -203,7 +256,7 @@ func (n *MessageNotifications) SendWebPushNotification(
 	res, err := webpush.SendNotificationWithContext(ctx, payload, subscription, options)
 	if err != nil {
 		n.webPushSent.With(prometheus.Labels{"status": fmt.Sprintf("%d", http.StatusServiceUnavailable)}).Inc()
-		return false, AsRiverError(err).
+		return false, 0, AsRiverError(err).
 			Message("Send notification with WebPush failed").
 			Func("SendWebPushNotification")
 	}
@@ -212,7 +265,7 @@ func (n *MessageNotifications) SendWebPushNotification(
 	n.webPushSent.With(prometheus.Labels{"status": fmt.Sprintf("%d", res.StatusCode)}).Inc()
 
 	if res.StatusCode == http.StatusCreated {
-		return false, nil
+		return false, res.StatusCode, nil
 	}
 
 	riverErr := RiverError(protocol.Err_UNAVAILABLE,
@@ -227,7 +280,7 @@ func (n *MessageNotifications) SendWebPushNotification(
 	}
 
 	subExpired := res.StatusCode == http.StatusGone
-	return subExpired, riverErr
+	return subExpired, res.StatusCode, riverErr
 }
 
 func (n *MessageNotifications) SendApplePushNotification(
@@ -236,10 +289,12 @@ func (n *MessageNotifications) SendApplePushNotification(
 	eventHash common.Hash,
 	payload *payload2.Payload,
 	payloadIncludesStreamEvent bool,
+	collapseID string,
 ) (bool, int, error) {
 	notification := &apns2.Notification{
 		DeviceToken: hex.EncodeToString(sub.DeviceToken),
 		Topic:       n.apnsAppBundleID,
+		CollapseID:  collapseID,
 		Payload:     payload,
 		Priority:    apns2.PriorityHigh,
 		PushType:    apns2.PushTypeAlert,
@@ -307,24 +362,62 @@ func (n *MessageNotifications) SendApplePushNotification(
 	return subExpired, res.StatusCode, riverErr
 }
 
+func (n *MessageNotifications) SendFCMNotification(
+	ctx context.Context,
+	sub *types.FCMPushSubscription,
+	eventHash common.Hash,
+	data map[string]string,
+	collapseID string,
+) (bool, int, error) {
+	if n.fcm == nil {
+		return false, http.StatusNotImplemented, RiverError(protocol.Err_UNAVAILABLE, "FCM is not configured").
+			Func("SendFCMNotification")
+	}
+
+	statusCode, errorCode, err := n.fcm.send(ctx, sub.Token, data, collapseID)
+
+	n.fcmSent.With(prometheus.Labels{
+		"status":          fmt.Sprintf("%d", statusCode),
+		"payload_version": fmt.Sprintf("%d", sub.PushVersion),
+	}).Inc()
+
+	if err == nil {
+		logging.FromCtx(ctx).Infow("FCM notification sent",
+			"event", eventHash,
+			"payloadVersion", sub.PushVersion)
+		return false, statusCode, nil
+	}
+
+	// FCM returns 404 UNREGISTERED for tokens of uninstalled apps and expired tokens
+	subExpired := statusCode == http.StatusNotFound || errorCode == fcmErrorUnregistered
+
+	return subExpired, statusCode, AsRiverError(err).
+		Tags("event", eventHash, "payloadVersion", sub.PushVersion).
+		Func("SendFCMNotification")
+}
+
 func (n *MessageNotificationsSimulator) SendWebPushNotification(
 	ctx context.Context,
 	subscription *webpush.Subscription,
 	eventHash common.Hash,
 	payload []byte,
-) (bool, error) {
+	collapseID string,
+) (bool, int, error) {
 	log := logging.FromCtx(ctx)
 	log.Infow("SendWebPushNotification",
 		"keys.p256dh", subscription.Keys.P256dh,
 		"keys.auth", subscription.Keys.Auth,
-		"payload", payload)
+		"payload", payload,
+		"collapseID", collapseID)
 
+	n.mu.Lock()
 	n.WebPushNotificationsByEndpoint[subscription.Endpoint] = append(
 		n.WebPushNotificationsByEndpoint[subscription.Endpoint], payload)
+	n.mu.Unlock()
 
 	n.webPushSent.With(prometheus.Labels{"status": "200"}).Inc()
 
-	return false, nil
+	return false, http.StatusCreated, nil
 }
 
 func (n *MessageNotificationsSimulator) SendApplePushNotification(
@@ -333,6 +426,7 @@ func (n *MessageNotificationsSimulator) SendApplePushNotification(
 	eventHash common.Hash,
 	payload *payload2.Payload,
 	payloadIncludesStreamEvent bool,
+	collapseID string,
 ) (bool, int, error) {
 	log := logging.FromCtx(ctx)
 
@@ -342,9 +436,36 @@ func (n *MessageNotificationsSimulator) SendApplePushNotification(
 		"payload", payload,
 		"payloadStripped", payloadIncludesStreamEvent,
 		"payloadVersion", fmt.Sprintf("%d", sub.PushVersion),
+		"collapseID", collapseID,
 	)
 
 	n.apnSent.With(prometheus.Labels{"status": "200"}).Inc()
 
 	return false, http.StatusOK, nil
 }
+
+func (n *MessageNotificationsSimulator) SendFCMNotification(
+	ctx context.Context,
+	sub *types.FCMPushSubscription,
+	eventHash common.Hash,
+	data map[string]string,
+	collapseID string,
+) (bool, int, error) {
+	log := logging.FromCtx(ctx)
+
+	log.Debugw("SendFCMNotification",
+		"token", sub.Token,
+		"event", eventHash,
+		"data", data,
+		"payloadVersion", fmt.Sprintf("%d", sub.PushVersion),
+		"collapseID", collapseID,
+	)
+
+	n.mu.Lock()
+	n.FCMNotificationsByToken[sub.Token] = append(n.FCMNotificationsByToken[sub.Token], data)
+	n.mu.Unlock()
+
+	n.fcmSent.With(prometheus.Labels{"status": "200"}).Inc()
+
+	return false, http.StatusOK, nil
+}
@@ -64,7 +64,7 @@ func TestAPNSPushNotification(t *testing.T) {
 	}
 
 	expired, _, err := notifier.SendApplePushNotification(
-		ctx, &sub, common.Hash{1}, payload, true)
+		ctx, &sub, common.Hash{1}, payload, true, "")
 	req.False(expired, "subscription should not be expired")
 	req.NoError(err, "send APN notification")
 }
@@ -121,7 +121,7 @@ func TestWebPushWithVapid(t *testing.T) {
 
 	// payload := payload2.NewPayload().Alert("Sry to bother you if this works...")
 
-	expired, err := notifier.SendWebPushNotification(ctx, subscription, common.Hash{1}, payload)
+	expired, _, err := notifier.SendWebPushNotification(ctx, subscription, common.Hash{1}, payload, "")
 	req.False(expired, "expired")
 	req.NoError(err, "send web push notification")
 }
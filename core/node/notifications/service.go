@@ -2,6 +2,7 @@ package notifications
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"connectrpc.com/connect"
@@ -38,6 +39,7 @@ type (
 		nodes               []nodes.NodeRegistry
 		listener            track_streams.StreamEventListener
 		streamsTracker      track_streams.StreamsTracker
+		shards              *ShardManager
 		metrics             infra.MetricsFactory
 	}
 )
@@ -51,6 +53,7 @@ func NewService(
 	nodes []nodes.NodeRegistry,
 	metrics infra.MetricsFactory,
 	listener track_streams.StreamEventListener,
+	shards *ShardManager,
 ) (*Service, error) {
 	tracker, err := notificationssync.NewNotificationsStreamsTracker(
 		ctx,
@@ -59,6 +62,7 @@ func NewService(
 		nodes,
 		listener,
 		userPreferences,
+		shards,
 		metrics,
 	)
 	if err != nil {
@@ -73,6 +77,7 @@ func NewService(
 		nodes:               nodes,
 		listener:            listener,
 		streamsTracker:      tracker,
+		shards:              shards,
 		metrics:             metrics,
 	}
 	if err := service.AuthServiceMixin.InitAuthentication(
@@ -88,6 +93,46 @@ func NewService(
 func (s *Service) Start(ctx context.Context) {
 	log := logging.FromCtx(ctx)
 
+	// start and stop syncing streams when shards move between instances, loading streams from the registry
+	// can take a while and is done in the background to not delay lease renewals
+	var (
+		refresh = make(chan struct{}, 1)
+		mu      sync.Mutex
+		// historySince is the creation time from which events of newly acquired streams are processed,
+		// it is set when the first change after the last refresh is detected
+		historySince time.Time
+	)
+	s.shards.Start(ctx, func(context.Context) {
+		mu.Lock()
+		if historySince.IsZero() {
+			historySince = time.Now().Add(-s.shards.HandoverWindow())
+		}
+		mu.Unlock()
+
+		select {
+		case refresh <- struct{}{}:
+		default:
+		}
+	})
+
+	go func() {
+		for {
+			select {
+			case <-refresh:
+				mu.Lock()
+				since := historySince
+				historySince = time.Time{}
+				mu.Unlock()
+
+				if err := s.streamsTracker.Refresh(ctx, since); err != nil {
+					log.Errorw("Unable to refresh tracked streams", "err", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
 	go func() {
 		for {
 			log.Infow("Start notification streams tracker")
@@ -128,6 +173,8 @@ func (s *Service) GetSettings(
 		GdmGlobal:   preferences.GDM,
 		DmChannels:  preferences.DMChannels.Protobuf(),
 		GdmChannels: preferences.GDMChannels.Protobuf(),
+		QuietHours:  preferences.QuietHours.Protobuf(),
+		Snoozes:     preferences.SpaceSnoozes.Protobuf(preferences.Snooze),
 	})
 
 	for _, wp := range preferences.Subscriptions.WebPush {
@@ -147,6 +194,13 @@ func (s *Service) GetSettings(
 		})
 	}
 
+	for _, fcm := range preferences.Subscriptions.FCMPush {
+		resp.Msg.FcmSubscriptions = append(resp.Msg.FcmSubscriptions, &FCMSubscription{
+			Token:       fcm.Token,
+			PushVersion: fcm.PushVersion,
+		})
+	}
+
 	return resp, nil
 }
 
@@ -432,3 +486,139 @@ func (s *Service) UnsubscribeAPN(
 
 	return connect.NewResponse(&UnsubscribeAPNResponse{}), nil
 }
+
+func (s *Service) SubscribeFCM(
+	ctx context.Context,
+	req *connect.Request[SubscribeFCMRequest],
+) (*connect.Response[SubscribeFCMResponse], error) {
+	var (
+		msg         = req.Msg
+		userID      = authentication.UserFromAuthenticatedContext(ctx)
+		token       = msg.GetToken()
+		pushVersion = msg.GetPushVersion()
+	)
+
+	if token == "" {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid FCM registration token")
+	}
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid user id")
+	}
+
+	if pushVersion == NotificationPushVersion_NOTIFICATION_PUSH_VERSION_UNSPECIFIED {
+		pushVersion = NotificationPushVersion_NOTIFICATION_PUSH_VERSION_1
+	}
+
+	if err := s.userPreferences.AddFCMSubscription(ctx, userID, token, pushVersion); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&SubscribeFCMResponse{}), nil
+}
+
+func (s *Service) UnsubscribeFCM(
+	ctx context.Context,
+	req *connect.Request[UnsubscribeFCMRequest],
+) (*connect.Response[UnsubscribeFCMResponse], error) {
+	var (
+		msg    = req.Msg
+		token  = msg.GetToken()
+		userID = authentication.UserFromAuthenticatedContext(ctx)
+	)
+	if token == "" {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid FCM registration token")
+	}
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid user id")
+	}
+
+	logging.FromCtx(ctx).Infow("remove FCM subscription", "userID", userID)
+
+	if err := s.userPreferences.RemoveFCMSubscription(ctx, token, userID); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&UnsubscribeFCMResponse{}), nil
+}
+
+// SetQuietHours sets the recurring time windows in which the user doesn't receive notifications.
+func (s *Service) SetQuietHours(
+	ctx context.Context,
+	req *connect.Request[SetQuietHoursRequest],
+) (*connect.Response[SetQuietHoursResponse], error) {
+	userID := authentication.UserFromAuthenticatedContext(ctx)
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid user id")
+	}
+
+	quietHours, err := types.DecodeQuietHoursFromMsg(req.Msg.GetQuietHours())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userPreferences.SetQuietHours(ctx, userID, quietHours); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&SetQuietHoursResponse{}), nil
+}
+
+// SetSnooze suppresses notifications for a space, or for all streams, until the given time.
+func (s *Service) SetSnooze(
+	ctx context.Context,
+	req *connect.Request[SetSnoozeRequest],
+) (*connect.Response[SetSnoozeResponse], error) {
+	userID := authentication.UserFromAuthenticatedContext(ctx)
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid user id")
+	}
+
+	msg := req.Msg.GetSnooze()
+
+	var spaceID *shared.StreamId
+	if len(msg.GetSpaceId()) > 0 {
+		id, err := shared.StreamIdFromBytes(msg.GetSpaceId())
+		if err != nil {
+			return nil, err
+		}
+		if id.Type() != shared.STREAM_SPACE_BIN {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid space id", "space", id)
+		}
+		spaceID = &id
+	}
+
+	var snooze *types.SnoozeSettings
+	if msg.GetUntilEpochMs() != 0 {
+		snooze = &types.SnoozeSettings{
+			Until:         time.UnixMilli(msg.GetUntilEpochMs()),
+			AllowMentions: msg.GetAllowMentions(),
+		}
+		if !snooze.Active(time.Now()) {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Snooze must end in the future",
+				"until", snooze.Until)
+		}
+	}
+
+	if err := s.userPreferences.SetSnooze(ctx, userID, spaceID, snooze); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&SetSnoozeResponse{}), nil
+}
+
+func (s *Service) GetUnreadCounts(
+	ctx context.Context,
+	_ *connect.Request[GetUnreadCountsRequest],
+) (*connect.Response[GetUnreadCountsResponse], error) {
+	userID := authentication.UserFromAuthenticatedContext(ctx)
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid user id")
+	}
+
+	counts, err := s.userPreferences.GetUnreadCounts(ctx, userID)
+	if err != nil {
+		return nil, AsRiverError(err).Func("GetUnreadCounts")
+	}
+
+	return connect.NewResponse(types.UnreadCountsToProtobuf(counts)), nil
+}
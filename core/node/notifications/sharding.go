@@ -0,0 +1,283 @@
+package notifications
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+// shardReleaseTimeout is how long the shard manager waits for its leases to be released when it stops.
+const shardReleaseTimeout = 5 * time.Second
+
+// ShardManager divides streams over notification service instances. Streams are assigned to a fixed number
+// of shards by the hash of their id and shards are assigned to the instances that are alive with rendezvous
+// hashing. An instance only tracks and sends notifications for streams in shards it holds a lease for.
+//
+// Leases are renewed periodically. When an instance stops its shards are reassigned to the remaining instances
+// which acquire them once the lease of the stopped instance expired. Notifications are only added to the outbox
+// while the shard lease is held, which prevents that an instance that lost its lease sends notifications for
+// events that the new holder also processes.
+//
+// When sharding is disabled the instance is responsible for all streams.
+type ShardManager struct {
+	store         storage.NotificationShardLeaseStore
+	shards        int
+	instanceID    string
+	leaseDuration time.Duration
+
+	mu sync.RWMutex
+	// owned maps the shards this instance holds to the time until it can assume it holds the lease.
+	owned map[int]time.Time
+
+	ownedShards prometheus.Gauge
+}
+
+// NewShardManager creates a shard manager with the given config. Sharding is disabled when the number
+// of shards is not set.
+func NewShardManager(
+	store storage.NotificationShardLeaseStore,
+	cfg *config.NotificationShardingConfig,
+	metrics infra.MetricsFactory,
+) *ShardManager {
+	instanceID := cfg.InstanceID
+	if instanceID == "" {
+		instanceID = randomInstanceID()
+	}
+
+	return &ShardManager{
+		store:         store,
+		shards:        cfg.Shards,
+		instanceID:    instanceID,
+		leaseDuration: cfg.GetLeaseDuration(),
+		owned:         make(map[int]time.Time),
+		ownedShards: metrics.NewGaugeEx(
+			"notification_owned_shards",
+			"Number of shards this notification service instance holds",
+		),
+	}
+}
+
+func randomInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "notifications"
+	}
+	var suffix [4]byte
+	_, _ = rand.Read(suffix[:])
+	return hostname + "-" + hex.EncodeToString(suffix[:])
+}
+
+// Enabled returns true when streams are divided over multiple instances.
+func (m *ShardManager) Enabled() bool {
+	return m.shards > 0
+}
+
+// Owns returns true if this instance is responsible for the given stream.
+func (m *ShardManager) Owns(streamID shared.StreamId) bool {
+	if !m.Enabled() {
+		return true
+	}
+
+	m.mu.RLock()
+	expires, ok := m.owned[shardOf(streamID, m.shards)]
+	m.mu.RUnlock()
+
+	return ok && time.Now().Before(expires)
+}
+
+// Lease returns the lease that must be held to send notifications for events in the given stream,
+// or nil when sharding is disabled.
+func (m *ShardManager) Lease(streamID shared.StreamId) *storage.ShardLease {
+	if !m.Enabled() {
+		return nil
+	}
+	return &storage.ShardLease{Shard: shardOf(streamID, m.shards), Owner: m.instanceID}
+}
+
+// HandoverWindow returns how long events in a newly acquired shard may not have been processed. The previous
+// holder can stop up to a lease duration before its lease expires and the expired lease is acquired at the
+// next renewal.
+func (m *ShardManager) HandoverWindow() time.Duration {
+	return m.leaseDuration + m.leaseDuration/3
+}
+
+// Start acquires the shards assigned to this instance and keeps renewing and rebalancing them in the background
+// until ctx expires. After the initial acquisition onChange is called each time the set of held shards changes.
+// Held shards are released when ctx expires.
+func (m *ShardManager) Start(ctx context.Context, onChange func(ctx context.Context)) {
+	if !m.Enabled() {
+		return
+	}
+
+	logging.FromCtx(ctx).Infow("Start shard manager", "instance", m.instanceID, "shards", m.shards)
+
+	m.update(ctx)
+	go m.run(ctx, onChange)
+}
+
+func (m *ShardManager) run(ctx context.Context, onChange func(ctx context.Context)) {
+	ticker := time.NewTicker(m.leaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.releaseAll(ctx)
+			return
+		case <-ticker.C:
+			if m.update(ctx) {
+				onChange(ctx)
+			}
+		}
+	}
+}
+
+// update renews the instance heartbeat and acquires the shards that are assigned to this instance. Shards
+// that are assigned to another instance are released. It returns true if the set of held shards changed.
+func (m *ShardManager) update(ctx context.Context) bool {
+	var (
+		log     = logging.FromCtx(ctx)
+		renewAt = time.Now() // leases expire in the database no earlier than leaseDuration from now
+	)
+
+	instances, err := m.store.HeartbeatNotificationInstance(ctx, m.instanceID, m.leaseDuration)
+	if err != nil {
+		log.Errorw("Unable to renew notification instance heartbeat", "instance", m.instanceID, "err", err)
+		return m.dropExpired()
+	}
+
+	assigned := assignShards(instances, m.shards, m.instanceID)
+
+	// stop processing shards assigned to another instance before their leases are released
+	var released []int
+	m.mu.Lock()
+	for shard := range m.owned {
+		if !slices.Contains(assigned, shard) {
+			delete(m.owned, shard)
+			released = append(released, shard)
+		}
+	}
+	m.mu.Unlock()
+
+	if err := m.store.ReleaseShardLeases(ctx, m.instanceID, released); err != nil {
+		log.Warnw("Unable to release shard leases", "shards", released, "err", err)
+	}
+
+	acquired, err := m.store.AcquireShardLeases(ctx, m.instanceID, assigned, m.leaseDuration)
+	if err != nil {
+		log.Errorw("Unable to acquire shard leases", "instance", m.instanceID, "err", err)
+		return m.dropExpired() || len(released) > 0
+	}
+
+	m.mu.Lock()
+	changed := len(released) > 0 || len(acquired) != len(m.owned)
+	owned := make(map[int]time.Time, len(acquired))
+	for _, shard := range acquired {
+		if _, ok := m.owned[shard]; !ok {
+			changed = true
+		}
+		owned[shard] = renewAt.Add(m.leaseDuration)
+	}
+	m.owned = owned
+	m.mu.Unlock()
+
+	m.ownedShards.Set(float64(len(acquired)))
+
+	if changed {
+		log.Infow("Shard assignment changed",
+			"instance", m.instanceID,
+			"instances", len(instances),
+			"assigned", len(assigned),
+			"held", len(acquired),
+			"released", len(released))
+	}
+
+	return changed
+}
+
+// dropExpired removes the shards for which the lease could not be renewed in time and returns true
+// if shards were removed.
+func (m *ShardManager) dropExpired() bool {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dropped := false
+	for shard, expires := range m.owned {
+		if !now.Before(expires) {
+			delete(m.owned, shard)
+			dropped = true
+		}
+	}
+	m.ownedShards.Set(float64(len(m.owned)))
+	return dropped
+}
+
+// releaseAll releases all held shards, allowing other instances to take them over without waiting
+// for the leases to expire.
+func (m *ShardManager) releaseAll(ctx context.Context) {
+	m.mu.Lock()
+	released := make([]int, 0, len(m.owned))
+	for shard := range m.owned {
+		released = append(released, shard)
+	}
+	m.owned = make(map[int]time.Time)
+	m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shardReleaseTimeout)
+	defer cancel()
+
+	if err := m.store.ReleaseShardLeases(ctx, m.instanceID, released); err != nil {
+		logging.FromCtx(ctx).Warnw("Unable to release shard leases", "instance", m.instanceID, "err", err)
+	}
+}
+
+// shardOf returns the shard the stream belongs to.
+func shardOf(streamID shared.StreamId, shards int) int {
+	h := sha256.Sum256(streamID[:])
+	return int(binary.BigEndian.Uint64(h[:8]) % uint64(shards))
+}
+
+// assignShards returns the shards that are assigned to the given instance. Each shard is assigned to the
+// instance with the highest hash of instance id and shard. When an instance joins or leaves only the shards
+// assigned to that instance move.
+func assignShards(instances []string, shards int, instanceID string) []int {
+	var assigned []int
+	for shard := range shards {
+		var (
+			best      string
+			bestScore uint64
+		)
+		for _, instance := range instances {
+			if score := shardScore(instance, shard); best == "" || score > bestScore {
+				best, bestScore = instance, score
+			}
+		}
+		if best == instanceID {
+			assigned = append(assigned, shard)
+		}
+	}
+	return assigned
+}
+
+func shardScore(instanceID string, shard int) uint64 {
+	h := sha256.New()
+	_, _ = h.Write([]byte(instanceID))
+	_ = binary.Write(h, binary.BigEndian, uint64(shard))
+	return binary.BigEndian.Uint64(h.Sum(nil)[:8])
+}
@@ -0,0 +1,232 @@
+package notifications
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+// memoryShardLeaseStore is an in-memory storage.NotificationShardLeaseStore.
+type memoryShardLeaseStore struct {
+	mu        sync.Mutex
+	instances map[string]time.Time
+	leases    map[int]storage.ShardLease
+	expires   map[int]time.Time
+}
+
+func newMemoryShardLeaseStore() *memoryShardLeaseStore {
+	return &memoryShardLeaseStore{
+		instances: make(map[string]time.Time),
+		leases:    make(map[int]storage.ShardLease),
+		expires:   make(map[int]time.Time),
+	}
+}
+
+func (s *memoryShardLeaseStore) HeartbeatNotificationInstance(
+	_ context.Context,
+	instanceID string,
+	ttl time.Duration,
+) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.instances[instanceID] = now.Add(ttl)
+
+	var instances []string
+	for instance, expires := range s.instances {
+		if now.Before(expires) {
+			instances = append(instances, instance)
+		}
+	}
+	slices.Sort(instances)
+	return instances, nil
+}
+
+func (s *memoryShardLeaseStore) AcquireShardLeases(
+	_ context.Context,
+	instanceID string,
+	shards []int,
+	ttl time.Duration,
+) ([]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var acquired []int
+	for _, shard := range shards {
+		if lease, ok := s.leases[shard]; ok && lease.Owner != instanceID && now.Before(s.expires[shard]) {
+			continue
+		}
+		s.leases[shard] = storage.ShardLease{Shard: shard, Owner: instanceID}
+		s.expires[shard] = now.Add(ttl)
+		acquired = append(acquired, shard)
+	}
+	return acquired, nil
+}
+
+func (s *memoryShardLeaseStore) ReleaseShardLeases(_ context.Context, instanceID string, shards []int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, shard := range shards {
+		if s.leases[shard].Owner == instanceID {
+			delete(s.leases, shard)
+			delete(s.expires, shard)
+		}
+	}
+	return nil
+}
+
+func TestAssignShards(t *testing.T) {
+	req := require.New(t)
+
+	const shards = 64
+	instances := []string{"instance1", "instance2", "instance3"}
+
+	assignments := make(map[string][]int)
+	owners := make(map[int]int)
+	for _, instance := range instances {
+		assignments[instance] = assignShards(instances, shards, instance)
+		req.NotEmpty(assignments[instance])
+		for _, shard := range assignments[instance] {
+			owners[shard]++
+		}
+	}
+
+	req.Len(owners, shards)
+	for shard, count := range owners {
+		req.Equal(1, count, "shard %d must be assigned to exactly one instance", shard)
+	}
+
+	// when an instance leaves only its shards move to the remaining instances
+	remaining := []string{"instance1", "instance3"}
+	for _, instance := range remaining {
+		assigned := assignShards(remaining, shards, instance)
+		for _, shard := range assignments[instance] {
+			req.Contains(assigned, shard)
+		}
+		for _, shard := range assigned {
+			req.True(slices.Contains(assignments[instance], shard) ||
+				slices.Contains(assignments["instance2"], shard))
+		}
+	}
+}
+
+func TestShardOf(t *testing.T) {
+	req := require.New(t)
+
+	streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	shard := shardOf(streamID, 16)
+	req.GreaterOrEqual(shard, 0)
+	req.Less(shard, 16)
+	req.Equal(shard, shardOf(streamID, 16))
+}
+
+func TestShardManagerDisabled(t *testing.T) {
+	req := require.New(t)
+
+	m := NewShardManager(
+		newMemoryShardLeaseStore(),
+		&config.NotificationShardingConfig{},
+		infra.NewMetricsFactory(nil, "", ""),
+	)
+
+	streamID := testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)
+	req.False(m.Enabled())
+	req.True(m.Owns(streamID))
+	req.Nil(m.Lease(streamID))
+}
+
+func TestShardManagerTakeover(t *testing.T) {
+	req := require.New(t)
+	ctx := context.Background()
+
+	const (
+		shards        = 8
+		leaseDuration = 300 * time.Millisecond
+	)
+
+	var (
+		store   = newMemoryShardLeaseStore()
+		metrics = infra.NewMetricsFactory(nil, "", "")
+		m1      = NewShardManager(store, &config.NotificationShardingConfig{
+			Shards: shards, InstanceID: "instance1", LeaseDuration: leaseDuration,
+		}, metrics)
+		m2 = NewShardManager(store, &config.NotificationShardingConfig{
+			Shards: shards, InstanceID: "instance2", LeaseDuration: leaseDuration,
+		}, metrics)
+		streams = make([]shared.StreamId, 64)
+	)
+	for i := range streams {
+		streams[i] = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	}
+
+	requireSingleOwner := func() {
+		for _, streamID := range streams {
+			req.NotEqual(m1.Owns(streamID), m2.Owns(streamID), "stream must be owned by exactly one instance")
+		}
+	}
+
+	// a single instance holds all shards
+	req.True(m1.update(ctx))
+	for _, streamID := range streams {
+		req.True(m1.Owns(streamID))
+	}
+
+	// shards assigned to the second instance are acquired after the first instance released them
+	req.False(m2.update(ctx), "shards are still held by the first instance")
+	req.True(m1.update(ctx))
+	req.True(m2.update(ctx))
+	requireSingleOwner()
+	req.NotEmpty(m1.owned)
+	req.NotEmpty(m2.owned)
+
+	// renewals don't change the assignment
+	req.False(m1.update(ctx))
+	req.False(m2.update(ctx))
+	requireSingleOwner()
+
+	// the first instance stops renewing, its shards are taken over after its leases expired
+	time.Sleep(leaseDuration + 50*time.Millisecond)
+	req.True(m2.update(ctx))
+	for _, streamID := range streams {
+		req.False(m1.Owns(streamID), "expired leases are not held")
+		req.True(m2.Owns(streamID))
+
+		lease := m2.Lease(streamID)
+		req.Equal("instance2", lease.Owner)
+		req.Equal(shardOf(streamID, shards), lease.Shard)
+	}
+}
+
+func TestShardManagerReleasesOnStop(t *testing.T) {
+	req := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	store := newMemoryShardLeaseStore()
+	m := NewShardManager(store, &config.NotificationShardingConfig{
+		Shards: 4, InstanceID: "instance1", LeaseDuration: time.Minute,
+	}, infra.NewMetricsFactory(nil, "", ""))
+
+	m.Start(ctx, func(context.Context) {})
+	req.Len(store.leases, 4)
+
+	// leases are released on stop so other instances don't have to wait for them to expire
+	cancel()
+	req.Eventually(func() bool {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		return len(store.leases) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
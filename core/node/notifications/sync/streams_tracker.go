@@ -13,11 +13,18 @@ import (
 	"github.com/towns-protocol/towns/core/node/track_streams"
 )
 
+// ShardFilter determines if this instance is responsible for a stream when streams are divided over
+// multiple notification service instances.
+type ShardFilter interface {
+	Owns(streamID shared.StreamId) bool
+}
+
 // NotificationsStreamTracker implements the StreamsTracker interface for the notifications service. It encapsulates
 // StreamsTracker functionality with notifications-specific data structures.
 type NotificationsStreamsTracker struct {
 	track_streams.StreamsTrackerImpl
 	storage UserPreferencesStore
+	shards  ShardFilter
 }
 
 var _ track_streams.StreamFilter = (*NotificationsStreamsTracker)(nil)
@@ -30,10 +37,12 @@ func NewNotificationsStreamsTracker(
 	nodeRegistries []nodes.NodeRegistry,
 	listener track_streams.StreamEventListener,
 	storage UserPreferencesStore,
+	shards ShardFilter,
 	metricsFactory infra.MetricsFactory,
 ) (track_streams.StreamsTracker, error) {
 	tracker := &NotificationsStreamsTracker{
 		storage: storage,
+		shards:  shards,
 	}
 	if err := tracker.StreamsTrackerImpl.Init(ctx, onChainConfig, riverRegistry, nodeRegistries, listener, tracker, metricsFactory); err != nil {
 		return nil, err
@@ -58,12 +67,16 @@ func (tracker *NotificationsStreamsTracker) NewTrackedStream(
 	)
 }
 
-// TrackStream returns true if the given streamID must be tracked for notifications.
+// TrackStream returns true if the given streamID must be tracked for notifications by this instance.
+// User settings streams are tracked by all instances because blocked users are kept in memory.
 func (tracker *NotificationsStreamsTracker) TrackStream(streamID shared.StreamId) bool {
 	streamType := streamID.Type()
 
-	return streamType == shared.STREAM_DM_CHANNEL_BIN ||
+	if streamType == shared.STREAM_USER_SETTINGS_BIN { // users add addresses of blocked users into their settings stream
+		return true
+	}
+
+	return (streamType == shared.STREAM_DM_CHANNEL_BIN ||
 		streamType == shared.STREAM_GDM_CHANNEL_BIN ||
-		streamType == shared.STREAM_CHANNEL_BIN ||
-		streamType == shared.STREAM_USER_SETTINGS_BIN // users add addresses of blocked users into their settings stream
+		streamType == shared.STREAM_CHANNEL_BIN) && tracker.shards.Owns(streamID)
 }
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -25,6 +26,23 @@ type (
 			user common.Address,
 			blockedUser common.Address,
 		)
+
+		// ResetUnreadCounts removes the unread counters of the user for the given channels
+		ResetUnreadCounts(
+			ctx context.Context,
+			user common.Address,
+			channelIDs []shared.StreamId,
+		) error
+	}
+
+	// fullyReadMarkers is the JSON encoded content of a fully read markers user settings event.
+	// It maps a channel or thread id to the read marker of the channel or thread.
+	fullyReadMarkers struct {
+		Markers map[string]struct {
+			ChannelID      string `json:"channelId"`
+			ThreadParentID string `json:"threadParentId"`
+			IsUnread       bool   `json:"isUnread"`
+		} `json:"markers"`
 	}
 )
 
@@ -49,6 +67,14 @@ func (n *notificationsTrackedStreamView) onNewEvent(ctx context.Context, view *S
 					n.userPreferences.UnblockUser(userID, blockedUser)
 				}
 			}
+
+			// reset unread counters for channels the user has read
+			if markers := settings.GetFullyReadMarkers(); markers != nil {
+				userID := common.BytesToAddress(event.Event.CreatorAddress)
+				if err := n.userPreferences.ResetUnreadCounts(ctx, userID, readChannels(markers)); err != nil {
+					return err
+				}
+			}
 		}
 
 		return nil
@@ -111,3 +137,23 @@ func NewTrackedStreamForNotifications(
 
 	return trackedView, nil
 }
+
+// readChannels returns the channels that are marked as read in the given fully read markers.
+// Markers for threads are ignored, unread counters are kept per channel.
+func readChannels(markers *UserSettingsPayload_FullyReadMarkers) []shared.StreamId {
+	var content fullyReadMarkers
+	if err := json.Unmarshal([]byte(markers.GetContent().GetData()), &content); err != nil {
+		return nil
+	}
+
+	var channels []shared.StreamId
+	for _, marker := range content.Markers {
+		if marker.IsUnread || marker.ThreadParentID != "" {
+			continue
+		}
+		if channelID, err := shared.StreamIdFromString(marker.ChannelID); err == nil {
+			channels = append(channels, channelID)
+		}
+	}
+	return channels
+}
@@ -0,0 +1,37 @@
+package sync
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestReadChannels(t *testing.T) {
+	req := require.New(t)
+
+	var (
+		read     = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		unread   = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		threadID = "a1b2c3"
+	)
+
+	data := fmt.Sprintf(`{"markers": {
+		"%[1]s": {"channelId": "%[1]s", "eventNum": "12", "isUnread": false, "markedReadAtTs": "1700000000000"},
+		"%[2]s": {"channelId": "%[2]s", "eventNum": "3", "isUnread": true},
+		"%[3]s": {"channelId": "%[2]s", "threadParentId": "%[3]s", "isUnread": false}
+	}}`, read, unread, threadID)
+
+	channels := readChannels(&UserSettingsPayload_FullyReadMarkers{
+		Content: &UserSettingsPayload_MarkerContent{Data: data},
+	})
+	req.Equal([]shared.StreamId{read}, channels)
+
+	req.Empty(readChannels(&UserSettingsPayload_FullyReadMarkers{
+		Content: &UserSettingsPayload_MarkerContent{Data: "not json"},
+	}))
+}
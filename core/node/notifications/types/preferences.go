@@ -11,7 +11,7 @@ import (
 	"github.com/towns-protocol/towns/core/node/shared"
 )
 
-// UserPreferences are all user cache and web/APN subscriptions a user has configured through the API.
+// UserPreferences are all user cache and web/APN/FCM subscriptions a user has configured through the API.
 type (
 	SpacesMap        map[shared.StreamId]*SpacePreferences
 	DMChannelsMap    map[shared.StreamId]DmChannelSettingValue
@@ -37,6 +37,12 @@ type (
 		GDMChannels GDMChannelsMap
 		// Subscriptions keeps track of how a user wants to be notified
 		Subscriptions Subscriptions
+		// QuietHours holds the recurring time windows in which notifications are suppressed, nil if not set.
+		QuietHours *QuietHoursSettings
+		// Snooze suppresses notifications for all streams, nil if not set.
+		Snooze *SnoozeSettings
+		// SpaceSnoozes is a map from a space id to the snooze that suppresses notifications in that space.
+		SpaceSnoozes SnoozesMap
 	}
 
 	WebPushSubscription struct {
@@ -51,9 +57,17 @@ type (
 		PushVersion NotificationPushVersion
 	}
 
+	FCMPushSubscription struct {
+		// Token is the FCM registration token of the app instance.
+		Token       string
+		LastSeen    time.Time
+		PushVersion NotificationPushVersion
+	}
+
 	Subscriptions struct {
 		WebPush []*WebPushSubscription
 		APNPush []*APNPushSubscription
+		FCMPush []*FCMPushSubscription
 	}
 
 	SpacePreferences struct {
@@ -71,12 +85,15 @@ func (up *UserPreferences) Clone() *UserPreferences {
 	}
 
 	cpy := UserPreferences{
-		UserID:      up.UserID,
-		DM:          up.DM,
-		GDM:         up.GDM,
-		Spaces:      make(SpacesMap),
-		DMChannels:  make(DMChannelsMap),
-		GDMChannels: make(GDMChannelsMap),
+		UserID:       up.UserID,
+		DM:           up.DM,
+		GDM:          up.GDM,
+		Spaces:       make(SpacesMap),
+		DMChannels:   make(DMChannelsMap),
+		GDMChannels:  make(GDMChannelsMap),
+		QuietHours:   up.QuietHours,
+		Snooze:       up.Snooze,
+		SpaceSnoozes: make(SnoozesMap),
 	}
 
 	for spaceID, space := range up.Spaces {
@@ -100,8 +117,13 @@ func (up *UserPreferences) Clone() *UserPreferences {
 		cpy.GDMChannels[channelID] = channel
 	}
 
+	for spaceID, snooze := range up.SpaceSnoozes {
+		cpy.SpaceSnoozes[spaceID] = snooze
+	}
+
 	cpy.Subscriptions.WebPush = append(cpy.Subscriptions.WebPush, up.Subscriptions.WebPush...)
 	cpy.Subscriptions.APNPush = append(cpy.Subscriptions.APNPush, up.Subscriptions.APNPush...)
+	cpy.Subscriptions.FCMPush = append(cpy.Subscriptions.FCMPush, up.Subscriptions.FCMPush...)
 
 	return &cpy
 }
@@ -109,7 +131,8 @@ func (up *UserPreferences) Clone() *UserPreferences {
 // HasSubscriptions returns an indication if the user has specified to receive notifications on at least 1 type.
 func (up *UserPreferences) HasSubscriptions() bool {
 	return len(up.Subscriptions.WebPush) > 0 ||
-		len(up.Subscriptions.APNPush) > 0
+		len(up.Subscriptions.APNPush) > 0 ||
+		len(up.Subscriptions.FCMPush) > 0
 }
 
 // DecodeUserPreferenceFromMsg decodes the given msg into a UserPreference instance.
@@ -270,13 +293,8 @@ func (up *UserPreferences) WantsNotificationForGDMMessage(
 // WantNotificationForSpaceChannelMessage returns an indication of the user wants to receive a
 // notification for a received space channel message.
 // Note: channel must be a space channel.
-func (up *UserPreferences) WantNotificationForSpaceChannelMessage(
-	space shared.StreamId,
-	channel shared.StreamId,
-	mentioned bool,
-	participating bool,
-	msgInteractionType MessageInteractionType,
-) bool {
+// SpaceChannelSetting returns the setting that applies to the given channel in the given space.
+func (up *UserPreferences) SpaceChannelSetting(space shared.StreamId, channel shared.StreamId) SpaceChannelSettingValue {
 	// by default only send notifications for mentions, replies or reactions for messages in space channels.
 	setting := SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_ONLY_MENTIONS_REPLIES_REACTIONS
 
@@ -292,9 +310,22 @@ func (up *UserPreferences) WantNotificationForSpaceChannelMessage(
 		}
 	}
 
+	return setting
+}
+
+func (up *UserPreferences) WantNotificationForSpaceChannelMessage(
+	space shared.StreamId,
+	channel shared.StreamId,
+	mentioned bool,
+	participating bool,
+	msgInteractionType MessageInteractionType,
+) bool {
+	setting := up.SpaceChannelSetting(space, channel)
+
 	// determine if for the type of message the user wants to receive a notification
 	switch setting {
-	case SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_MESSAGES_ALL:
+	case SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_MESSAGES_ALL,
+		SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_DIGEST:
 		switch msgInteractionType {
 		case MessageInteractionType_MESSAGE_INTERACTION_TYPE_TIP:
 			return participating
@@ -326,6 +357,42 @@ func (up *UserPreferences) WantNotificationForSpaceChannelMessage(
 	return false // by default spaces and their channels are not muted
 }
 
+// IsChannelMuted returns true if the user muted the channel. Clients don't render any feedback for
+// messages in muted channels. spaceID must be set for space channels.
+func (up *UserPreferences) IsChannelMuted(spaceID *shared.StreamId, channel shared.StreamId) bool {
+	switch channel.Type() {
+	case shared.STREAM_DM_CHANNEL_BIN:
+		setting := up.DM
+		if dmChannelSetting, found := up.DMChannels[channel]; found {
+			setting = dmChannelSetting
+		}
+		return setting == DmChannelSettingValue_DM_MESSAGES_NO_AND_MUTE
+	case shared.STREAM_GDM_CHANNEL_BIN:
+		setting := up.GDM
+		if gdmChannelSetting, found := up.GDMChannels[channel]; found {
+			setting = gdmChannelSetting
+		}
+		return setting == GdmChannelSettingValue_GDM_MESSAGES_NO_AND_MUTE
+	case shared.STREAM_CHANNEL_BIN:
+		return spaceID != nil &&
+			up.SpaceChannelSetting(*spaceID, channel) == SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_NO_MESSAGES_AND_MUTE
+	}
+	return false
+}
+
+// WantsDigestForSpaceChannelMessage returns true if the notification for a message in the given space channel
+// must be collapsed into a digest instead of being sent immediately. Mentions and replies/reactions to the users
+// own messages are never collapsed.
+func (up *UserPreferences) WantsDigestForSpaceChannelMessage(
+	space shared.StreamId,
+	channel shared.StreamId,
+	mentioned bool,
+	participating bool,
+) bool {
+	return !mentioned && !participating &&
+		up.SpaceChannelSetting(space, channel) == SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_DIGEST
+}
+
 func (dms DMChannelsMap) Protobuf() []*DmChannelSetting {
 	results := make([]*DmChannelSetting, 0, len(dms))
 	for streamID, dm := range dms {
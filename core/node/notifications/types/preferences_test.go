@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestSpaceChannelDigest(t *testing.T) {
+	req := require.New(t)
+
+	var (
+		spaceID  = testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		channel1 = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		channel2 = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		prefs    = &types.UserPreferences{
+			Spaces: types.SpacesMap{
+				spaceID: &types.SpacePreferences{
+					Setting: SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_DIGEST,
+					Channels: types.SpaceChannelsMap{
+						channel2: SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_NO_MESSAGES,
+					},
+				},
+			},
+		}
+		post = MessageInteractionType_MESSAGE_INTERACTION_TYPE_POST
+	)
+
+	req.True(prefs.WantNotificationForSpaceChannelMessage(spaceID, channel1, false, false, post))
+	req.True(prefs.WantsDigestForSpaceChannelMessage(spaceID, channel1, false, false))
+	req.False(prefs.WantsDigestForSpaceChannelMessage(spaceID, channel1, true, false), "mentions are sent immediately")
+	req.False(prefs.WantsDigestForSpaceChannelMessage(spaceID, channel1, false, true), "replies are sent immediately")
+
+	// channel setting overwrites the space digest setting
+	req.False(prefs.WantNotificationForSpaceChannelMessage(spaceID, channel2, false, false, post))
+	req.False(prefs.WantsDigestForSpaceChannelMessage(spaceID, channel2, false, false))
+}
+
+func TestIsChannelMuted(t *testing.T) {
+	req := require.New(t)
+
+	var (
+		spaceID = testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		channel = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		dm      = testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)
+		gdm     = testutils.FakeStreamId(shared.STREAM_GDM_CHANNEL_BIN)
+		prefs   = &types.UserPreferences{
+			DM:  DmChannelSettingValue_DM_MESSAGES_YES,
+			GDM: GdmChannelSettingValue_GDM_MESSAGES_NO_AND_MUTE,
+			DMChannels: types.DMChannelsMap{
+				dm: DmChannelSettingValue_DM_MESSAGES_NO_AND_MUTE,
+			},
+			Spaces: types.SpacesMap{
+				spaceID: &types.SpacePreferences{
+					Setting:  SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_NO_MESSAGES_AND_MUTE,
+					Channels: types.SpaceChannelsMap{},
+				},
+			},
+		}
+	)
+
+	req.True(prefs.IsChannelMuted(nil, dm))
+	req.False(prefs.IsChannelMuted(nil, testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)))
+	req.True(prefs.IsChannelMuted(nil, gdm))
+	req.True(prefs.IsChannelMuted(&spaceID, channel))
+
+	prefs.Spaces[spaceID].Channels[channel] = SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_MESSAGES_ALL
+	req.False(prefs.IsChannelMuted(&spaceID, channel))
+}
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"slices"
+	"time"
+	// embed the time zone database, the notification service can run on hosts without it
+	_ "time/tzdata"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+const minutesPerDay = 24 * 60
+
+type (
+	// QuietHoursSettings are recurring time windows in which the user doesn't receive notifications.
+	QuietHoursSettings struct {
+		// Location is the time zone of the user, the windows are in local time.
+		Location *time.Location
+		Windows  []QuietHoursInterval
+		// AllowMentions if set notifications for messages the user is mentioned in are sent during quiet hours.
+		AllowMentions bool
+	}
+
+	// QuietHoursInterval is a recurring time window in local time.
+	QuietHoursInterval struct {
+		// Days the window starts on, if empty the window starts every day.
+		Days []time.Weekday
+		// Start of the window in minutes after midnight.
+		Start int
+		// End of the window in minutes after midnight, if End < Start the window ends on the next day.
+		End int
+	}
+
+	// SnoozeSettings suppresses notifications until a given time.
+	SnoozeSettings struct {
+		Until time.Time
+		// AllowMentions if set notifications for messages the user is mentioned in are sent while snoozed.
+		AllowMentions bool
+	}
+
+	// SnoozesMap is a map from a space id to the snooze for that space.
+	SnoozesMap map[shared.StreamId]*SnoozeSettings
+)
+
+// DecodeQuietHoursFromMsg decodes and validates the given msg into a QuietHoursSettings instance.
+// It returns nil if msg has no windows.
+func DecodeQuietHoursFromMsg(msg *QuietHours) (*QuietHoursSettings, error) {
+	if len(msg.GetWindows()) == 0 {
+		return nil, nil
+	}
+
+	loc, err := time.LoadLocation(msg.GetTimezone())
+	if err != nil {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid time zone", "timezone", msg.GetTimezone())
+	}
+
+	quietHours := &QuietHoursSettings{
+		Location:      loc,
+		AllowMentions: msg.GetAllowMentions(),
+	}
+
+	for _, w := range msg.GetWindows() {
+		if w.GetStartMinute() >= minutesPerDay || w.GetEndMinute() >= minutesPerDay {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Quiet hours window out of range",
+				"start", w.GetStartMinute(), "end", w.GetEndMinute())
+		}
+		if w.GetStartMinute() == w.GetEndMinute() {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Empty quiet hours window", "start", w.GetStartMinute())
+		}
+
+		window := QuietHoursInterval{Start: int(w.GetStartMinute()), End: int(w.GetEndMinute())}
+		for _, day := range w.GetDays() {
+			if day > uint32(time.Saturday) {
+				return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid quiet hours day", "day", day)
+			}
+			window.Days = append(window.Days, time.Weekday(day))
+		}
+		quietHours.Windows = append(quietHours.Windows, window)
+	}
+
+	return quietHours, nil
+}
+
+// Protobuf returns the protobuf representation of the quiet hours.
+func (q *QuietHoursSettings) Protobuf() *QuietHours {
+	if q == nil {
+		return nil
+	}
+
+	msg := &QuietHours{
+		Timezone:      q.Location.String(),
+		AllowMentions: q.AllowMentions,
+	}
+	for _, w := range q.Windows {
+		window := &QuietHoursWindow{StartMinute: uint32(w.Start), EndMinute: uint32(w.End)}
+		for _, day := range w.Days {
+			window.Days = append(window.Days, uint32(day))
+		}
+		msg.Windows = append(msg.Windows, window)
+	}
+	return msg
+}
+
+// Active returns true if now is within one of the quiet hours windows.
+func (q *QuietHoursSettings) Active(now time.Time) bool {
+	if q == nil {
+		return false
+	}
+
+	local := now.In(q.Location)
+	minute := local.Hour()*60 + local.Minute()
+	today := local.Weekday()
+	yesterday := (today + 6) % 7
+
+	for _, w := range q.Windows {
+		if w.Start < w.End {
+			if w.startsOn(today) && minute >= w.Start && minute < w.End {
+				return true
+			}
+			continue
+		}
+		// window wraps around midnight
+		if w.startsOn(today) && minute >= w.Start {
+			return true
+		}
+		if w.startsOn(yesterday) && minute < w.End {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w QuietHoursInterval) startsOn(day time.Weekday) bool {
+	return len(w.Days) == 0 || slices.Contains(w.Days, day)
+}
+
+// Active returns true if the snooze hasn't expired.
+func (s *SnoozeSettings) Active(now time.Time) bool {
+	return s != nil && now.Before(s.Until)
+}
+
+// Protobuf returns the protobuf representation of the snoozes, the global snooze has no space id.
+func (snoozes SnoozesMap) Protobuf(global *SnoozeSettings) []*Snooze {
+	var result []*Snooze
+	if global != nil {
+		result = append(result, &Snooze{
+			UntilEpochMs:  global.Until.UnixMilli(),
+			AllowMentions: global.AllowMentions,
+		})
+	}
+	for spaceID, snooze := range snoozes {
+		result = append(result, &Snooze{
+			SpaceId:       spaceID[:],
+			UntilEpochMs:  snooze.Until.UnixMilli(),
+			AllowMentions: snooze.AllowMentions,
+		})
+	}
+	return result
+}
+
+// IsMuted returns true if notifications for the user are suppressed at the given time by quiet hours or
+// a snooze. Space snoozes only apply to messages in the given space, spaceID is nil for DM and GDM messages.
+// Quiet hours and snoozes that allow mentions don't suppress notifications for messages the user is mentioned in.
+func (up *UserPreferences) IsMuted(now time.Time, spaceID *shared.StreamId, mentioned bool) bool {
+	suppresses := func(active bool, allowMentions bool) bool {
+		return active && !(mentioned && allowMentions)
+	}
+
+	if up.Snooze != nil && suppresses(up.Snooze.Active(now), up.Snooze.AllowMentions) {
+		return true
+	}
+
+	if spaceID != nil {
+		if snooze, ok := up.SpaceSnoozes[*spaceID]; ok && suppresses(snooze.Active(now), snooze.AllowMentions) {
+			return true
+		}
+	}
+
+	return up.QuietHours != nil && suppresses(up.QuietHours.Active(now), up.QuietHours.AllowMentions)
+}
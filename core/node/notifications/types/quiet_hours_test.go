@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestDecodeQuietHours(t *testing.T) {
+	req := require.New(t)
+
+	quietHours, err := types.DecodeQuietHoursFromMsg(&QuietHours{Timezone: "Europe/Amsterdam"})
+	req.NoError(err)
+	req.Nil(quietHours, "quiet hours without windows are disabled")
+
+	msg := &QuietHours{
+		Timezone:      "Europe/Amsterdam",
+		AllowMentions: true,
+		Windows: []*QuietHoursWindow{
+			{StartMinute: 22 * 60, EndMinute: 7 * 60},
+			{Days: []uint32{uint32(time.Saturday), uint32(time.Sunday)}, StartMinute: 9 * 60, EndMinute: 12 * 60},
+		},
+	}
+	quietHours, err = types.DecodeQuietHoursFromMsg(msg)
+	req.NoError(err)
+	req.Equal("Europe/Amsterdam", quietHours.Location.String())
+	req.True(quietHours.AllowMentions)
+	req.Len(quietHours.Windows, 2)
+	req.Equal([]time.Weekday{time.Saturday, time.Sunday}, quietHours.Windows[1].Days)
+	req.EqualValues(msg.String(), quietHours.Protobuf().String())
+
+	invalid := []*QuietHours{
+		{Timezone: "Mars/Olympus_Mons", Windows: []*QuietHoursWindow{{StartMinute: 0, EndMinute: 60}}},
+		{Timezone: "UTC", Windows: []*QuietHoursWindow{{StartMinute: 0, EndMinute: 24 * 60}}},
+		{Timezone: "UTC", Windows: []*QuietHoursWindow{{StartMinute: 60, EndMinute: 60}}},
+		{Timezone: "UTC", Windows: []*QuietHoursWindow{{Days: []uint32{7}, StartMinute: 0, EndMinute: 60}}},
+	}
+	for _, msg := range invalid {
+		_, err := types.DecodeQuietHoursFromMsg(msg)
+		req.Error(err, msg.String())
+	}
+}
+
+func TestQuietHoursActive(t *testing.T) {
+	req := require.New(t)
+
+	loc, err := time.LoadLocation("America/New_York")
+	req.NoError(err)
+
+	quietHours := &types.QuietHoursSettings{
+		Location: loc,
+		Windows: []types.QuietHoursInterval{
+			// Friday 22:00 till Saturday 07:00
+			{Days: []time.Weekday{time.Friday}, Start: 22 * 60, End: 7 * 60},
+			// every day 12:00 till 13:00
+			{Start: 12 * 60, End: 13 * 60},
+		},
+	}
+
+	// 2024-03-01 is a Friday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, time.March, day, hour, minute, 0, 0, loc)
+	}
+
+	req.False(quietHours.Active(at(1, 21, 59)))
+	req.True(quietHours.Active(at(1, 22, 0)))
+	req.True(quietHours.Active(at(2, 6, 59)))
+	req.False(quietHours.Active(at(2, 7, 0)))
+	req.False(quietHours.Active(at(2, 22, 30)), "wrapping window only starts on Friday")
+	req.False(quietHours.Active(at(1, 6, 0)), "wrapping window didn't start on Thursday")
+	req.True(quietHours.Active(at(3, 12, 30)))
+	req.False(quietHours.Active(at(3, 13, 0)))
+
+	// the windows are evaluated in the users time zone
+	req.True(quietHours.Active(at(1, 22, 30).UTC()))
+
+	var disabled *types.QuietHoursSettings
+	req.False(disabled.Active(at(1, 22, 30)))
+}
+
+func TestIsMuted(t *testing.T) {
+	req := require.New(t)
+
+	var (
+		now    = time.Now()
+		space1 = testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		space2 = testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		prefs  = &types.UserPreferences{}
+	)
+
+	req.False(prefs.IsMuted(now, nil, false))
+	req.False(prefs.IsMuted(now, &space1, false))
+
+	prefs.SpaceSnoozes = types.SnoozesMap{
+		space1: {Until: now.Add(time.Hour), AllowMentions: true},
+		space2: {Until: now.Add(-time.Hour)},
+	}
+	req.True(prefs.IsMuted(now, &space1, false))
+	req.False(prefs.IsMuted(now, &space1, true), "mentions break through the space snooze")
+	req.False(prefs.IsMuted(now, &space2, false), "space snooze expired")
+	req.False(prefs.IsMuted(now, nil, false), "space snoozes don't apply to DM/GDM messages")
+
+	prefs.Snooze = &types.SnoozeSettings{Until: now.Add(time.Hour)}
+	req.True(prefs.IsMuted(now, nil, true))
+	req.True(prefs.IsMuted(now, &space2, true))
+	req.False(prefs.IsMuted(now.Add(2*time.Hour), nil, false), "global snooze expired")
+
+	prefs.Snooze = nil
+	prefs.SpaceSnoozes = nil
+	prefs.QuietHours = &types.QuietHoursSettings{
+		Location:      time.UTC,
+		Windows:       []types.QuietHoursInterval{{Start: 0, End: 60}},
+		AllowMentions: true,
+	}
+	midnight := time.Date(2024, time.March, 1, 0, 30, 0, 0, time.UTC)
+	req.True(prefs.IsMuted(midnight, nil, false))
+	req.False(prefs.IsMuted(midnight, nil, true), "mentions break through quiet hours")
+	req.False(prefs.IsMuted(midnight.Add(time.Hour), nil, false))
+}
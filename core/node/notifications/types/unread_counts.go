@@ -0,0 +1,29 @@
+package types
+
+import (
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+// UnreadCount holds the number of messages in a channel the user hasn't read yet.
+type UnreadCount struct {
+	ChannelID shared.StreamId
+	Unread    int
+	// Mentions is the number of unread messages the user is mentioned in.
+	Mentions int
+}
+
+// UnreadCountsToProtobuf returns the protobuf representation of the given unread counts.
+func UnreadCountsToProtobuf(counts []*UnreadCount) *GetUnreadCountsResponse {
+	resp := &GetUnreadCountsResponse{}
+	for _, count := range counts {
+		resp.Channels = append(resp.Channels, &ChannelUnreadCount{
+			ChannelId: count.ChannelID[:],
+			Unread:    uint32(count.Unread),
+			Mentions:  uint32(count.Mentions),
+		})
+		resp.TotalUnread += uint32(count.Unread)
+		resp.TotalMentions += uint32(count.Mentions)
+	}
+	return resp
+}
@@ -231,6 +231,64 @@ func (up *UserPreferencesCache) SetChannelSetting(
 	return nil
 }
 
+func (up *UserPreferencesCache) SetQuietHours(
+	ctx context.Context,
+	userID common.Address,
+	quietHours *types.QuietHoursSettings,
+) error {
+	if err := up.persistent.SetQuietHours(ctx, userID, quietHours); err != nil {
+		return err
+	}
+
+	// force a reload next time user preferences are requested
+	up.userPreferencesCache.Delete(userID)
+
+	return nil
+}
+
+func (up *UserPreferencesCache) SetSnooze(
+	ctx context.Context,
+	userID common.Address,
+	spaceID *shared.StreamId,
+	snooze *types.SnoozeSettings,
+) error {
+	if err := up.persistent.SetSnooze(ctx, userID, spaceID, snooze); err != nil {
+		return err
+	}
+
+	// force a reload next time user preferences are requested
+	up.userPreferencesCache.Delete(userID)
+
+	return nil
+}
+
+// IncrementUnreadCounts increments the unread counters of the users for the given channel.
+// Unread counts change with each message and are not cached.
+func (up *UserPreferencesCache) IncrementUnreadCounts(
+	ctx context.Context,
+	lease *storage.ShardLease,
+	channelID shared.StreamId,
+	eventHash common.Hash,
+	users map[common.Address]bool,
+) (map[common.Address]int, error) {
+	return up.persistent.IncrementUnreadCounts(ctx, lease, channelID, eventHash, users)
+}
+
+func (up *UserPreferencesCache) ResetUnreadCounts(
+	ctx context.Context,
+	userID common.Address,
+	channelIDs []shared.StreamId,
+) error {
+	return up.persistent.ResetUnreadCounts(ctx, userID, channelIDs)
+}
+
+func (up *UserPreferencesCache) GetUnreadCounts(
+	ctx context.Context,
+	userID common.Address,
+) ([]*types.UnreadCount, error) {
+	return up.persistent.GetUnreadCounts(ctx, userID)
+}
+
 func (up *UserPreferencesCache) BlockUser(userID common.Address, user common.Address) {
 	ms := &blockedUserList{
 		mu:    sync.RWMutex{},
@@ -409,3 +467,65 @@ func (up *UserPreferencesCache) RemoveAPNSubscription(ctx context.Context,
 
 	return err
 }
+
+func (up *UserPreferencesCache) GetFCMSubscriptions(
+	ctx context.Context,
+	userID common.Address,
+) ([]*types.FCMPushSubscription, error) {
+	pref, err := up.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pref.Subscriptions.FCMPush, nil
+}
+
+func (up *UserPreferencesCache) AddFCMSubscription(
+	ctx context.Context,
+	userID common.Address,
+	token string,
+	pushVersion NotificationPushVersion,
+) error {
+	pref, err := up.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// if it already exists and last seen was recently no need to update the database.
+	// this method is expected to be called often by the client.
+	for _, fcmPush := range pref.Subscriptions.FCMPush {
+		if fcmPush.Token == token && fcmPush.PushVersion == pushVersion &&
+			time.Since(fcmPush.LastSeen) < SubscriptionTimeout {
+			return nil
+		}
+	}
+
+	err = up.persistent.AddFCMSubscription(ctx, userID, token, pushVersion)
+	if err != nil {
+		return err
+	}
+
+	// force reload next time user userPreferencesCache are requested
+	up.userPreferencesCache.Delete(userID)
+
+	return err
+}
+
+func (up *UserPreferencesCache) RemoveFCMSubscription(ctx context.Context,
+	token string,
+	userID common.Address,
+) error {
+	err := up.persistent.RemoveFCMSubscription(ctx, token, userID)
+	if err != nil {
+		return err
+	}
+
+	// force reload next time user userPreferencesCache are requested
+	up.userPreferencesCache.Delete(userID)
+
+	return err
+}
+
+func (up *UserPreferencesCache) PruneUnreadCountEvents(ctx context.Context) (int64, error) {
+	return up.persistent.PruneUnreadCountEvents(ctx)
+}
@@ -5,17 +5,23 @@ import (
 	"crypto/rand"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/SherClockHolmes/webpush-go"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/base/test"
 	"github.com/towns-protocol/towns/core/node/crypto"
 	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/notifications"
 	"github.com/towns-protocol/towns/core/node/notifications/types"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/shared"
 	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
 	"github.com/towns-protocol/towns/core/node/testutils/dbtestutils"
 	"github.com/stretchr/testify/require"
 )
@@ -80,6 +86,21 @@ func TestUserPreferencesStore(t *testing.T) {
 	t.Run("webPushExpired", func(t *testing.T) {
 		webPushExpired(req, ctx, store)
 	})
+	t.Run("subscribeFCM", func(t *testing.T) {
+		subscribeFCM(req, ctx, store)
+	})
+	t.Run("quietHoursAndSnoozes", func(t *testing.T) {
+		quietHoursAndSnoozes(req, ctx, store)
+	})
+	t.Run("unreadCounts", func(t *testing.T) {
+		unreadCounts(req, ctx, store)
+	})
+	t.Run("shardLeases", func(t *testing.T) {
+		shardLeases(req, ctx, store)
+	})
+	t.Run("shardLeaseHandover", func(t *testing.T) {
+		shardLeaseHandover(req, ctx, store)
+	})
 }
 
 func userPreferencesNotExists(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
@@ -96,6 +117,7 @@ func userPreferencesNotExists(req *require.Assertions, ctx context.Context, stor
 	req.Empty(preferences.GDMChannels)
 	req.Empty(preferences.Subscriptions.WebPush)
 	req.Empty(preferences.Subscriptions.APNPush)
+	req.Empty(preferences.Subscriptions.FCMPush)
 }
 
 func setAndRetrieveUserPreferences(
@@ -310,3 +332,269 @@ func webPushExpired(req *require.Assertions, ctx context.Context, store *storage
 	req.Equal(1, len(got))
 	req.Equal(exp2.Endpoint, got[0].Sub.Endpoint)
 }
+
+func subscribeFCM(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
+	wallet, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+	wallet2, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+
+	var (
+		token1 = fmt.Sprintf("fcm-token-1-%s", wallet.Address)
+		token2 = fmt.Sprintf("fcm-token-2-%s", wallet.Address)
+	)
+
+	req.NoError(store.AddFCMSubscription(ctx, wallet.Address, token1, NotificationPushVersion_NOTIFICATION_PUSH_VERSION_1))
+	req.NoError(store.AddFCMSubscription(ctx, wallet.Address, token2, NotificationPushVersion_NOTIFICATION_PUSH_VERSION_2))
+
+	subs, err := store.GetFCMSubscriptions(ctx, wallet.Address)
+	req.NoError(err)
+	req.Equal(2, len(subs))
+
+	for _, sub := range subs {
+		if sub.Token == token1 {
+			req.Equal(NotificationPushVersion_NOTIFICATION_PUSH_VERSION_1, sub.PushVersion)
+		} else {
+			req.Equal(token2, sub.Token)
+			req.Equal(NotificationPushVersion_NOTIFICATION_PUSH_VERSION_2, sub.PushVersion)
+		}
+	}
+
+	// token is moved to another user when the other user subscribes on the same device
+	req.NoError(store.AddFCMSubscription(ctx, wallet2.Address, token2, NotificationPushVersion_NOTIFICATION_PUSH_VERSION_2))
+
+	preferences, err := store.GetUserPreferences(ctx, wallet.Address)
+	req.NoError(err)
+	req.Equal(1, len(preferences.Subscriptions.FCMPush))
+	req.Equal(token1, preferences.Subscriptions.FCMPush[0].Token)
+
+	req.NoError(store.RemoveFCMSubscription(ctx, token1, wallet.Address))
+	subs, err = store.GetFCMSubscriptions(ctx, wallet.Address)
+	req.NoError(err)
+	req.Empty(subs)
+
+	subs, err = store.GetFCMSubscriptions(ctx, wallet2.Address)
+	req.NoError(err)
+	req.Equal(1, len(subs))
+}
+
+func quietHoursAndSnoozes(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
+	wallet, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	req.NoError(err)
+
+	quietHours := &types.QuietHoursSettings{
+		Location: loc,
+		Windows: []types.QuietHoursInterval{
+			{Start: 22 * 60, End: 7 * 60},
+			{Days: []time.Weekday{time.Sunday}, Start: 9 * 60, End: 18 * 60},
+		},
+		AllowMentions: true,
+	}
+	req.NoError(store.SetQuietHours(ctx, wallet.Address, quietHours))
+
+	var (
+		spaceID = testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		until   = time.UnixMilli(time.Now().Add(time.Hour).UnixMilli())
+	)
+	req.NoError(store.SetSnooze(ctx, wallet.Address, nil, &types.SnoozeSettings{Until: until}))
+	req.NoError(store.SetSnooze(ctx, wallet.Address, &spaceID, &types.SnoozeSettings{Until: until, AllowMentions: true}))
+
+	preferences, err := store.GetUserPreferences(ctx, wallet.Address)
+	req.NoError(err)
+	req.Equal(quietHours.Protobuf().String(), preferences.QuietHours.Protobuf().String())
+	req.True(until.Equal(preferences.Snooze.Until))
+	req.False(preferences.Snooze.AllowMentions)
+	req.Len(preferences.SpaceSnoozes, 1)
+	req.True(preferences.SpaceSnoozes[spaceID].AllowMentions)
+
+	// expired snoozes are not loaded
+	req.NoError(store.SetSnooze(ctx, wallet.Address, nil, &types.SnoozeSettings{Until: time.Now().Add(-time.Minute)}))
+	req.NoError(store.SetSnooze(ctx, wallet.Address, &spaceID, nil))
+	req.NoError(store.SetQuietHours(ctx, wallet.Address, nil))
+
+	preferences, err = store.GetUserPreferences(ctx, wallet.Address)
+	req.NoError(err)
+	req.Nil(preferences.QuietHours)
+	req.Nil(preferences.Snooze)
+	req.Empty(preferences.SpaceSnoozes)
+}
+
+func unreadCounts(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
+	wallet1, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+	wallet2, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+
+	var (
+		user1    = wallet1.Address
+		user2    = wallet2.Address
+		channel1 = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		channel2 = testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)
+	)
+
+	totals, err := store.IncrementUnreadCounts(
+		ctx, nil, channel1, common.Hash{1}, map[common.Address]bool{user1: false, user2: true})
+	req.NoError(err)
+	req.Equal(map[common.Address]int{user1: 1, user2: 1}, totals)
+
+	// replayed events are not counted again
+	totals, err = store.IncrementUnreadCounts(
+		ctx, nil, channel1, common.Hash{1}, map[common.Address]bool{user1: false, user2: true})
+	req.NoError(err)
+	req.Equal(map[common.Address]int{user1: 1, user2: 1}, totals)
+
+	totals, err = store.IncrementUnreadCounts(ctx, nil, channel1, common.Hash{2}, map[common.Address]bool{user1: true})
+	req.NoError(err)
+	req.Equal(map[common.Address]int{user1: 2}, totals)
+
+	totals, err = store.IncrementUnreadCounts(ctx, nil, channel2, common.Hash{3}, map[common.Address]bool{user1: false})
+	req.NoError(err)
+	req.Equal(map[common.Address]int{user1: 3}, totals)
+
+	counts, err := store.GetUnreadCounts(ctx, user1)
+	req.NoError(err)
+	req.Len(counts, 2)
+	for _, count := range counts {
+		if count.ChannelID == channel1 {
+			req.Equal(2, count.Unread)
+			req.Equal(1, count.Mentions)
+		} else {
+			req.Equal(channel2, count.ChannelID)
+			req.Equal(1, count.Unread)
+			req.Equal(0, count.Mentions)
+		}
+	}
+
+	req.NoError(store.ResetUnreadCounts(ctx, user1, []shared.StreamId{channel1}))
+	counts, err = store.GetUnreadCounts(ctx, user1)
+	req.NoError(err)
+	req.Len(counts, 1)
+	req.Equal(channel2, counts[0].ChannelID)
+
+	// events that were replayed after the channel was read are not counted again
+	totals, err = store.IncrementUnreadCounts(ctx, nil, channel1, common.Hash{2}, map[common.Address]bool{user1: true})
+	req.NoError(err)
+	req.Equal(map[common.Address]int{user1: 1}, totals)
+
+	// counts of other users are not affected
+	counts, err = store.GetUnreadCounts(ctx, user2)
+	req.NoError(err)
+	req.Len(counts, 1)
+	req.Equal(1, counts[0].Mentions)
+
+	// recently counted events are kept when pruning
+	_, err = store.PruneUnreadCountEvents(ctx)
+	req.NoError(err)
+	totals, err = store.IncrementUnreadCounts(
+		ctx, nil, channel1, common.Hash{1}, map[common.Address]bool{user1: false, user2: true})
+	req.NoError(err)
+	req.Equal(map[common.Address]int{user1: 1, user2: 1}, totals)
+}
+
+func shardLeases(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
+	const ttl = 2 * time.Second
+
+	instances, err := store.HeartbeatNotificationInstance(ctx, "instance1", ttl)
+	req.NoError(err)
+	req.Equal([]string{"instance1"}, instances)
+
+	instances, err = store.HeartbeatNotificationInstance(ctx, "instance2", ttl)
+	req.NoError(err)
+	req.Equal([]string{"instance1", "instance2"}, instances)
+
+	acquired, err := store.AcquireShardLeases(ctx, "instance1", []int{0, 1}, ttl)
+	req.NoError(err)
+	req.ElementsMatch([]int{0, 1}, acquired)
+
+	// shards held by another instance are not acquired until the lease expired
+	acquired, err = store.AcquireShardLeases(ctx, "instance2", []int{1, 2}, ttl)
+	req.NoError(err)
+	req.Equal([]int{2}, acquired)
+
+	// renew
+	acquired, err = store.AcquireShardLeases(ctx, "instance1", []int{0, 1}, ttl)
+	req.NoError(err)
+	req.ElementsMatch([]int{0, 1}, acquired)
+
+	// notifications are only added to the outbox when the shard lease is held
+	entry := &storage.NotificationOutboxEntry{Provider: "apn", Target: []byte{1}, Payload: []byte{1}}
+	req.NoError(store.EnqueueNotifications(ctx, &storage.ShardLease{Shard: 1, Owner: "instance1"},
+		[]*storage.NotificationOutboxEntry{entry}))
+	err = store.EnqueueNotifications(ctx, &storage.ShardLease{Shard: 1, Owner: "instance2"},
+		[]*storage.NotificationOutboxEntry{entry})
+	req.True(base.IsRiverErrorCode(err, Err_UNAVAILABLE), "unexpected error: %v", err)
+
+	// released shards can be acquired immediately
+	req.NoError(store.ReleaseShardLeases(ctx, "instance1", []int{0}))
+	acquired, err = store.AcquireShardLeases(ctx, "instance2", []int{0}, ttl)
+	req.NoError(err)
+	req.Equal([]int{0}, acquired)
+
+	// shards of an instance that stopped renewing are taken over after the lease expired
+	req.Eventually(func() bool {
+		acquired, err := store.AcquireShardLeases(ctx, "instance2", []int{1}, ttl)
+		return err == nil && len(acquired) == 1
+	}, 10*time.Second, 250*time.Millisecond)
+
+	err = store.EnqueueNotifications(ctx, &storage.ShardLease{Shard: 1, Owner: "instance1"},
+		[]*storage.NotificationOutboxEntry{entry})
+	req.True(base.IsRiverErrorCode(err, Err_UNAVAILABLE), "unexpected error: %v", err)
+
+	// expired instances are not alive anymore
+	req.Eventually(func() bool {
+		instances, err := store.HeartbeatNotificationInstance(ctx, "instance2", ttl)
+		return err == nil && len(instances) == 1 && instances[0] == "instance2"
+	}, 10*time.Second, 250*time.Millisecond)
+}
+
+// shardLeaseHandover checks that a notification that is enqueued again by the new holder of a shard lease,
+// because it processes the handover window, is delivered once.
+func shardLeaseHandover(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
+	const (
+		ttl      = 2 * time.Second
+		shard    = 20
+		provider = "handover"
+	)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outbox := notifications.NewOutbox(
+		store,
+		&config.NotificationOutboxConfig{PollInterval: 10 * time.Millisecond},
+		infra.NewMetricsFactory(nil, "", ""),
+		provider,
+	)
+	var delivered atomic.Int32
+	outbox.Start(ctx, deliverFunc(func(context.Context, *storage.NotificationOutboxEntry) error {
+		delivered.Add(1)
+		return nil
+	}))
+
+	entry := &storage.NotificationOutboxEntry{
+		Provider:  provider,
+		EventHash: common.Hash{20},
+		Target:    []byte("target"),
+		Payload:   []byte("payload"),
+	}
+
+	acquired, err := store.AcquireShardLeases(ctx, "handover1", []int{shard}, ttl)
+	req.NoError(err)
+	req.Equal([]int{shard}, acquired)
+	req.NoError(outbox.Enqueue(ctx, &storage.ShardLease{Shard: shard, Owner: "handover1"},
+		[]*storage.NotificationOutboxEntry{entry}))
+	req.Eventually(func() bool { return delivered.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// the new holder processes the handover window and enqueues the notification again
+	req.NoError(store.ReleaseShardLeases(ctx, "handover1", []int{shard}))
+	acquired, err = store.AcquireShardLeases(ctx, "handover2", []int{shard}, ttl)
+	req.NoError(err)
+	req.Equal([]int{shard}, acquired)
+	req.NoError(outbox.Enqueue(ctx, &storage.ShardLease{Shard: shard, Owner: "handover2"},
+		[]*storage.NotificationOutboxEntry{entry}))
+
+	req.Never(func() bool { return delivered.Load() > 1 }, 500*time.Millisecond, 10*time.Millisecond)
+}
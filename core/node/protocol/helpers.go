@@ -43,3 +43,29 @@ func (cc *CreationCookie) IsLocal(addr common.Address) bool {
 
 	return false
 }
+
+// HasScope returns true if the app declared the scope. Apps without permissions are not restricted.
+func (p *AppPermissions) HasScope(scope AppScope) bool {
+	if p == nil {
+		return true
+	}
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsStreamType returns true if the app can add events to streams of the given type.
+func (p *AppPermissions) AllowsStreamType(streamType AppStreamType) bool {
+	if p == nil || len(p.StreamTypes) == 0 {
+		return true
+	}
+	for _, t := range p.StreamTypes {
+		if t == streamType {
+			return true
+		}
+	}
+	return false
+}
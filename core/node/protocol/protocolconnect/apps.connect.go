@@ -42,6 +42,33 @@ const (
 	// AppRegistryServiceGetStatusProcedure is the fully-qualified name of the AppRegistryService's
 	// GetStatus RPC.
 	AppRegistryServiceGetStatusProcedure = "/river.AppRegistryService/GetStatus"
+	// AppRegistryServiceGetDeliveryLogProcedure is the fully-qualified name of the AppRegistryService's
+	// GetDeliveryLog RPC.
+	AppRegistryServiceGetDeliveryLogProcedure = "/river.AppRegistryService/GetDeliveryLog"
+	// AppRegistryServiceGetPermissionsProcedure is the fully-qualified name of the AppRegistryService's
+	// GetPermissions RPC.
+	AppRegistryServiceGetPermissionsProcedure = "/river.AppRegistryService/GetPermissions"
+	// AppRegistryServiceGetAppsProcedure is the fully-qualified name of the AppRegistryService's
+	// GetApps RPC.
+	AppRegistryServiceGetAppsProcedure = "/river.AppRegistryService/GetApps"
+	// AppRegistryServiceGetInfoProcedure is the fully-qualified name of the AppRegistryService's
+	// GetInfo RPC.
+	AppRegistryServiceGetInfoProcedure = "/river.AppRegistryService/GetInfo"
+	// AppRegistryServiceRotateSecretProcedure is the fully-qualified name of the AppRegistryService's
+	// RotateSecret RPC.
+	AppRegistryServiceRotateSecretProcedure = "/river.AppRegistryService/RotateSecret"
+	// AppRegistryServiceUpdateWebhookProcedure is the fully-qualified name of the AppRegistryService's
+	// UpdateWebhook RPC.
+	AppRegistryServiceUpdateWebhookProcedure = "/river.AppRegistryService/UpdateWebhook"
+	// AppRegistryServiceDisableAppProcedure is the fully-qualified name of the AppRegistryService's
+	// DisableApp RPC.
+	AppRegistryServiceDisableAppProcedure = "/river.AppRegistryService/DisableApp"
+	// AppRegistryServiceDeleteAppProcedure is the fully-qualified name of the AppRegistryService's
+	// DeleteApp RPC.
+	AppRegistryServiceDeleteAppProcedure = "/river.AppRegistryService/DeleteApp"
+	// AppRegistryServiceSetPermissionsProcedure is the fully-qualified name of the AppRegistryService's
+	// SetPermissions RPC.
+	AppRegistryServiceSetPermissionsProcedure = "/river.AppRegistryService/SetPermissions"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -50,15 +77,36 @@ var (
 	appRegistryServiceRegisterMethodDescriptor        = appRegistryServiceServiceDescriptor.Methods().ByName("Register")
 	appRegistryServiceRegisterWebhookMethodDescriptor = appRegistryServiceServiceDescriptor.Methods().ByName("RegisterWebhook")
 	appRegistryServiceGetStatusMethodDescriptor       = appRegistryServiceServiceDescriptor.Methods().ByName("GetStatus")
+	appRegistryServiceGetDeliveryLogMethodDescriptor  = appRegistryServiceServiceDescriptor.Methods().ByName("GetDeliveryLog")
+	appRegistryServiceGetPermissionsMethodDescriptor  = appRegistryServiceServiceDescriptor.Methods().ByName("GetPermissions")
+	appRegistryServiceGetAppsMethodDescriptor         = appRegistryServiceServiceDescriptor.Methods().ByName("GetApps")
+	appRegistryServiceGetInfoMethodDescriptor         = appRegistryServiceServiceDescriptor.Methods().ByName("GetInfo")
+	appRegistryServiceRotateSecretMethodDescriptor    = appRegistryServiceServiceDescriptor.Methods().ByName("RotateSecret")
+	appRegistryServiceUpdateWebhookMethodDescriptor   = appRegistryServiceServiceDescriptor.Methods().ByName("UpdateWebhook")
+	appRegistryServiceDisableAppMethodDescriptor      = appRegistryServiceServiceDescriptor.Methods().ByName("DisableApp")
+	appRegistryServiceDeleteAppMethodDescriptor       = appRegistryServiceServiceDescriptor.Methods().ByName("DeleteApp")
+	appRegistryServiceSetPermissionsMethodDescriptor  = appRegistryServiceServiceDescriptor.Methods().ByName("SetPermissions")
 )
 
 // AppRegistryServiceClient is a client for the river.AppRegistryService service.
 type AppRegistryServiceClient interface {
 	Register(context.Context, *connect.Request[protocol.RegisterRequest]) (*connect.Response[protocol.RegisterResponse], error)
 	RegisterWebhook(context.Context, *connect.Request[protocol.RegisterWebhookRequest]) (*connect.Response[protocol.RegisterWebhookResponse], error)
-	// rpc GetInfo(GetInfoRequest) returns (GetInfoResponse);
-	// rpc RotateSecret(RotateSecretRequest) returns (RotateSecretResponse);
 	GetStatus(context.Context, *connect.Request[protocol.GetStatusRequest]) (*connect.Response[protocol.GetStatusResponse], error)
+	GetDeliveryLog(context.Context, *connect.Request[protocol.GetDeliveryLogRequest]) (*connect.Response[protocol.GetDeliveryLogResponse], error)
+	// GetPermissions is called by stream nodes to enforce the permissions of apps that add events.
+	GetPermissions(context.Context, *connect.Request[protocol.GetPermissionsRequest]) (*connect.Response[protocol.GetPermissionsResponse], error)
+	// GetApps is called by stream nodes to learn which event creators are apps, the permissions are
+	// only looked up for apps.
+	GetApps(context.Context, *connect.Request[protocol.GetAppsRequest]) (*connect.Response[protocol.GetAppsResponse], error)
+	// The following functions can only be called by the app owner. Each call is recorded in the audit log
+	// of the app registry.
+	GetInfo(context.Context, *connect.Request[protocol.GetInfoRequest]) (*connect.Response[protocol.GetInfoResponse], error)
+	RotateSecret(context.Context, *connect.Request[protocol.RotateSecretRequest]) (*connect.Response[protocol.RotateSecretResponse], error)
+	UpdateWebhook(context.Context, *connect.Request[protocol.UpdateWebhookRequest]) (*connect.Response[protocol.UpdateWebhookResponse], error)
+	DisableApp(context.Context, *connect.Request[protocol.DisableAppRequest]) (*connect.Response[protocol.DisableAppResponse], error)
+	DeleteApp(context.Context, *connect.Request[protocol.DeleteAppRequest]) (*connect.Response[protocol.DeleteAppResponse], error)
+	SetPermissions(context.Context, *connect.Request[protocol.SetPermissionsRequest]) (*connect.Response[protocol.SetPermissionsResponse], error)
 }
 
 // NewAppRegistryServiceClient constructs a client for the river.AppRegistryService service. By
@@ -89,6 +137,60 @@ func NewAppRegistryServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(appRegistryServiceGetStatusMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getDeliveryLog: connect.NewClient[protocol.GetDeliveryLogRequest, protocol.GetDeliveryLogResponse](
+			httpClient,
+			baseURL+AppRegistryServiceGetDeliveryLogProcedure,
+			connect.WithSchema(appRegistryServiceGetDeliveryLogMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getPermissions: connect.NewClient[protocol.GetPermissionsRequest, protocol.GetPermissionsResponse](
+			httpClient,
+			baseURL+AppRegistryServiceGetPermissionsProcedure,
+			connect.WithSchema(appRegistryServiceGetPermissionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getApps: connect.NewClient[protocol.GetAppsRequest, protocol.GetAppsResponse](
+			httpClient,
+			baseURL+AppRegistryServiceGetAppsProcedure,
+			connect.WithSchema(appRegistryServiceGetAppsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getInfo: connect.NewClient[protocol.GetInfoRequest, protocol.GetInfoResponse](
+			httpClient,
+			baseURL+AppRegistryServiceGetInfoProcedure,
+			connect.WithSchema(appRegistryServiceGetInfoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rotateSecret: connect.NewClient[protocol.RotateSecretRequest, protocol.RotateSecretResponse](
+			httpClient,
+			baseURL+AppRegistryServiceRotateSecretProcedure,
+			connect.WithSchema(appRegistryServiceRotateSecretMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhook: connect.NewClient[protocol.UpdateWebhookRequest, protocol.UpdateWebhookResponse](
+			httpClient,
+			baseURL+AppRegistryServiceUpdateWebhookProcedure,
+			connect.WithSchema(appRegistryServiceUpdateWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		disableApp: connect.NewClient[protocol.DisableAppRequest, protocol.DisableAppResponse](
+			httpClient,
+			baseURL+AppRegistryServiceDisableAppProcedure,
+			connect.WithSchema(appRegistryServiceDisableAppMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteApp: connect.NewClient[protocol.DeleteAppRequest, protocol.DeleteAppResponse](
+			httpClient,
+			baseURL+AppRegistryServiceDeleteAppProcedure,
+			connect.WithSchema(appRegistryServiceDeleteAppMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setPermissions: connect.NewClient[protocol.SetPermissionsRequest, protocol.SetPermissionsResponse](
+			httpClient,
+			baseURL+AppRegistryServiceSetPermissionsProcedure,
+			connect.WithSchema(appRegistryServiceSetPermissionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -97,6 +199,15 @@ type appRegistryServiceClient struct {
 	register        *connect.Client[protocol.RegisterRequest, protocol.RegisterResponse]
 	registerWebhook *connect.Client[protocol.RegisterWebhookRequest, protocol.RegisterWebhookResponse]
 	getStatus       *connect.Client[protocol.GetStatusRequest, protocol.GetStatusResponse]
+	getDeliveryLog  *connect.Client[protocol.GetDeliveryLogRequest, protocol.GetDeliveryLogResponse]
+	getPermissions  *connect.Client[protocol.GetPermissionsRequest, protocol.GetPermissionsResponse]
+	getApps         *connect.Client[protocol.GetAppsRequest, protocol.GetAppsResponse]
+	getInfo         *connect.Client[protocol.GetInfoRequest, protocol.GetInfoResponse]
+	rotateSecret    *connect.Client[protocol.RotateSecretRequest, protocol.RotateSecretResponse]
+	updateWebhook   *connect.Client[protocol.UpdateWebhookRequest, protocol.UpdateWebhookResponse]
+	disableApp      *connect.Client[protocol.DisableAppRequest, protocol.DisableAppResponse]
+	deleteApp       *connect.Client[protocol.DeleteAppRequest, protocol.DeleteAppResponse]
+	setPermissions  *connect.Client[protocol.SetPermissionsRequest, protocol.SetPermissionsResponse]
 }
 
 // Register calls river.AppRegistryService.Register.
@@ -114,13 +225,70 @@ func (c *appRegistryServiceClient) GetStatus(ctx context.Context, req *connect.R
 	return c.getStatus.CallUnary(ctx, req)
 }
 
+// GetDeliveryLog calls river.AppRegistryService.GetDeliveryLog.
+func (c *appRegistryServiceClient) GetDeliveryLog(ctx context.Context, req *connect.Request[protocol.GetDeliveryLogRequest]) (*connect.Response[protocol.GetDeliveryLogResponse], error) {
+	return c.getDeliveryLog.CallUnary(ctx, req)
+}
+
+// GetPermissions calls river.AppRegistryService.GetPermissions.
+func (c *appRegistryServiceClient) GetPermissions(ctx context.Context, req *connect.Request[protocol.GetPermissionsRequest]) (*connect.Response[protocol.GetPermissionsResponse], error) {
+	return c.getPermissions.CallUnary(ctx, req)
+}
+
+// GetApps calls river.AppRegistryService.GetApps.
+func (c *appRegistryServiceClient) GetApps(ctx context.Context, req *connect.Request[protocol.GetAppsRequest]) (*connect.Response[protocol.GetAppsResponse], error) {
+	return c.getApps.CallUnary(ctx, req)
+}
+
+// GetInfo calls river.AppRegistryService.GetInfo.
+func (c *appRegistryServiceClient) GetInfo(ctx context.Context, req *connect.Request[protocol.GetInfoRequest]) (*connect.Response[protocol.GetInfoResponse], error) {
+	return c.getInfo.CallUnary(ctx, req)
+}
+
+// RotateSecret calls river.AppRegistryService.RotateSecret.
+func (c *appRegistryServiceClient) RotateSecret(ctx context.Context, req *connect.Request[protocol.RotateSecretRequest]) (*connect.Response[protocol.RotateSecretResponse], error) {
+	return c.rotateSecret.CallUnary(ctx, req)
+}
+
+// UpdateWebhook calls river.AppRegistryService.UpdateWebhook.
+func (c *appRegistryServiceClient) UpdateWebhook(ctx context.Context, req *connect.Request[protocol.UpdateWebhookRequest]) (*connect.Response[protocol.UpdateWebhookResponse], error) {
+	return c.updateWebhook.CallUnary(ctx, req)
+}
+
+// DisableApp calls river.AppRegistryService.DisableApp.
+func (c *appRegistryServiceClient) DisableApp(ctx context.Context, req *connect.Request[protocol.DisableAppRequest]) (*connect.Response[protocol.DisableAppResponse], error) {
+	return c.disableApp.CallUnary(ctx, req)
+}
+
+// DeleteApp calls river.AppRegistryService.DeleteApp.
+func (c *appRegistryServiceClient) DeleteApp(ctx context.Context, req *connect.Request[protocol.DeleteAppRequest]) (*connect.Response[protocol.DeleteAppResponse], error) {
+	return c.deleteApp.CallUnary(ctx, req)
+}
+
+// SetPermissions calls river.AppRegistryService.SetPermissions.
+func (c *appRegistryServiceClient) SetPermissions(ctx context.Context, req *connect.Request[protocol.SetPermissionsRequest]) (*connect.Response[protocol.SetPermissionsResponse], error) {
+	return c.setPermissions.CallUnary(ctx, req)
+}
+
 // AppRegistryServiceHandler is an implementation of the river.AppRegistryService service.
 type AppRegistryServiceHandler interface {
 	Register(context.Context, *connect.Request[protocol.RegisterRequest]) (*connect.Response[protocol.RegisterResponse], error)
 	RegisterWebhook(context.Context, *connect.Request[protocol.RegisterWebhookRequest]) (*connect.Response[protocol.RegisterWebhookResponse], error)
-	// rpc GetInfo(GetInfoRequest) returns (GetInfoResponse);
-	// rpc RotateSecret(RotateSecretRequest) returns (RotateSecretResponse);
 	GetStatus(context.Context, *connect.Request[protocol.GetStatusRequest]) (*connect.Response[protocol.GetStatusResponse], error)
+	GetDeliveryLog(context.Context, *connect.Request[protocol.GetDeliveryLogRequest]) (*connect.Response[protocol.GetDeliveryLogResponse], error)
+	// GetPermissions is called by stream nodes to enforce the permissions of apps that add events.
+	GetPermissions(context.Context, *connect.Request[protocol.GetPermissionsRequest]) (*connect.Response[protocol.GetPermissionsResponse], error)
+	// GetApps is called by stream nodes to learn which event creators are apps, the permissions are
+	// only looked up for apps.
+	GetApps(context.Context, *connect.Request[protocol.GetAppsRequest]) (*connect.Response[protocol.GetAppsResponse], error)
+	// The following functions can only be called by the app owner. Each call is recorded in the audit log
+	// of the app registry.
+	GetInfo(context.Context, *connect.Request[protocol.GetInfoRequest]) (*connect.Response[protocol.GetInfoResponse], error)
+	RotateSecret(context.Context, *connect.Request[protocol.RotateSecretRequest]) (*connect.Response[protocol.RotateSecretResponse], error)
+	UpdateWebhook(context.Context, *connect.Request[protocol.UpdateWebhookRequest]) (*connect.Response[protocol.UpdateWebhookResponse], error)
+	DisableApp(context.Context, *connect.Request[protocol.DisableAppRequest]) (*connect.Response[protocol.DisableAppResponse], error)
+	DeleteApp(context.Context, *connect.Request[protocol.DeleteAppRequest]) (*connect.Response[protocol.DeleteAppResponse], error)
+	SetPermissions(context.Context, *connect.Request[protocol.SetPermissionsRequest]) (*connect.Response[protocol.SetPermissionsResponse], error)
 }
 
 // NewAppRegistryServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -147,6 +315,60 @@ func NewAppRegistryServiceHandler(svc AppRegistryServiceHandler, opts ...connect
 		connect.WithSchema(appRegistryServiceGetStatusMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	appRegistryServiceGetDeliveryLogHandler := connect.NewUnaryHandler(
+		AppRegistryServiceGetDeliveryLogProcedure,
+		svc.GetDeliveryLog,
+		connect.WithSchema(appRegistryServiceGetDeliveryLogMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	appRegistryServiceGetPermissionsHandler := connect.NewUnaryHandler(
+		AppRegistryServiceGetPermissionsProcedure,
+		svc.GetPermissions,
+		connect.WithSchema(appRegistryServiceGetPermissionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	appRegistryServiceGetAppsHandler := connect.NewUnaryHandler(
+		AppRegistryServiceGetAppsProcedure,
+		svc.GetApps,
+		connect.WithSchema(appRegistryServiceGetAppsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	appRegistryServiceGetInfoHandler := connect.NewUnaryHandler(
+		AppRegistryServiceGetInfoProcedure,
+		svc.GetInfo,
+		connect.WithSchema(appRegistryServiceGetInfoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	appRegistryServiceRotateSecretHandler := connect.NewUnaryHandler(
+		AppRegistryServiceRotateSecretProcedure,
+		svc.RotateSecret,
+		connect.WithSchema(appRegistryServiceRotateSecretMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	appRegistryServiceUpdateWebhookHandler := connect.NewUnaryHandler(
+		AppRegistryServiceUpdateWebhookProcedure,
+		svc.UpdateWebhook,
+		connect.WithSchema(appRegistryServiceUpdateWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	appRegistryServiceDisableAppHandler := connect.NewUnaryHandler(
+		AppRegistryServiceDisableAppProcedure,
+		svc.DisableApp,
+		connect.WithSchema(appRegistryServiceDisableAppMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	appRegistryServiceDeleteAppHandler := connect.NewUnaryHandler(
+		AppRegistryServiceDeleteAppProcedure,
+		svc.DeleteApp,
+		connect.WithSchema(appRegistryServiceDeleteAppMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	appRegistryServiceSetPermissionsHandler := connect.NewUnaryHandler(
+		AppRegistryServiceSetPermissionsProcedure,
+		svc.SetPermissions,
+		connect.WithSchema(appRegistryServiceSetPermissionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/river.AppRegistryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AppRegistryServiceRegisterProcedure:
@@ -155,6 +377,24 @@ func NewAppRegistryServiceHandler(svc AppRegistryServiceHandler, opts ...connect
 			appRegistryServiceRegisterWebhookHandler.ServeHTTP(w, r)
 		case AppRegistryServiceGetStatusProcedure:
 			appRegistryServiceGetStatusHandler.ServeHTTP(w, r)
+		case AppRegistryServiceGetDeliveryLogProcedure:
+			appRegistryServiceGetDeliveryLogHandler.ServeHTTP(w, r)
+		case AppRegistryServiceGetPermissionsProcedure:
+			appRegistryServiceGetPermissionsHandler.ServeHTTP(w, r)
+		case AppRegistryServiceGetAppsProcedure:
+			appRegistryServiceGetAppsHandler.ServeHTTP(w, r)
+		case AppRegistryServiceGetInfoProcedure:
+			appRegistryServiceGetInfoHandler.ServeHTTP(w, r)
+		case AppRegistryServiceRotateSecretProcedure:
+			appRegistryServiceRotateSecretHandler.ServeHTTP(w, r)
+		case AppRegistryServiceUpdateWebhookProcedure:
+			appRegistryServiceUpdateWebhookHandler.ServeHTTP(w, r)
+		case AppRegistryServiceDisableAppProcedure:
+			appRegistryServiceDisableAppHandler.ServeHTTP(w, r)
+		case AppRegistryServiceDeleteAppProcedure:
+			appRegistryServiceDeleteAppHandler.ServeHTTP(w, r)
+		case AppRegistryServiceSetPermissionsProcedure:
+			appRegistryServiceSetPermissionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -175,3 +415,39 @@ func (UnimplementedAppRegistryServiceHandler) RegisterWebhook(context.Context, *
 func (UnimplementedAppRegistryServiceHandler) GetStatus(context.Context, *connect.Request[protocol.GetStatusRequest]) (*connect.Response[protocol.GetStatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.GetStatus is not implemented"))
 }
+
+func (UnimplementedAppRegistryServiceHandler) GetDeliveryLog(context.Context, *connect.Request[protocol.GetDeliveryLogRequest]) (*connect.Response[protocol.GetDeliveryLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.GetDeliveryLog is not implemented"))
+}
+
+func (UnimplementedAppRegistryServiceHandler) GetPermissions(context.Context, *connect.Request[protocol.GetPermissionsRequest]) (*connect.Response[protocol.GetPermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.GetPermissions is not implemented"))
+}
+
+func (UnimplementedAppRegistryServiceHandler) GetApps(context.Context, *connect.Request[protocol.GetAppsRequest]) (*connect.Response[protocol.GetAppsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.GetApps is not implemented"))
+}
+
+func (UnimplementedAppRegistryServiceHandler) GetInfo(context.Context, *connect.Request[protocol.GetInfoRequest]) (*connect.Response[protocol.GetInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.GetInfo is not implemented"))
+}
+
+func (UnimplementedAppRegistryServiceHandler) RotateSecret(context.Context, *connect.Request[protocol.RotateSecretRequest]) (*connect.Response[protocol.RotateSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.RotateSecret is not implemented"))
+}
+
+func (UnimplementedAppRegistryServiceHandler) UpdateWebhook(context.Context, *connect.Request[protocol.UpdateWebhookRequest]) (*connect.Response[protocol.UpdateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.UpdateWebhook is not implemented"))
+}
+
+func (UnimplementedAppRegistryServiceHandler) DisableApp(context.Context, *connect.Request[protocol.DisableAppRequest]) (*connect.Response[protocol.DisableAppResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.DisableApp is not implemented"))
+}
+
+func (UnimplementedAppRegistryServiceHandler) DeleteApp(context.Context, *connect.Request[protocol.DeleteAppRequest]) (*connect.Response[protocol.DeleteAppResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.DeleteApp is not implemented"))
+}
+
+func (UnimplementedAppRegistryServiceHandler) SetPermissions(context.Context, *connect.Request[protocol.SetPermissionsRequest]) (*connect.Response[protocol.SetPermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AppRegistryService.SetPermissions is not implemented"))
+}
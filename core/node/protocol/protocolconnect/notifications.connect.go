@@ -66,6 +66,21 @@ const (
 	// NotificationServiceUnsubscribeAPNProcedure is the fully-qualified name of the
 	// NotificationService's UnsubscribeAPN RPC.
 	NotificationServiceUnsubscribeAPNProcedure = "/river.NotificationService/UnsubscribeAPN"
+	// NotificationServiceSubscribeFCMProcedure is the fully-qualified name of the NotificationService's
+	// SubscribeFCM RPC.
+	NotificationServiceSubscribeFCMProcedure = "/river.NotificationService/SubscribeFCM"
+	// NotificationServiceUnsubscribeFCMProcedure is the fully-qualified name of the
+	// NotificationService's UnsubscribeFCM RPC.
+	NotificationServiceUnsubscribeFCMProcedure = "/river.NotificationService/UnsubscribeFCM"
+	// NotificationServiceSetQuietHoursProcedure is the fully-qualified name of the
+	// NotificationService's SetQuietHours RPC.
+	NotificationServiceSetQuietHoursProcedure = "/river.NotificationService/SetQuietHours"
+	// NotificationServiceSetSnoozeProcedure is the fully-qualified name of the NotificationService's
+	// SetSnooze RPC.
+	NotificationServiceSetSnoozeProcedure = "/river.NotificationService/SetSnooze"
+	// NotificationServiceGetUnreadCountsProcedure is the fully-qualified name of the
+	// NotificationService's GetUnreadCounts RPC.
+	NotificationServiceGetUnreadCountsProcedure = "/river.NotificationService/GetUnreadCounts"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -82,6 +97,11 @@ var (
 	notificationServiceUnsubscribeWebPushMethodDescriptor      = notificationServiceServiceDescriptor.Methods().ByName("UnsubscribeWebPush")
 	notificationServiceSubscribeAPNMethodDescriptor            = notificationServiceServiceDescriptor.Methods().ByName("SubscribeAPN")
 	notificationServiceUnsubscribeAPNMethodDescriptor          = notificationServiceServiceDescriptor.Methods().ByName("UnsubscribeAPN")
+	notificationServiceSubscribeFCMMethodDescriptor            = notificationServiceServiceDescriptor.Methods().ByName("SubscribeFCM")
+	notificationServiceUnsubscribeFCMMethodDescriptor          = notificationServiceServiceDescriptor.Methods().ByName("UnsubscribeFCM")
+	notificationServiceSetQuietHoursMethodDescriptor           = notificationServiceServiceDescriptor.Methods().ByName("SetQuietHours")
+	notificationServiceSetSnoozeMethodDescriptor               = notificationServiceServiceDescriptor.Methods().ByName("SetSnooze")
+	notificationServiceGetUnreadCountsMethodDescriptor         = notificationServiceServiceDescriptor.Methods().ByName("GetUnreadCounts")
 )
 
 // NotificationServiceClient is a client for the river.NotificationService service.
@@ -110,6 +130,20 @@ type NotificationServiceClient interface {
 	SubscribeAPN(context.Context, *connect.Request[protocol.SubscribeAPNRequest]) (*connect.Response[protocol.SubscribeAPNResponse], error)
 	// UnsubscribeAPN unsubscribes a device from receiving Apple Push Notifications.
 	UnsubscribeAPN(context.Context, *connect.Request[protocol.UnsubscribeAPNRequest]) (*connect.Response[protocol.UnsubscribeAPNResponse], error)
+	// SubscribeFCM subscribes an Android device to receive notifications through Firebase Cloud Messaging.
+	// If the given registration token is already associated with an FCM subscription the user id is updated (upsert).
+	SubscribeFCM(context.Context, *connect.Request[protocol.SubscribeFCMRequest]) (*connect.Response[protocol.SubscribeFCMResponse], error)
+	// UnsubscribeFCM unsubscribes a device from receiving Firebase Cloud Messaging notifications.
+	UnsubscribeFCM(context.Context, *connect.Request[protocol.UnsubscribeFCMRequest]) (*connect.Response[protocol.UnsubscribeFCMResponse], error)
+	// SetQuietHours sets the recurring time windows in which the user doesn't receive notifications,
+	// overwriting existing quiet hours. Quiet hours are removed when no windows are given.
+	SetQuietHours(context.Context, *connect.Request[protocol.SetQuietHoursRequest]) (*connect.Response[protocol.SetQuietHoursResponse], error)
+	// SetSnooze suppresses notifications until the given time, either for a space or for all streams.
+	// The snooze is removed when until_epoch_ms is 0.
+	SetSnooze(context.Context, *connect.Request[protocol.SetSnoozeRequest]) (*connect.Response[protocol.SetSnoozeResponse], error)
+	// GetUnreadCounts returns the number of unread messages and mentions for each channel with unread messages.
+	// Counts are reset when the user marks a channel as read in the user settings stream.
+	GetUnreadCounts(context.Context, *connect.Request[protocol.GetUnreadCountsRequest]) (*connect.Response[protocol.GetUnreadCountsResponse], error)
 }
 
 // NewNotificationServiceClient constructs a client for the river.NotificationService service. By
@@ -188,6 +222,36 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceUnsubscribeAPNMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		subscribeFCM: connect.NewClient[protocol.SubscribeFCMRequest, protocol.SubscribeFCMResponse](
+			httpClient,
+			baseURL+NotificationServiceSubscribeFCMProcedure,
+			connect.WithSchema(notificationServiceSubscribeFCMMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unsubscribeFCM: connect.NewClient[protocol.UnsubscribeFCMRequest, protocol.UnsubscribeFCMResponse](
+			httpClient,
+			baseURL+NotificationServiceUnsubscribeFCMProcedure,
+			connect.WithSchema(notificationServiceUnsubscribeFCMMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setQuietHours: connect.NewClient[protocol.SetQuietHoursRequest, protocol.SetQuietHoursResponse](
+			httpClient,
+			baseURL+NotificationServiceSetQuietHoursProcedure,
+			connect.WithSchema(notificationServiceSetQuietHoursMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setSnooze: connect.NewClient[protocol.SetSnoozeRequest, protocol.SetSnoozeResponse](
+			httpClient,
+			baseURL+NotificationServiceSetSnoozeProcedure,
+			connect.WithSchema(notificationServiceSetSnoozeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getUnreadCounts: connect.NewClient[protocol.GetUnreadCountsRequest, protocol.GetUnreadCountsResponse](
+			httpClient,
+			baseURL+NotificationServiceGetUnreadCountsProcedure,
+			connect.WithSchema(notificationServiceGetUnreadCountsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -204,6 +268,11 @@ type notificationServiceClient struct {
 	unsubscribeWebPush      *connect.Client[protocol.UnsubscribeWebPushRequest, protocol.UnsubscribeWebPushResponse]
 	subscribeAPN            *connect.Client[protocol.SubscribeAPNRequest, protocol.SubscribeAPNResponse]
 	unsubscribeAPN          *connect.Client[protocol.UnsubscribeAPNRequest, protocol.UnsubscribeAPNResponse]
+	subscribeFCM            *connect.Client[protocol.SubscribeFCMRequest, protocol.SubscribeFCMResponse]
+	unsubscribeFCM          *connect.Client[protocol.UnsubscribeFCMRequest, protocol.UnsubscribeFCMResponse]
+	setQuietHours           *connect.Client[protocol.SetQuietHoursRequest, protocol.SetQuietHoursResponse]
+	setSnooze               *connect.Client[protocol.SetSnoozeRequest, protocol.SetSnoozeResponse]
+	getUnreadCounts         *connect.Client[protocol.GetUnreadCountsRequest, protocol.GetUnreadCountsResponse]
 }
 
 // GetSettings calls river.NotificationService.GetSettings.
@@ -261,6 +330,31 @@ func (c *notificationServiceClient) UnsubscribeAPN(ctx context.Context, req *con
 	return c.unsubscribeAPN.CallUnary(ctx, req)
 }
 
+// SubscribeFCM calls river.NotificationService.SubscribeFCM.
+func (c *notificationServiceClient) SubscribeFCM(ctx context.Context, req *connect.Request[protocol.SubscribeFCMRequest]) (*connect.Response[protocol.SubscribeFCMResponse], error) {
+	return c.subscribeFCM.CallUnary(ctx, req)
+}
+
+// UnsubscribeFCM calls river.NotificationService.UnsubscribeFCM.
+func (c *notificationServiceClient) UnsubscribeFCM(ctx context.Context, req *connect.Request[protocol.UnsubscribeFCMRequest]) (*connect.Response[protocol.UnsubscribeFCMResponse], error) {
+	return c.unsubscribeFCM.CallUnary(ctx, req)
+}
+
+// SetQuietHours calls river.NotificationService.SetQuietHours.
+func (c *notificationServiceClient) SetQuietHours(ctx context.Context, req *connect.Request[protocol.SetQuietHoursRequest]) (*connect.Response[protocol.SetQuietHoursResponse], error) {
+	return c.setQuietHours.CallUnary(ctx, req)
+}
+
+// SetSnooze calls river.NotificationService.SetSnooze.
+func (c *notificationServiceClient) SetSnooze(ctx context.Context, req *connect.Request[protocol.SetSnoozeRequest]) (*connect.Response[protocol.SetSnoozeResponse], error) {
+	return c.setSnooze.CallUnary(ctx, req)
+}
+
+// GetUnreadCounts calls river.NotificationService.GetUnreadCounts.
+func (c *notificationServiceClient) GetUnreadCounts(ctx context.Context, req *connect.Request[protocol.GetUnreadCountsRequest]) (*connect.Response[protocol.GetUnreadCountsResponse], error) {
+	return c.getUnreadCounts.CallUnary(ctx, req)
+}
+
 // NotificationServiceHandler is an implementation of the river.NotificationService service.
 type NotificationServiceHandler interface {
 	// GetSettings returns user stored notification settings.
@@ -287,6 +381,20 @@ type NotificationServiceHandler interface {
 	SubscribeAPN(context.Context, *connect.Request[protocol.SubscribeAPNRequest]) (*connect.Response[protocol.SubscribeAPNResponse], error)
 	// UnsubscribeAPN unsubscribes a device from receiving Apple Push Notifications.
 	UnsubscribeAPN(context.Context, *connect.Request[protocol.UnsubscribeAPNRequest]) (*connect.Response[protocol.UnsubscribeAPNResponse], error)
+	// SubscribeFCM subscribes an Android device to receive notifications through Firebase Cloud Messaging.
+	// If the given registration token is already associated with an FCM subscription the user id is updated (upsert).
+	SubscribeFCM(context.Context, *connect.Request[protocol.SubscribeFCMRequest]) (*connect.Response[protocol.SubscribeFCMResponse], error)
+	// UnsubscribeFCM unsubscribes a device from receiving Firebase Cloud Messaging notifications.
+	UnsubscribeFCM(context.Context, *connect.Request[protocol.UnsubscribeFCMRequest]) (*connect.Response[protocol.UnsubscribeFCMResponse], error)
+	// SetQuietHours sets the recurring time windows in which the user doesn't receive notifications,
+	// overwriting existing quiet hours. Quiet hours are removed when no windows are given.
+	SetQuietHours(context.Context, *connect.Request[protocol.SetQuietHoursRequest]) (*connect.Response[protocol.SetQuietHoursResponse], error)
+	// SetSnooze suppresses notifications until the given time, either for a space or for all streams.
+	// The snooze is removed when until_epoch_ms is 0.
+	SetSnooze(context.Context, *connect.Request[protocol.SetSnoozeRequest]) (*connect.Response[protocol.SetSnoozeResponse], error)
+	// GetUnreadCounts returns the number of unread messages and mentions for each channel with unread messages.
+	// Counts are reset when the user marks a channel as read in the user settings stream.
+	GetUnreadCounts(context.Context, *connect.Request[protocol.GetUnreadCountsRequest]) (*connect.Response[protocol.GetUnreadCountsResponse], error)
 }
 
 // NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -361,6 +469,36 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceUnsubscribeAPNMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceSubscribeFCMHandler := connect.NewUnaryHandler(
+		NotificationServiceSubscribeFCMProcedure,
+		svc.SubscribeFCM,
+		connect.WithSchema(notificationServiceSubscribeFCMMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceUnsubscribeFCMHandler := connect.NewUnaryHandler(
+		NotificationServiceUnsubscribeFCMProcedure,
+		svc.UnsubscribeFCM,
+		connect.WithSchema(notificationServiceUnsubscribeFCMMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSetQuietHoursHandler := connect.NewUnaryHandler(
+		NotificationServiceSetQuietHoursProcedure,
+		svc.SetQuietHours,
+		connect.WithSchema(notificationServiceSetQuietHoursMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSetSnoozeHandler := connect.NewUnaryHandler(
+		NotificationServiceSetSnoozeProcedure,
+		svc.SetSnooze,
+		connect.WithSchema(notificationServiceSetSnoozeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceGetUnreadCountsHandler := connect.NewUnaryHandler(
+		NotificationServiceGetUnreadCountsProcedure,
+		svc.GetUnreadCounts,
+		connect.WithSchema(notificationServiceGetUnreadCountsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/river.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NotificationServiceGetSettingsProcedure:
@@ -385,6 +523,16 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 			notificationServiceSubscribeAPNHandler.ServeHTTP(w, r)
 		case NotificationServiceUnsubscribeAPNProcedure:
 			notificationServiceUnsubscribeAPNHandler.ServeHTTP(w, r)
+		case NotificationServiceSubscribeFCMProcedure:
+			notificationServiceSubscribeFCMHandler.ServeHTTP(w, r)
+		case NotificationServiceUnsubscribeFCMProcedure:
+			notificationServiceUnsubscribeFCMHandler.ServeHTTP(w, r)
+		case NotificationServiceSetQuietHoursProcedure:
+			notificationServiceSetQuietHoursHandler.ServeHTTP(w, r)
+		case NotificationServiceSetSnoozeProcedure:
+			notificationServiceSetSnoozeHandler.ServeHTTP(w, r)
+		case NotificationServiceGetUnreadCountsProcedure:
+			notificationServiceGetUnreadCountsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -437,3 +585,23 @@ func (UnimplementedNotificationServiceHandler) SubscribeAPN(context.Context, *co
 func (UnimplementedNotificationServiceHandler) UnsubscribeAPN(context.Context, *connect.Request[protocol.UnsubscribeAPNRequest]) (*connect.Response[protocol.UnsubscribeAPNResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.UnsubscribeAPN is not implemented"))
 }
+
+func (UnimplementedNotificationServiceHandler) SubscribeFCM(context.Context, *connect.Request[protocol.SubscribeFCMRequest]) (*connect.Response[protocol.SubscribeFCMResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.SubscribeFCM is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) UnsubscribeFCM(context.Context, *connect.Request[protocol.UnsubscribeFCMRequest]) (*connect.Response[protocol.UnsubscribeFCMResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.UnsubscribeFCM is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) SetQuietHours(context.Context, *connect.Request[protocol.SetQuietHoursRequest]) (*connect.Response[protocol.SetQuietHoursResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.SetQuietHours is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) SetSnooze(context.Context, *connect.Request[protocol.SetSnoozeRequest]) (*connect.Response[protocol.SetSnoozeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.SetSnooze is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) GetUnreadCounts(context.Context, *connect.Request[protocol.GetUnreadCountsRequest]) (*connect.Response[protocol.GetUnreadCountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.GetUnreadCounts is not implemented"))
+}
@@ -53,8 +53,16 @@ const (
 	// StreamServiceGetMiniblockHeaderProcedure is the fully-qualified name of the StreamService's
 	// GetMiniblockHeader RPC.
 	StreamServiceGetMiniblockHeaderProcedure = "/river.StreamService/GetMiniblockHeader"
+	// StreamServiceGetStreamSnapshotProcedure is the fully-qualified name of the StreamService's
+	// GetStreamSnapshot RPC.
+	StreamServiceGetStreamSnapshotProcedure = "/river.StreamService/GetStreamSnapshot"
+	// StreamServiceSearchEventsProcedure is the fully-qualified name of the StreamService's
+	// SearchEvents RPC.
+	StreamServiceSearchEventsProcedure = "/river.StreamService/SearchEvents"
 	// StreamServiceAddEventProcedure is the fully-qualified name of the StreamService's AddEvent RPC.
 	StreamServiceAddEventProcedure = "/river.StreamService/AddEvent"
+	// StreamServiceAddEventsProcedure is the fully-qualified name of the StreamService's AddEvents RPC.
+	StreamServiceAddEventsProcedure = "/river.StreamService/AddEvents"
 	// StreamServiceAddMediaEventProcedure is the fully-qualified name of the StreamService's
 	// AddMediaEvent RPC.
 	StreamServiceAddMediaEventProcedure = "/river.StreamService/AddMediaEvent"
@@ -89,7 +97,10 @@ var (
 	streamServiceGetMiniblocksMethodDescriptor        = streamServiceServiceDescriptor.Methods().ByName("GetMiniblocks")
 	streamServiceGetLastMiniblockHashMethodDescriptor = streamServiceServiceDescriptor.Methods().ByName("GetLastMiniblockHash")
 	streamServiceGetMiniblockHeaderMethodDescriptor   = streamServiceServiceDescriptor.Methods().ByName("GetMiniblockHeader")
+	streamServiceGetStreamSnapshotMethodDescriptor    = streamServiceServiceDescriptor.Methods().ByName("GetStreamSnapshot")
+	streamServiceSearchEventsMethodDescriptor         = streamServiceServiceDescriptor.Methods().ByName("SearchEvents")
 	streamServiceAddEventMethodDescriptor             = streamServiceServiceDescriptor.Methods().ByName("AddEvent")
+	streamServiceAddEventsMethodDescriptor            = streamServiceServiceDescriptor.Methods().ByName("AddEvents")
 	streamServiceAddMediaEventMethodDescriptor        = streamServiceServiceDescriptor.Methods().ByName("AddMediaEvent")
 	streamServiceSyncStreamsMethodDescriptor          = streamServiceServiceDescriptor.Methods().ByName("SyncStreams")
 	streamServiceAddStreamToSyncMethodDescriptor      = streamServiceServiceDescriptor.Methods().ByName("AddStreamToSync")
@@ -109,7 +120,14 @@ type StreamServiceClient interface {
 	GetMiniblocks(context.Context, *connect.Request[protocol.GetMiniblocksRequest]) (*connect.Response[protocol.GetMiniblocksResponse], error)
 	GetLastMiniblockHash(context.Context, *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error)
 	GetMiniblockHeader(context.Context, *connect.Request[protocol.GetMiniblockHeaderRequest]) (*connect.Response[protocol.GetMiniblockHeaderResponse], error)
+	// GetStreamSnapshot returns the stream snapshot (members, channel metadata, pins, settings, etc.)
+	// as of the given miniblock.
+	GetStreamSnapshot(context.Context, *connect.Request[protocol.GetStreamSnapshotRequest]) (*connect.Response[protocol.GetStreamSnapshotResponse], error)
+	// SearchEvents searches the event index of the node. Requires a session token from the
+	// AuthenticationService of the node in the Authorization header.
+	SearchEvents(context.Context, *connect.Request[protocol.SearchEventsRequest]) (*connect.Response[protocol.SearchEventsResponse], error)
 	AddEvent(context.Context, *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error)
+	AddEvents(context.Context, *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error)
 	AddMediaEvent(context.Context, *connect.Request[protocol.AddMediaEventRequest]) (*connect.Response[protocol.AddMediaEventResponse], error)
 	SyncStreams(context.Context, *connect.Request[protocol.SyncStreamsRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error)
 	AddStreamToSync(context.Context, *connect.Request[protocol.AddStreamToSyncRequest]) (*connect.Response[protocol.AddStreamToSyncResponse], error)
@@ -177,12 +195,30 @@ func NewStreamServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(streamServiceGetMiniblockHeaderMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getStreamSnapshot: connect.NewClient[protocol.GetStreamSnapshotRequest, protocol.GetStreamSnapshotResponse](
+			httpClient,
+			baseURL+StreamServiceGetStreamSnapshotProcedure,
+			connect.WithSchema(streamServiceGetStreamSnapshotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		searchEvents: connect.NewClient[protocol.SearchEventsRequest, protocol.SearchEventsResponse](
+			httpClient,
+			baseURL+StreamServiceSearchEventsProcedure,
+			connect.WithSchema(streamServiceSearchEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		addEvent: connect.NewClient[protocol.AddEventRequest, protocol.AddEventResponse](
 			httpClient,
 			baseURL+StreamServiceAddEventProcedure,
 			connect.WithSchema(streamServiceAddEventMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		addEvents: connect.NewClient[protocol.AddEventsRequest, protocol.AddEventsResponse](
+			httpClient,
+			baseURL+StreamServiceAddEventsProcedure,
+			connect.WithSchema(streamServiceAddEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		addMediaEvent: connect.NewClient[protocol.AddMediaEventRequest, protocol.AddMediaEventResponse](
 			httpClient,
 			baseURL+StreamServiceAddMediaEventProcedure,
@@ -243,7 +279,10 @@ type streamServiceClient struct {
 	getMiniblocks        *connect.Client[protocol.GetMiniblocksRequest, protocol.GetMiniblocksResponse]
 	getLastMiniblockHash *connect.Client[protocol.GetLastMiniblockHashRequest, protocol.GetLastMiniblockHashResponse]
 	getMiniblockHeader   *connect.Client[protocol.GetMiniblockHeaderRequest, protocol.GetMiniblockHeaderResponse]
+	getStreamSnapshot    *connect.Client[protocol.GetStreamSnapshotRequest, protocol.GetStreamSnapshotResponse]
+	searchEvents         *connect.Client[protocol.SearchEventsRequest, protocol.SearchEventsResponse]
 	addEvent             *connect.Client[protocol.AddEventRequest, protocol.AddEventResponse]
+	addEvents            *connect.Client[protocol.AddEventsRequest, protocol.AddEventsResponse]
 	addMediaEvent        *connect.Client[protocol.AddMediaEventRequest, protocol.AddMediaEventResponse]
 	syncStreams          *connect.Client[protocol.SyncStreamsRequest, protocol.SyncStreamsResponse]
 	addStreamToSync      *connect.Client[protocol.AddStreamToSyncRequest, protocol.AddStreamToSyncResponse]
@@ -289,11 +328,26 @@ func (c *streamServiceClient) GetMiniblockHeader(ctx context.Context, req *conne
 	return c.getMiniblockHeader.CallUnary(ctx, req)
 }
 
+// GetStreamSnapshot calls river.StreamService.GetStreamSnapshot.
+func (c *streamServiceClient) GetStreamSnapshot(ctx context.Context, req *connect.Request[protocol.GetStreamSnapshotRequest]) (*connect.Response[protocol.GetStreamSnapshotResponse], error) {
+	return c.getStreamSnapshot.CallUnary(ctx, req)
+}
+
+// SearchEvents calls river.StreamService.SearchEvents.
+func (c *streamServiceClient) SearchEvents(ctx context.Context, req *connect.Request[protocol.SearchEventsRequest]) (*connect.Response[protocol.SearchEventsResponse], error) {
+	return c.searchEvents.CallUnary(ctx, req)
+}
+
 // AddEvent calls river.StreamService.AddEvent.
 func (c *streamServiceClient) AddEvent(ctx context.Context, req *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error) {
 	return c.addEvent.CallUnary(ctx, req)
 }
 
+// AddEvents calls river.StreamService.AddEvents.
+func (c *streamServiceClient) AddEvents(ctx context.Context, req *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error) {
+	return c.addEvents.CallUnary(ctx, req)
+}
+
 // AddMediaEvent calls river.StreamService.AddMediaEvent.
 func (c *streamServiceClient) AddMediaEvent(ctx context.Context, req *connect.Request[protocol.AddMediaEventRequest]) (*connect.Response[protocol.AddMediaEventResponse], error) {
 	return c.addMediaEvent.CallUnary(ctx, req)
@@ -343,7 +397,14 @@ type StreamServiceHandler interface {
 	GetMiniblocks(context.Context, *connect.Request[protocol.GetMiniblocksRequest]) (*connect.Response[protocol.GetMiniblocksResponse], error)
 	GetLastMiniblockHash(context.Context, *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error)
 	GetMiniblockHeader(context.Context, *connect.Request[protocol.GetMiniblockHeaderRequest]) (*connect.Response[protocol.GetMiniblockHeaderResponse], error)
+	// GetStreamSnapshot returns the stream snapshot (members, channel metadata, pins, settings, etc.)
+	// as of the given miniblock.
+	GetStreamSnapshot(context.Context, *connect.Request[protocol.GetStreamSnapshotRequest]) (*connect.Response[protocol.GetStreamSnapshotResponse], error)
+	// SearchEvents searches the event index of the node. Requires a session token from the
+	// AuthenticationService of the node in the Authorization header.
+	SearchEvents(context.Context, *connect.Request[protocol.SearchEventsRequest]) (*connect.Response[protocol.SearchEventsResponse], error)
 	AddEvent(context.Context, *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error)
+	AddEvents(context.Context, *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error)
 	AddMediaEvent(context.Context, *connect.Request[protocol.AddMediaEventRequest]) (*connect.Response[protocol.AddMediaEventResponse], error)
 	SyncStreams(context.Context, *connect.Request[protocol.SyncStreamsRequest], *connect.ServerStream[protocol.SyncStreamsResponse]) error
 	AddStreamToSync(context.Context, *connect.Request[protocol.AddStreamToSyncRequest]) (*connect.Response[protocol.AddStreamToSyncResponse], error)
@@ -407,12 +468,30 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(streamServiceGetMiniblockHeaderMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	streamServiceGetStreamSnapshotHandler := connect.NewUnaryHandler(
+		StreamServiceGetStreamSnapshotProcedure,
+		svc.GetStreamSnapshot,
+		connect.WithSchema(streamServiceGetStreamSnapshotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	streamServiceSearchEventsHandler := connect.NewUnaryHandler(
+		StreamServiceSearchEventsProcedure,
+		svc.SearchEvents,
+		connect.WithSchema(streamServiceSearchEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	streamServiceAddEventHandler := connect.NewUnaryHandler(
 		StreamServiceAddEventProcedure,
 		svc.AddEvent,
 		connect.WithSchema(streamServiceAddEventMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	streamServiceAddEventsHandler := connect.NewUnaryHandler(
+		StreamServiceAddEventsProcedure,
+		svc.AddEvents,
+		connect.WithSchema(streamServiceAddEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	streamServiceAddMediaEventHandler := connect.NewUnaryHandler(
 		StreamServiceAddMediaEventProcedure,
 		svc.AddMediaEvent,
@@ -477,8 +556,14 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 			streamServiceGetLastMiniblockHashHandler.ServeHTTP(w, r)
 		case StreamServiceGetMiniblockHeaderProcedure:
 			streamServiceGetMiniblockHeaderHandler.ServeHTTP(w, r)
+		case StreamServiceGetStreamSnapshotProcedure:
+			streamServiceGetStreamSnapshotHandler.ServeHTTP(w, r)
+		case StreamServiceSearchEventsProcedure:
+			streamServiceSearchEventsHandler.ServeHTTP(w, r)
 		case StreamServiceAddEventProcedure:
 			streamServiceAddEventHandler.ServeHTTP(w, r)
+		case StreamServiceAddEventsProcedure:
+			streamServiceAddEventsHandler.ServeHTTP(w, r)
 		case StreamServiceAddMediaEventProcedure:
 			streamServiceAddMediaEventHandler.ServeHTTP(w, r)
 		case StreamServiceSyncStreamsProcedure:
@@ -532,10 +617,22 @@ func (UnimplementedStreamServiceHandler) GetMiniblockHeader(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetMiniblockHeader is not implemented"))
 }
 
+func (UnimplementedStreamServiceHandler) GetStreamSnapshot(context.Context, *connect.Request[protocol.GetStreamSnapshotRequest]) (*connect.Response[protocol.GetStreamSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetStreamSnapshot is not implemented"))
+}
+
+func (UnimplementedStreamServiceHandler) SearchEvents(context.Context, *connect.Request[protocol.SearchEventsRequest]) (*connect.Response[protocol.SearchEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.SearchEvents is not implemented"))
+}
+
 func (UnimplementedStreamServiceHandler) AddEvent(context.Context, *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.AddEvent is not implemented"))
 }
 
+func (UnimplementedStreamServiceHandler) AddEvents(context.Context, *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.AddEvents is not implemented"))
+}
+
 func (UnimplementedStreamServiceHandler) AddMediaEvent(context.Context, *connect.Request[protocol.AddMediaEventRequest]) (*connect.Response[protocol.AddMediaEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.AddMediaEvent is not implemented"))
 }
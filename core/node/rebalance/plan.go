@@ -0,0 +1,187 @@
+package rebalance
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/towns-protocol/towns/core/contracts/river"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/nodes"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/registries"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// Step is a single change of the stream placement.
+// Node is added to the placement before the departing node is removed,
+// so the number of replicas never drops below the target.
+type Step struct {
+	// Add is the node that is added to the stream placement. Add replicates the stream
+	// from the current placement before it is added.
+	Add *common.Address `json:"add,omitempty"`
+
+	// Remove is the node that is removed from the stream placement.
+	Remove *common.Address `json:"remove,omitempty"`
+
+	// Executor is the node that executes the step.
+	Executor common.Address `json:"executor"`
+}
+
+// Move is the list of steps that change the stream placement from current to target.
+type Move struct {
+	StreamId StreamId         `json:"streamId"`
+	Current  []common.Address `json:"current"`
+	Target   []common.Address `json:"target"`
+	Steps    []*Step          `json:"steps"`
+}
+
+// Plan is the list of stream moves required to reach the target placement for all streams.
+type Plan struct {
+	BlockNum   crypto.BlockNumber `json:"blockNum"`
+	NumStreams int                `json:"numStreams"`
+	Moves      []*Move            `json:"moves"`
+}
+
+// StepsFor returns the steps of the plan that should be executed by the given node.
+func (p *Plan) StepsFor(node common.Address) []*MoveStep {
+	var steps []*MoveStep
+	for _, move := range p.Moves {
+		for _, step := range move.Steps {
+			if step.Executor == node {
+				steps = append(steps, &MoveStep{StreamId: move.StreamId, Step: step})
+			}
+		}
+	}
+	return steps
+}
+
+// MoveStep is a step together with the stream it belongs to.
+type MoveStep struct {
+	StreamId StreamId
+	*Step
+}
+
+// OperationalNodes returns addresses of the nodes that can receive streams.
+func OperationalNodes(records []*nodes.NodeRecord) []common.Address {
+	var addrs []common.Address
+	for _, n := range records {
+		if n.Status() == river.NodeStatus_Operational {
+			addrs = append(addrs, n.Address())
+		}
+	}
+	return addrs
+}
+
+// PlanMove returns the move that changes the stream placement from current to target
+// or nil if the stream is already placed on the target nodes.
+func PlanMove(streamId StreamId, current []common.Address, target []common.Address) *Move {
+	var adds, removes, kept []common.Address
+	for _, n := range target {
+		if slices.Contains(current, n) {
+			kept = append(kept, n)
+		} else {
+			adds = append(adds, n)
+		}
+	}
+	for _, n := range current {
+		if !slices.Contains(target, n) {
+			removes = append(removes, n)
+		}
+	}
+	if len(adds) == 0 && len(removes) == 0 {
+		return nil
+	}
+
+	move := &Move{
+		StreamId: streamId,
+		Current:  current,
+		Target:   target,
+	}
+	for i := range max(len(adds), len(removes)) {
+		step := &Step{}
+		if i < len(adds) {
+			step.Add = &adds[i]
+			step.Executor = adds[i]
+		}
+		if i < len(removes) {
+			step.Remove = &removes[i]
+		}
+		if step.Add == nil {
+			// Removal only steps are executed by a node that stays in the placement.
+			if len(kept) > 0 {
+				step.Executor = kept[0]
+			} else {
+				step.Executor = target[0]
+			}
+		}
+		move.Steps = append(move.Steps, step)
+	}
+	return move
+}
+
+// TargetPlacement returns the nodes the stream should be placed on with the given strategy.
+func TargetPlacement(
+	strategy nodes.StreamPlacementStrategy,
+	streamId StreamId,
+	operational []common.Address,
+	replFactor int,
+) ([]common.Address, error) {
+	return strategy.ChooseStreamNodes(streamId, slices.Clone(operational), replFactor)
+}
+
+// PlanStrategy returns the placement strategy plans are computed with. Only the weighted strategy is
+// supported: hash placement reassigns nearly every stream when the set of nodes changes, so a plan
+// would migrate most streams.
+func PlanStrategy(cfg *crypto.OnChainSettings) (nodes.StreamPlacementStrategy, error) {
+	if cfg.StreamPlacement.Strategy != crypto.StreamPlacementStrategyWeighted {
+		return nil, RiverError(Err_FAILED_PRECONDITION, "Rebalancing requires the weighted placement strategy").
+			Tag("key", crypto.StreamPlacementStrategyConfigKey).
+			Tag("strategy", cfg.StreamPlacement.Strategy).
+			Func("PlanStrategy")
+	}
+	return nodes.NewStreamPlacementStrategy(cfg.StreamPlacement)
+}
+
+// ComputePlan computes moves for all streams registered at the given block, so all streams are
+// placed on the nodes chosen by the placement strategy from the on-chain configuration.
+// Plans are only computed for the weighted placement strategy, see PlanStrategy.
+func ComputePlan(
+	ctx context.Context,
+	registry *registries.RiverRegistryContract,
+	blockNum crypto.BlockNumber,
+	operational []common.Address,
+	cfg *crypto.OnChainSettings,
+) (*Plan, error) {
+	strategy, err := PlanStrategy(cfg)
+	if err != nil {
+		return nil, err
+	}
+	replFactor := int(cfg.ReplicationFactor)
+
+	plan := &Plan{BlockNum: blockNum}
+	var targetErr error
+	err = registry.ForAllStreams(ctx, blockNum, func(stream *registries.GetStreamResult) bool {
+		plan.NumStreams++
+
+		target, err := TargetPlacement(strategy, stream.StreamId, operational, replFactor)
+		if err != nil {
+			targetErr = err
+			return false
+		}
+		if move := PlanMove(stream.StreamId, stream.Nodes, target); move != nil {
+			plan.Moves = append(plan.Moves, move)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if targetErr != nil {
+		return nil, AsRiverError(targetErr).Func("ComputePlan")
+	}
+
+	return plan, nil
+}
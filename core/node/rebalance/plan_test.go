@@ -0,0 +1,107 @@
+package rebalance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/nodes"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func makeTestNodes(n int) []common.Address {
+	addrs := make([]common.Address, n)
+	for i := range addrs {
+		addrs[i] = common.BytesToAddress([]byte(fmt.Sprintf("node%d", i)))
+	}
+	return addrs
+}
+
+func TestPlanMove(t *testing.T) {
+	require := require.New(t)
+	n := makeTestNodes(5)
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	require.Nil(PlanMove(streamId, []common.Address{n[0], n[1]}, []common.Address{n[1], n[0]}))
+
+	// Replace node: new node adds itself and removes the departing node.
+	move := PlanMove(streamId, []common.Address{n[0], n[1], n[2]}, []common.Address{n[0], n[1], n[3]})
+	require.Len(move.Steps, 1)
+	require.Equal(n[3], *move.Steps[0].Add)
+	require.Equal(n[2], *move.Steps[0].Remove)
+	require.Equal(n[3], move.Steps[0].Executor)
+
+	// Replication factor is reduced: removal is executed by a node that stays.
+	move = PlanMove(streamId, []common.Address{n[0], n[1], n[2]}, []common.Address{n[1], n[2]})
+	require.Len(move.Steps, 1)
+	require.Nil(move.Steps[0].Add)
+	require.Equal(n[0], *move.Steps[0].Remove)
+	require.Equal(n[1], move.Steps[0].Executor)
+
+	// Replication factor is increased: new nodes only add themselves.
+	move = PlanMove(streamId, []common.Address{n[0]}, []common.Address{n[0], n[3], n[4]})
+	require.Len(move.Steps, 2)
+	for i, step := range move.Steps {
+		require.Nil(step.Remove)
+		require.Equal(*step.Add, step.Executor)
+		require.Equal(n[3+i], step.Executor)
+	}
+
+	plan := &Plan{Moves: []*Move{move}}
+	steps := plan.StepsFor(n[4])
+	require.Len(steps, 1)
+	require.Equal(streamId, steps[0].StreamId)
+	require.Empty(plan.StepsFor(n[0]))
+}
+
+func TestTargetPlacementMovesFewStreams(t *testing.T) {
+	require := require.New(t)
+	n := makeTestNodes(6)
+
+	strategy, err := nodes.NewStreamPlacementStrategy(crypto.StreamPlacementSettings{
+		Strategy: crypto.StreamPlacementStrategyWeighted,
+	})
+	require.NoError(err)
+
+	// Node joins: only streams that are placed on the new node are moved.
+	moved := 0
+	for range 1000 {
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		current, err := TargetPlacement(strategy, streamId, n[:5], 3)
+		require.NoError(err)
+		target, err := TargetPlacement(strategy, streamId, n, 3)
+		require.NoError(err)
+
+		move := PlanMove(streamId, current, target)
+		if move != nil {
+			moved++
+			require.Len(move.Steps, 1)
+			require.Equal(n[5], *move.Steps[0].Add)
+		}
+	}
+	// Expected share is 3/6 of streams.
+	require.InDelta(500, moved, 100)
+}
+
+func TestPlanStrategyRequiresWeightedPlacement(t *testing.T) {
+	require := require.New(t)
+
+	_, err := PlanStrategy(&crypto.OnChainSettings{})
+	require.Equal(Err_FAILED_PRECONDITION, AsRiverError(err).Code)
+
+	_, err = PlanStrategy(&crypto.OnChainSettings{
+		StreamPlacement: crypto.StreamPlacementSettings{Strategy: crypto.StreamPlacementStrategyHash},
+	})
+	require.Equal(Err_FAILED_PRECONDITION, AsRiverError(err).Code)
+
+	_, err = PlanStrategy(&crypto.OnChainSettings{
+		StreamPlacement: crypto.StreamPlacementSettings{Strategy: crypto.StreamPlacementStrategyWeighted},
+	})
+	require.NoError(err)
+}
@@ -0,0 +1,223 @@
+package rebalance
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/nodes"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/registries"
+)
+
+// StreamReplicator copies stream miniblocks from the current stream placement into local storage.
+type StreamReplicator interface {
+	ReplicateStream(ctx context.Context, record *registries.GetStreamResult) error
+}
+
+// Rebalancer moves streams to the placement chosen by the placement strategy when nodes join
+// or leave the registry.
+//
+// Each node executes only the plan steps assigned to it: the node that is added to a stream
+// replicates miniblocks from the current placement, adds itself to the stream placement and
+// then removes the departing node. Streams are moved one by one at the configured rate.
+type Rebalancer struct {
+	cfg          *config.StreamRebalancerConfig
+	localNode    common.Address
+	riverChain   *crypto.Blockchain
+	registry     *registries.RiverRegistryContract
+	chainConfig  crypto.OnChainConfiguration
+	nodeRegistry nodes.NodeRegistry
+	replicator   StreamReplicator
+
+	trigger chan struct{}
+}
+
+func NewRebalancer(
+	cfg *config.StreamRebalancerConfig,
+	localNode common.Address,
+	riverChain *crypto.Blockchain,
+	registry *registries.RiverRegistryContract,
+	chainConfig crypto.OnChainConfiguration,
+	nodeRegistry nodes.NodeRegistry,
+	replicator StreamReplicator,
+) *Rebalancer {
+	return &Rebalancer{
+		cfg:          cfg,
+		localNode:    localNode,
+		riverChain:   riverChain,
+		registry:     registry,
+		chainConfig:  chainConfig,
+		nodeRegistry: nodeRegistry,
+		replicator:   replicator,
+		trigger:      make(chan struct{}, 1),
+	}
+}
+
+// Start subscribes to node registry changes and runs the rebalancer in the background
+// until the context is cancelled. Rebalancing is run once on start.
+func (r *Rebalancer) Start(ctx context.Context, fromBlock crypto.BlockNumber) {
+	onNodeChange := func(context.Context, types.Log) { r.Trigger() }
+	for _, event := range []string{"NodeAdded", "NodeRemoved", "NodeStatusUpdated"} {
+		r.riverChain.ChainMonitor.OnContractWithTopicsEvent(
+			fromBlock,
+			r.registry.Address,
+			[][]common.Hash{{r.registry.NodeRegistryAbi.Events[event].ID}},
+			onNodeChange,
+		)
+	}
+
+	r.Trigger()
+	go r.run(ctx)
+}
+
+// Trigger schedules a rebalancing run.
+func (r *Rebalancer) Trigger() {
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Rebalancer) run(ctx context.Context) {
+	log := logging.FromCtx(ctx).With("func", "Rebalancer")
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.trigger:
+		}
+
+		// Wait for the node registry to settle, changes in the meantime are handled by this run.
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.Delay):
+		}
+		select {
+		case <-r.trigger:
+		default:
+		}
+
+		if err := r.rebalance(ctx); err != nil {
+			log.Errorw("Stream rebalancing failed", "err", err)
+		}
+	}
+}
+
+func (r *Rebalancer) operationalNodes() []common.Address {
+	return OperationalNodes(r.nodeRegistry.GetAllNodes())
+}
+
+func (r *Rebalancer) rebalance(ctx context.Context) error {
+	log := logging.FromCtx(ctx)
+
+	blockNum, err := r.riverChain.GetBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	plan, err := ComputePlan(ctx, r.registry, blockNum, r.operationalNodes(), r.chainConfig.Get())
+	if err != nil {
+		return err
+	}
+	steps := plan.StepsFor(r.localNode)
+
+	log.Infow("Stream rebalancing plan",
+		"blockNum", plan.BlockNum,
+		"numStreams", plan.NumStreams,
+		"numMoves", len(plan.Moves),
+		"localSteps", len(steps),
+		"dryRun", r.cfg.DryRun,
+	)
+	if r.cfg.DryRun {
+		for _, step := range steps {
+			log.Infow("Stream rebalancing step (dry run)",
+				"streamId", step.StreamId, "add", step.Add, "remove", step.Remove)
+		}
+		return nil
+	}
+
+	throttle := time.NewTicker(time.Minute / time.Duration(r.cfg.GetMovesPerMinute()))
+	defer throttle.Stop()
+
+	for i, step := range steps {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-throttle.C:
+			}
+		}
+
+		if err := r.executeStep(ctx, step); err != nil {
+			log.Errorw("Failed to move stream", "streamId", step.StreamId, "add", step.Add, "remove", step.Remove, "err", err)
+		}
+	}
+	return nil
+}
+
+// executeStep applies the step to the current stream placement. Since the plan is computed in advance,
+// the stream record and the target placement are read again and the step is skipped if it is no
+// longer needed.
+func (r *Rebalancer) executeStep(ctx context.Context, step *MoveStep) error {
+	log := logging.FromCtx(ctx)
+
+	blockNum, err := r.riverChain.GetBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	record, err := r.registry.GetStream(ctx, step.StreamId, blockNum)
+	if err != nil {
+		return err
+	}
+
+	cfg := r.chainConfig.Get()
+	strategy, err := PlanStrategy(cfg)
+	if err != nil {
+		return err
+	}
+	target, err := TargetPlacement(strategy, step.StreamId, r.operationalNodes(), int(cfg.ReplicationFactor))
+	if err != nil {
+		return err
+	}
+
+	placement := record.Nodes
+	if step.Add != nil && slices.Contains(target, *step.Add) && !slices.Contains(placement, *step.Add) {
+		if *step.Add != r.localNode {
+			return RiverError(Err_INTERNAL, "Stream can only be added to the local node").
+				Tags("streamId", step.StreamId, "node", *step.Add).
+				Func("executeStep")
+		}
+
+		if err := r.replicator.ReplicateStream(ctx, record); err != nil {
+			return err
+		}
+		if err := r.registry.PlaceStreamOnNode(ctx, step.StreamId, r.localNode); err != nil {
+			return err
+		}
+		placement = append(slices.Clone(placement), r.localNode)
+		log.Infow("Stream placed on local node", "streamId", step.StreamId, "nodes", placement)
+	}
+
+	// Node is removed only when the stream still has at least as many replicas as the target placement.
+	if step.Remove != nil &&
+		slices.Contains(placement, *step.Remove) &&
+		!slices.Contains(target, *step.Remove) &&
+		len(placement) > len(target) {
+		if err := r.registry.RemoveStreamFromNode(ctx, step.StreamId, *step.Remove); err != nil {
+			return err
+		}
+		log.Infow("Stream removed from node", "streamId", step.StreamId, "node", *step.Remove)
+	}
+
+	return nil
+}
@@ -311,6 +311,92 @@ func (c *RiverRegistryContract) AddStream(
 	return RiverError(Err_ERR_UNSPECIFIED, "AddStream transaction result unknown")
 }
 
+// PlaceStreamOnNode adds the given node to the stream placement.
+// Node should have the stream replicated before it is added to the placement.
+func (c *RiverRegistryContract) PlaceStreamOnNode(
+	ctx context.Context,
+	streamId StreamId,
+	nodeAddress common.Address,
+) error {
+	return c.updateStreamPlacement(
+		ctx,
+		"PlaceStreamOnNode",
+		streamId,
+		nodeAddress,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.StreamRegistry.PlaceStreamOnNode(opts, streamId, nodeAddress)
+		},
+	)
+}
+
+// RemoveStreamFromNode removes the given node from the stream placement.
+func (c *RiverRegistryContract) RemoveStreamFromNode(
+	ctx context.Context,
+	streamId StreamId,
+	nodeAddress common.Address,
+) error {
+	return c.updateStreamPlacement(
+		ctx,
+		"RemoveStreamFromNode",
+		streamId,
+		nodeAddress,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.StreamRegistry.RemoveStreamFromNode(opts, streamId, nodeAddress)
+		},
+	)
+}
+
+func (c *RiverRegistryContract) updateStreamPlacement(
+	ctx context.Context,
+	name string,
+	streamId StreamId,
+	nodeAddress common.Address,
+	createTx func(opts *bind.TransactOpts) (*types.Transaction, error),
+) error {
+	log := logging.FromCtx(ctx)
+
+	pendingTx, err := c.Blockchain.TxPool.Submit(
+		ctx,
+		name,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			tx, err := createTx(opts)
+			if err == nil {
+				log.Debugw(
+					"RiverRegistryContract: prepared transaction",
+					"name", name,
+					"streamId", streamId,
+					"nodeAddress", nodeAddress,
+					"txHash", tx.Hash(),
+				)
+			}
+			return tx, err
+		},
+	)
+	if err != nil {
+		return AsRiverError(err, Err_CANNOT_CALL_CONTRACT).
+			Func(name).
+			Message("Smart contract call failed")
+	}
+
+	receipt, err := pendingTx.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	if receipt != nil && receipt.Status == crypto.TransactionResultSuccess {
+		return nil
+	}
+	if receipt != nil && receipt.Status != crypto.TransactionResultSuccess {
+		return RiverError(Err_ERR_UNSPECIFIED, "Update stream placement transaction failed").
+			Tag("tx", receipt.TxHash.Hex()).
+			Tag("streamId", streamId).
+			Tag("nodeAddress", nodeAddress).
+			Func(name)
+	}
+
+	return RiverError(Err_ERR_UNSPECIFIED, "Update stream placement transaction result unknown").Func(name)
+}
+
 type GetStreamResult struct {
 	StreamId          StreamId
 	Nodes             []common.Address
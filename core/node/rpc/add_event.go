@@ -31,6 +31,53 @@ func (s *Service) localAddEvent(
 
 	log.Debugw("localAddEvent", "parsedEvent", parsedEvent)
 
+	var resp *AddEventResponse
+	if req.Msg.IdempotencyKey != "" {
+		resp, err = s.addEventOutcomes.run(
+			ctx,
+			addEventIdempotencyKey{
+				streamId: streamId,
+				creator:  common.BytesToAddress(parsedEvent.Event.CreatorAddress),
+				key:      req.Msg.IdempotencyKey,
+			},
+			localStreamHasEvent(localStream),
+			&EventRef{
+				StreamId:  streamId[:],
+				Hash:      parsedEvent.Hash[:],
+				Signature: parsedEvent.Envelope.Signature,
+			},
+			func() (*AddEventResponse, error) {
+				return s.localAddParsedEvent(ctx, req.Msg, streamId, parsedEvent, localStream, streamView)
+			},
+		)
+	} else {
+		resp, err = s.localAddParsedEvent(ctx, req.Msg, streamId, parsedEvent, localStream, streamView)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// localStreamHasEvent returns a lookup of events in the minipool and recent miniblocks of the local stream.
+func localStreamHasEvent(stream *Stream) eventLookup {
+	return func(ctx context.Context, hash common.Hash) (bool, error) {
+		view, err := stream.GetViewIfLocal(ctx)
+		if err != nil {
+			return false, err
+		}
+		return view != nil && view.HasEvent(hash), nil
+	}
+}
+
+func (s *Service) localAddParsedEvent(
+	ctx context.Context,
+	req *AddEventRequest,
+	streamId StreamId,
+	parsedEvent *ParsedEvent,
+	localStream *Stream,
+	streamView *StreamView,
+) (*AddEventResponse, error) {
 	newEvents, err := s.addParsedEvent(ctx, streamId, parsedEvent, localStream, streamView)
 
 	if err != nil {
@@ -40,24 +87,16 @@ func (s *Service) localAddEvent(
 		)
 	}
 
-	if err != nil && req.Msg.Optional {
+	if err != nil && req.Optional {
 		// aellis 5/2024 - we only want to wrap errors from canAddEvent,
 		// currently this is catching all errors, which is not ideal
-		riverError := AsRiverError(err)
-		return connect.NewResponse(&AddEventResponse{
-			Error: &AddEventResponse_Error{
-				Code:  riverError.Code,
-				Msg:   riverError.Error(),
-				Funcs: riverError.Funcs,
-			},
-			NewEvents: newEvents,
-		}), nil
+		return addEventErrorResponse(err, newEvents), nil
 	} else if err != nil {
 		return nil, err
 	} else {
-		return connect.NewResponse(&AddEventResponse{
+		return &AddEventResponse{
 			NewEvents: newEvents,
-		}), nil
+		}, nil
 	}
 }
 
@@ -69,6 +108,11 @@ func (s *Service) addParsedEvent(
 	streamView *StreamView,
 ) ([]*EventRef, error) {
 	// TODO: here it should loop and re-check the rules if view was updated in the meantime.
+	appPermissions, err := s.appPermissions.ForCreator(ctx, common.BytesToAddress(parsedEvent.Event.CreatorAddress))
+	if err != nil {
+		return nil, AsRiverError(err).Func("addParsedEvent")
+	}
+
 	canAddEvent, verifications, sideEffects, err := rules.CanAddEvent(
 		ctx,
 		*s.config,
@@ -77,6 +121,7 @@ func (s *Service) addParsedEvent(
 		time.Now(),
 		parsedEvent,
 		streamView,
+		appPermissions,
 	)
 
 	if !canAddEvent || err != nil {
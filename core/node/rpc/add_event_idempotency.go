@@ -0,0 +1,182 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru/arc/v2"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+type addEventIdempotencyKey struct {
+	streamId StreamId
+	creator  common.Address
+	key      string
+}
+
+// addEventOutcome is the outcome of the first AddEvent request with an idempotency key.
+// resp and err are set when done is closed.
+type addEventOutcome struct {
+	created time.Time
+	done    chan struct{}
+	event   *EventRef
+	resp    *AddEventResponse
+	err     error
+}
+
+// addEventOutcomes retains the outcomes of AddEvent requests with an idempotency key, so that retries
+// return the original outcome instead of adding the event again.
+//
+// Outcomes are kept in memory by the node that handled the request. Retries that reach another node,
+// or this node after a restart, are deduplicated by the event hash against the minipool and recent
+// miniblocks of the stream, which all replicas have. Such retries must resend the same envelope.
+type addEventOutcomes struct {
+	mu       sync.Mutex
+	outcomes *lru.ARCCache[addEventIdempotencyKey, *addEventOutcome]
+	ttl      time.Duration
+}
+
+func newAddEventOutcomes(cfg *config.AddEventIdempotencyConfig) (*addEventOutcomes, error) {
+	outcomes, err := lru.NewARC[addEventIdempotencyKey, *addEventOutcome](cfg.GetCacheSize())
+	if err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).Func("newAddEventOutcomes")
+	}
+	return &addEventOutcomes{
+		outcomes: outcomes,
+		ttl:      cfg.GetTTL(),
+	}, nil
+}
+
+// get returns the retained outcome for the key, expired outcomes are removed.
+// Callers must hold o.mu.
+func (o *addEventOutcomes) get(key addEventIdempotencyKey) (*addEventOutcome, bool) {
+	outcome, ok := o.outcomes.Get(key)
+	if ok && time.Since(outcome.created) > o.ttl {
+		o.outcomes.Remove(key)
+		return nil, false
+	}
+	return outcome, ok
+}
+
+// isFinalAddEventError returns true if the error is the outcome of the request. Other errors, like timeouts
+// and replication failures, leave it unknown whether the event was added.
+func isFinalAddEventError(code Err) bool {
+	switch code {
+	case Err_UNKNOWN,
+		Err_INTERNAL,
+		Err_CANCELED,
+		Err_DEADLINE_EXCEEDED,
+		Err_UNAVAILABLE,
+		Err_RESOURCE_EXHAUSTED,
+		Err_ABORTED,
+		Err_DB_OPERATION_FAILURE,
+		Err_QUORUM_FAILED,
+		Err_MINIBLOCK_TOO_NEW,
+		Err_BAD_PREV_MINIBLOCK_HASH,
+		Err_CANNOT_CONNECT,
+		Err_CANNOT_CHECK_ENTITLEMENTS,
+		Err_CANNOT_CALL_CONTRACT,
+		Err_DOWNSTREAM_NETWORK_ERROR:
+		return false
+	default:
+		return true
+	}
+}
+
+func (o *addEventOutcome) isFinal() bool {
+	if o.err != nil {
+		return isFinalAddEventError(AsRiverError(o.err).Code)
+	}
+	if o.resp.GetError() != nil {
+		return isFinalAddEventError(o.resp.GetError().GetCode())
+	}
+	return true
+}
+
+// eventLookup returns true if the stream already has the event with the given hash.
+type eventLookup func(ctx context.Context, hash common.Hash) (bool, error)
+
+// run calls add unless a request with the same idempotency key was made before. In that case the outcome
+// of the earlier request is returned. If the earlier request failed with an error that leaves it unknown
+// if the event was added, the stream is checked for the earlier event, and add is called if it is not found.
+// Requests without a retained outcome are checked against the stream before add is called.
+func (o *addEventOutcomes) run(
+	ctx context.Context,
+	key addEventIdempotencyKey,
+	hasEvent eventLookup,
+	event *EventRef,
+	add func() (*AddEventResponse, error),
+) (*AddEventResponse, error) {
+	for {
+		o.mu.Lock()
+		outcome, ok := o.get(key)
+		if !ok {
+			outcome = &addEventOutcome{created: time.Now(), done: make(chan struct{}), event: event}
+			o.outcomes.Add(key, outcome)
+			o.mu.Unlock()
+
+			outcome.resp, outcome.err = o.addOnce(ctx, hasEvent, event, add)
+			close(outcome.done)
+			return outcome.resp, outcome.err
+		}
+		o.mu.Unlock()
+
+		select {
+		case <-outcome.done:
+		case <-ctx.Done():
+			return nil, AsRiverError(ctx.Err()).Func("addEventOutcomes.run")
+		}
+
+		if outcome.isFinal() {
+			return outcome.resp, outcome.err
+		}
+
+		added, err := hasEvent(ctx, common.BytesToHash(outcome.event.Hash))
+		if err != nil {
+			return nil, err
+		}
+		if added {
+			return &AddEventResponse{NewEvents: []*EventRef{outcome.event}}, nil
+		}
+
+		// The earlier request didn't add the event, forget it and try again with this request.
+		o.mu.Lock()
+		if current, ok := o.outcomes.Peek(key); ok && current == outcome {
+			o.outcomes.Remove(key)
+		}
+		o.mu.Unlock()
+	}
+}
+
+// addOnce calls add unless the stream already has the event. The event is found if an earlier request
+// with the same envelope was handled by another node, or is still being added by it.
+func (o *addEventOutcomes) addOnce(
+	ctx context.Context,
+	hasEvent eventLookup,
+	event *EventRef,
+	add func() (*AddEventResponse, error),
+) (*AddEventResponse, error) {
+	hash := common.BytesToHash(event.Hash)
+	added, err := hasEvent(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		return &AddEventResponse{NewEvents: []*EventRef{event}}, nil
+	}
+
+	resp, err := add()
+	if IsRiverErrorCode(err, Err_DUPLICATE_EVENT) || resp.GetError().GetCode() == Err_DUPLICATE_EVENT {
+		// The event was added concurrently by an earlier request.
+		if added, hasErr := hasEvent(ctx, hash); hasErr == nil && added {
+			return &AddEventResponse{NewEvents: []*EventRef{event}}, nil
+		}
+	}
+	return resp, err
+}
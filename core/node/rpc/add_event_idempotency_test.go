@@ -0,0 +1,169 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+// noEvents is an event lookup of a stream that doesn't have any events.
+func noEvents(context.Context, common.Hash) (bool, error) {
+	return false, nil
+}
+
+func TestAddEventOutcomes(t *testing.T) {
+	ctx := context.Background()
+	outcomes, err := newAddEventOutcomes(&config.AddEventIdempotencyConfig{})
+	require.NoError(t, err)
+	key := addEventIdempotencyKey{
+		streamId: testutils.FakeStreamId(STREAM_CHANNEL_BIN),
+		creator:  common.HexToAddress("0x1"),
+		key:      "key",
+	}
+	event := &EventRef{Hash: common.HexToHash("0x2").Bytes()}
+
+	var calls atomic.Int32
+	add := func() (*AddEventResponse, error) {
+		calls.Add(1)
+		return &AddEventResponse{NewEvents: []*EventRef{event}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := outcomes.run(ctx, key, noEvents, event, add)
+			require.NoError(t, err)
+			require.Equal(t, event, resp.NewEvents[0])
+		}()
+	}
+	wg.Wait()
+	require.EqualValues(t, 1, calls.Load())
+
+	// Validation errors are the outcome of the request and are returned to retries.
+	otherKey := key
+	otherKey.key = "other"
+	_, err = outcomes.run(ctx, otherKey, noEvents, event, func() (*AddEventResponse, error) {
+		return nil, RiverError(Err_PERMISSION_DENIED, "denied")
+	})
+	require.True(t, IsRiverErrorCode(err, Err_PERMISSION_DENIED))
+	_, err = outcomes.run(ctx, otherKey, noEvents, event, add)
+	require.True(t, IsRiverErrorCode(err, Err_PERMISSION_DENIED))
+	require.EqualValues(t, 1, calls.Load())
+
+	// Keys are scoped to the creator.
+	otherCreator := key
+	otherCreator.creator = common.HexToAddress("0x3")
+	_, err = outcomes.run(ctx, otherCreator, noEvents, event, add)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, calls.Load())
+}
+
+func TestAddEventOutcomesInFlightRetry(t *testing.T) {
+	ctx := context.Background()
+	outcomes, err := newAddEventOutcomes(&config.AddEventIdempotencyConfig{})
+	require.NoError(t, err)
+	key := addEventIdempotencyKey{
+		streamId: testutils.FakeStreamId(STREAM_CHANNEL_BIN),
+		creator:  common.HexToAddress("0x1"),
+		key:      "key",
+	}
+	event := &EventRef{Hash: common.HexToHash("0x2").Bytes()}
+
+	// The first request adds the event but fails with an error that leaves the outcome unknown.
+	var added atomic.Bool
+	hasEvent := func(context.Context, common.Hash) (bool, error) {
+		return added.Load(), nil
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+	first := make(chan error, 1)
+	go func() {
+		_, err := outcomes.run(ctx, key, hasEvent, event, func() (*AddEventResponse, error) {
+			calls.Add(1)
+			close(started)
+			<-release
+			added.Store(true)
+			return nil, RiverError(Err_UNAVAILABLE, "replication failed")
+		})
+		first <- err
+	}()
+	<-started
+
+	retry := make(chan *AddEventResponse, 1)
+	go func() {
+		resp, err := outcomes.run(ctx, key, hasEvent, event, func() (*AddEventResponse, error) {
+			calls.Add(1)
+			return nil, RiverError(Err_INTERNAL, "retry must not add the event again")
+		})
+		require.NoError(t, err)
+		retry <- resp
+	}()
+
+	// The retry waits for the first request.
+	select {
+	case <-retry:
+		t.Fatal("retry returned before the first request finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+
+	require.True(t, IsRiverErrorCode(<-first, Err_UNAVAILABLE))
+	resp := <-retry
+	require.Equal(t, event, resp.NewEvents[0])
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestAddEventOutcomesCacheMiss(t *testing.T) {
+	ctx := context.Background()
+	key := addEventIdempotencyKey{
+		streamId: testutils.FakeStreamId(STREAM_CHANNEL_BIN),
+		creator:  common.HexToAddress("0x1"),
+		key:      "key",
+	}
+	event := &EventRef{Hash: common.HexToHash("0x2").Bytes()}
+
+	// The retry reaches a node that didn't handle the first request, the event is found in the stream.
+	outcomes, err := newAddEventOutcomes(&config.AddEventIdempotencyConfig{})
+	require.NoError(t, err)
+	hasEvent := func(_ context.Context, hash common.Hash) (bool, error) {
+		return hash == common.BytesToHash(event.Hash), nil
+	}
+	resp, err := outcomes.run(ctx, key, hasEvent, event, func() (*AddEventResponse, error) {
+		t.Fatal("event must not be added again")
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, event, resp.NewEvents[0])
+
+	// The first request is still adding the event on another node, the retry loses the race.
+	outcomes, err = newAddEventOutcomes(&config.AddEventIdempotencyConfig{})
+	require.NoError(t, err)
+	var added atomic.Bool
+	resp, err = outcomes.run(
+		ctx,
+		key,
+		func(context.Context, common.Hash) (bool, error) { return added.Load(), nil },
+		event,
+		func() (*AddEventResponse, error) {
+			added.Store(true)
+			return addEventErrorResponse(RiverError(Err_DUPLICATE_EVENT, "duplicate event"), nil), nil
+		},
+	)
+	require.NoError(t, err)
+	require.Nil(t, resp.GetError())
+	require.Equal(t, event, resp.NewEvents[0])
+}
@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/utils"
+)
+
+// maxAddEventsBatchSize is the maximum number of events in a single AddEvents request.
+const maxAddEventsBatchSize = 100
+
+func (s *Service) AddEvents(
+	ctx context.Context,
+	req *connect.Request[AddEventsRequest],
+) (*connect.Response[AddEventsResponse], error) {
+	ctx, cancel := utils.UncancelContext(ctx, 10*time.Second, 20*time.Second)
+	defer cancel()
+	return executeConnectHandler(ctx, req, s, s.addEventsImpl, "AddEvents")
+}
+
+func (s *Service) addEventsImpl(
+	ctx context.Context,
+	req *connect.Request[AddEventsRequest],
+) (*connect.Response[AddEventsResponse], error) {
+	if len(req.Msg.Events) == 0 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "No events in request").Func("addEventsImpl")
+	}
+	if len(req.Msg.Events) > maxAddEventsBatchSize {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Too many events in request",
+			"numEvents", len(req.Msg.Events), "maxEvents", maxAddEventsBatchSize).Func("addEventsImpl")
+	}
+
+	// Events are added one by one, a failed event doesn't prevent later events from being added.
+	results := make([]*AddEventResponse, len(req.Msg.Events))
+	for i, event := range req.Msg.Events {
+		resp, err := s.addEventImpl(ctx, connect.NewRequest(&AddEventRequest{
+			StreamId:       event.StreamId,
+			Event:          event.Event,
+			Optional:       true,
+			IdempotencyKey: event.IdempotencyKey,
+		}))
+		if err != nil {
+			results[i] = addEventErrorResponse(err, nil)
+		} else {
+			results[i] = resp.Msg
+		}
+	}
+
+	return connect.NewResponse(&AddEventsResponse{Results: results}), nil
+}
+
+func addEventErrorResponse(err error, newEvents []*EventRef) *AddEventResponse {
+	riverError := AsRiverError(err)
+	return &AddEventResponse{
+		Error: &AddEventResponse_Error{
+			Code:  riverError.Code,
+			Msg:   riverError.Error(),
+			Funcs: riverError.Funcs,
+		},
+		NewEvents: newEvents,
+	}
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+func (tc *testClient) makeMessageRequest(channelId StreamId, message string) *AddEventRequest {
+	envelope, err := MakeEnvelopeWithPayload(
+		tc.wallet,
+		Make_ChannelPayload_Message(message),
+		tc.getLastMiniblockHash(channelId),
+	)
+	tc.require.NoError(err)
+	return &AddEventRequest{StreamId: channelId[:], Event: envelope}
+}
+
+func TestAddEvents(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	require := tt.require
+
+	alice := tt.newTestClient(0)
+	bob := tt.newTestClient(0)
+	_ = alice.createUserStream()
+	_ = bob.createUserStream()
+	spaceId, _ := alice.createSpace()
+	channel1, _ := alice.createChannel(spaceId)
+	channel2, _ := alice.createChannel(spaceId)
+
+	// Bob is not a member of the channels, so his messages are rejected.
+	resp, err := alice.client.AddEvents(tt.ctx, connect.NewRequest(&AddEventsRequest{
+		Events: []*AddEventRequest{
+			alice.makeMessageRequest(channel1, "hello 1"),
+			bob.makeMessageRequest(channel2, "bob 1"),
+			alice.makeMessageRequest(channel2, "hello 2"),
+		},
+	}))
+	require.NoError(err)
+	require.Len(resp.Msg.Results, 3)
+	require.Nil(resp.Msg.Results[0].Error)
+	require.Len(resp.Msg.Results[0].NewEvents, 1)
+	require.Equal(Err_PERMISSION_DENIED, resp.Msg.Results[1].Error.GetCode())
+	require.Nil(resp.Msg.Results[2].Error)
+
+	alice.listen(channel1, []common.Address{alice.userId}, [][]string{{"hello 1"}})
+	alice.listen(channel2, []common.Address{alice.userId}, [][]string{{"hello 2"}})
+}
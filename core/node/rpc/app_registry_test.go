@@ -479,3 +479,238 @@ func TestAppRegistry_Register(t *testing.T) {
 		})
 	}
 }
+
+func TestAppRegistry_DeliversChannelMessagesToWebhook(t *testing.T) {
+	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	service, _ := initAppRegistryService(tester.ctx, tester)
+	require := tester.require
+
+	httpClient, _ := testcert.GetHttp2LocalhostTLSClient(tester.ctx, tester.getConfig())
+	serviceAddr := "https://" + service.listener.Addr().String()
+	authClient := protocolconnect.NewAuthenticationServiceClient(httpClient, serviceAddr)
+	appRegistryClient := protocolconnect.NewAppRegistryServiceClient(httpClient, serviceAddr)
+
+	appWallet := safeNewWallet(tester.ctx, require)
+	ownerWallet := safeNewWallet(tester.ctx, require)
+
+	registerReq := &connect.Request[protocol.RegisterRequest]{
+		Msg: &protocol.RegisterRequest{
+			AppId:      appWallet.Address[:],
+			AppOwnerId: ownerWallet.Address[:],
+		},
+	}
+	authenticateBS(tester.ctx, require, authClient, ownerWallet, registerReq)
+	registerResp, err := appRegistryClient.Register(tester.ctx, registerReq)
+	require.NoError(err)
+
+	appServer := app_registry.NewTestAppServer(t, appWallet, registerResp.Msg.GetHs256SharedSecret())
+	defer appServer.Close()
+	go func() {
+		if err := appServer.Serve(tester.ctx); err != nil {
+			t.Errorf("Error starting app service: %v", err)
+		}
+	}()
+
+	webhookReq := &connect.Request[protocol.RegisterWebhookRequest]{
+		Msg: &protocol.RegisterWebhookRequest{
+			AppId:      appWallet.Address[:],
+			WebhookUrl: appServer.Url(),
+		},
+	}
+	authenticateBS(tester.ctx, require, authClient, appWallet, webhookReq)
+	_, err = appRegistryClient.RegisterWebhook(tester.ctx, webhookReq)
+	require.NoError(err)
+
+	client := tester.testClient(0)
+	_, _, err = createUser(tester.ctx, ownerWallet, client, nil)
+	require.NoError(err)
+	_, _, err = createUserMetadataStream(tester.ctx, ownerWallet, client, nil)
+	require.NoError(err)
+
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	_, _, err = createSpace(tester.ctx, ownerWallet, client, spaceId, nil)
+	require.NoError(err)
+
+	channelId := testutils.MakeChannelId(spaceId)
+	channel, _, err := createChannel(tester.ctx, ownerWallet, client, spaceId, channelId, nil)
+	require.NoError(err)
+
+	createUserAndAddToChannel(require, tester.ctx, client, appWallet, spaceId, channelId)
+
+	var hashes []common.Hash
+	for _, text := range []string{"first", "second", "third"} {
+		event, err := events.MakeEnvelopeWithPayload(
+			ownerWallet,
+			events.Make_ChannelPayload_Message(text),
+			&MiniblockRef{
+				Num:  channel.GetMinipoolGen() - 1,
+				Hash: common.Hash(channel.GetPrevMiniblockHash()),
+			},
+		)
+		require.NoError(err)
+		hashes = append(hashes, common.BytesToHash(event.Hash))
+
+		_, err = client.AddEvent(tester.ctx, connect.NewRequest(&protocol.AddEventRequest{
+			StreamId: channelId[:],
+			Event:    event,
+		}))
+		require.NoError(err)
+	}
+
+	// messages are delivered in the order they were added to the channel
+	require.EventuallyWithT(func(c *assert.CollectT) {
+		delivered := appServer.Events()
+		if !assert.Len(c, delivered, len(hashes)) {
+			return
+		}
+		for i, event := range delivered {
+			assert.Equal(c, channelId.String(), event.StreamId)
+			assert.Equal(c, spaceId.String(), event.SpaceId)
+			assert.Equal(c, hex.EncodeToString(hashes[i][:]), event.EventHash)
+			assert.NotEmpty(c, event.Envelope)
+		}
+	}, 20*time.Second, 100*time.Millisecond, "Messages were not delivered to the app webhook")
+
+	logReq := &connect.Request[protocol.GetDeliveryLogRequest]{
+		Msg: &protocol.GetDeliveryLogRequest{AppId: appWallet.Address[:]},
+	}
+	authenticateBS(tester.ctx, require, authClient, ownerWallet, logReq)
+	logResp, err := appRegistryClient.GetDeliveryLog(tester.ctx, logReq)
+	require.NoError(err)
+	require.Len(logResp.Msg.Attempts, len(hashes))
+	for i, attempt := range logResp.Msg.Attempts {
+		require.Equal(hashes[len(hashes)-1-i][:], attempt.EventHash)
+		require.Equal(channelId[:], attempt.StreamId)
+		require.Equal(protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED, attempt.Status)
+		require.Equal(int32(1), attempt.Attempt)
+	}
+
+	// other users can't read the delivery log
+	otherWallet := safeNewWallet(tester.ctx, require)
+	logReq = &connect.Request[protocol.GetDeliveryLogRequest]{
+		Msg: &protocol.GetDeliveryLogRequest{AppId: appWallet.Address[:]},
+	}
+	authenticateBS(tester.ctx, require, authClient, otherWallet, logReq)
+	_, err = appRegistryClient.GetDeliveryLog(tester.ctx, logReq)
+	require.ErrorContains(err, "authenticated user must be either app or owner")
+}
+
+func TestAppRegistry_ManageApp(t *testing.T) {
+	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	service, _ := initAppRegistryService(tester.ctx, tester)
+	require := tester.require
+
+	httpClient, _ := testcert.GetHttp2LocalhostTLSClient(tester.ctx, tester.getConfig())
+	serviceAddr := "https://" + service.listener.Addr().String()
+	authClient := protocolconnect.NewAuthenticationServiceClient(httpClient, serviceAddr)
+	appRegistryClient := protocolconnect.NewAppRegistryServiceClient(httpClient, serviceAddr)
+
+	appWallet := safeNewWallet(tester.ctx, require)
+	ownerWallet := safeNewWallet(tester.ctx, require)
+
+	registerReq := &connect.Request[protocol.RegisterRequest]{
+		Msg: &protocol.RegisterRequest{
+			AppId:      appWallet.Address[:],
+			AppOwnerId: ownerWallet.Address[:],
+		},
+	}
+	authenticateBS(tester.ctx, require, authClient, ownerWallet, registerReq)
+	registerResp, err := appRegistryClient.Register(tester.ctx, registerReq)
+	require.NoError(err)
+
+	getInfo := func(wallet *crypto.Wallet) (*protocol.GetInfoResponse, error) {
+		req := &connect.Request[protocol.GetInfoRequest]{
+			Msg: &protocol.GetInfoRequest{AppId: appWallet.Address[:]},
+		}
+		authenticateBS(tester.ctx, require, authClient, wallet, req)
+		resp, err := appRegistryClient.GetInfo(tester.ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	info, err := getInfo(ownerWallet)
+	require.NoError(err)
+	require.Equal(appWallet.Address[:], info.AppId)
+	require.Equal(ownerWallet.Address[:], info.AppOwnerId)
+	require.Empty(info.WebhookUrl)
+	require.False(info.Disabled)
+	require.Nil(info.PreviousSecretExpiresAt)
+
+	// only the owner can manage the app
+	_, err = getInfo(appWallet)
+	require.ErrorContains(err, "authenticated user must be app owner")
+
+	rotateReq := &connect.Request[protocol.RotateSecretRequest]{
+		Msg: &protocol.RotateSecretRequest{AppId: appWallet.Address[:]},
+	}
+	authenticateBS(tester.ctx, require, authClient, appWallet, rotateReq)
+	_, err = appRegistryClient.RotateSecret(tester.ctx, rotateReq)
+	require.ErrorContains(err, "authenticated user must be app owner")
+
+	rotateReq = &connect.Request[protocol.RotateSecretRequest]{
+		Msg: &protocol.RotateSecretRequest{AppId: appWallet.Address[:]},
+	}
+	authenticateBS(tester.ctx, require, authClient, ownerWallet, rotateReq)
+	rotateResp, err := appRegistryClient.RotateSecret(tester.ctx, rotateReq)
+	require.NoError(err)
+	require.Len(rotateResp.Msg.Hs256SharedSecret, 32)
+	require.NotEqual(registerResp.Msg.Hs256SharedSecret, rotateResp.Msg.Hs256SharedSecret)
+	require.True(rotateResp.Msg.PreviousSecretExpiresAt.AsTime().After(time.Now()))
+
+	// during the grace period app services with either the previous or the new secret accept requests
+	for _, appServer := range []*app_registry.TestAppServer{
+		app_registry.NewTestAppServerWithPreviousSecret(t, appWallet, registerResp.Msg.Hs256SharedSecret),
+		app_registry.NewTestAppServer(t, appWallet, rotateResp.Msg.Hs256SharedSecret),
+	} {
+		defer appServer.Close()
+		go func() {
+			if err := appServer.Serve(tester.ctx); err != nil {
+				t.Errorf("Error starting app service: %v", err)
+			}
+		}()
+
+		updateReq := &connect.Request[protocol.UpdateWebhookRequest]{
+			Msg: &protocol.UpdateWebhookRequest{
+				AppId:      appWallet.Address[:],
+				WebhookUrl: appServer.Url(),
+			},
+		}
+		authenticateBS(tester.ctx, require, authClient, ownerWallet, updateReq)
+		_, err = appRegistryClient.UpdateWebhook(tester.ctx, updateReq)
+		require.NoError(err)
+
+		info, err = getInfo(ownerWallet)
+		require.NoError(err)
+		require.Equal(appServer.Url(), info.WebhookUrl)
+		require.NotNil(info.PreviousSecretExpiresAt)
+	}
+
+	disableReq := &connect.Request[protocol.DisableAppRequest]{
+		Msg: &protocol.DisableAppRequest{AppId: appWallet.Address[:], Disabled: true},
+	}
+	authenticateBS(tester.ctx, require, authClient, ownerWallet, disableReq)
+	_, err = appRegistryClient.DisableApp(tester.ctx, disableReq)
+	require.NoError(err)
+
+	info, err = getInfo(ownerWallet)
+	require.NoError(err)
+	require.True(info.Disabled)
+
+	deleteReq := &connect.Request[protocol.DeleteAppRequest]{
+		Msg: &protocol.DeleteAppRequest{AppId: appWallet.Address[:]},
+	}
+	authenticateBS(tester.ctx, require, authClient, ownerWallet, deleteReq)
+	_, err = appRegistryClient.DeleteApp(tester.ctx, deleteReq)
+	require.NoError(err)
+
+	status, err := appRegistryClient.GetStatus(tester.ctx, connect.NewRequest(&protocol.GetStatusRequest{
+		AppId: appWallet.Address[:],
+	}))
+	require.NoError(err)
+	require.False(status.Msg.IsRegistered)
+
+	_, err = getInfo(ownerWallet)
+	require.ErrorContains(err, "app does not exist")
+}
@@ -297,6 +297,9 @@ type ArchiveStream struct {
 
 	corrupt StreamCorruptionTracker
 
+	// trimmedReplicas is the number of replicas in a row that trimmed the next miniblocks to archive.
+	trimmedReplicas int
+
 	// Mutex is used so only one archive operation is performed at a time.
 	// It also protects non-atomic fields that track stream corruption state.
 	mu sync.Mutex
@@ -328,6 +331,9 @@ type Archiver struct {
 	nodeRegistry nodes.NodeRegistry
 	storage      storage.StreamStorage
 
+	// coldTier is set if miniblocks are offloaded into segment files after archiving.
+	coldTier storage.ColdTierStorage
+
 	// Miniblock scrubbing
 	scrubber scrub.MiniblockScrubber
 	reports  chan *scrub.MiniblockScrubReport
@@ -348,9 +354,11 @@ type Archiver struct {
 	streamsExamined            atomic.Uint64
 	streamsCreated             atomic.Uint64
 	streamsUpToDate            atomic.Uint64
+	streamsTrimmed             atomic.Uint64
 	successOpsCount            atomic.Uint64
 	failedOpsCount             atomic.Uint64
 	miniblocksProcessed        atomic.Uint64
+	miniblocksOffloaded        atomic.Uint64
 	newStreamAllocated         atomic.Uint64
 	streamPlacementUpdated     atomic.Uint64
 	streamLastMiniblockUpdated atomic.Uint64
@@ -367,9 +375,11 @@ type ArchiverStats struct {
 	StreamsExamined            uint64
 	StreamsCreated             uint64
 	StreamsUpToDate            uint64
+	StreamsTrimmed             uint64
 	SuccessOpsCount            uint64
 	FailedOpsCount             uint64
 	MiniblocksProcessed        uint64
+	MiniblocksOffloaded        uint64
 	NewStreamAllocated         uint64
 	StreamPlacementUpdated     uint64
 	StreamLastMiniblockUpdated uint64
@@ -381,17 +391,20 @@ func NewArchiver(
 	config *config.ArchiveConfig,
 	contract *registries.RiverRegistryContract,
 	nodeRegistry nodes.NodeRegistry,
-	storage storage.StreamStorage,
+	store storage.StreamStorage,
 ) *Archiver {
 	reports := make(chan *scrub.MiniblockScrubReport, 50)
 	a := &Archiver{
 		config:       config,
 		contract:     contract,
 		nodeRegistry: nodeRegistry,
-		storage:      storage,
+		storage:      store,
 		tasks:        make(chan StreamId, config.GetTaskQueueSize()),
 		reports:      reports,
 	}
+	if config.ColdTier.Enabled() {
+		a.coldTier, _ = store.(storage.ColdTierStorage)
+	}
 	a.startedWG.Add(1)
 	return a
 }
@@ -439,6 +452,13 @@ func (a *Archiver) setupStatisticsMetrics(factory infra.MetricsFactory) {
 		},
 		func() float64 { return float64(a.miniblocksProcessed.Load()) },
 	)
+	factory.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "stats_miniblocks_offloaded",
+			Help: "Total miniblocks offloaded into segment files since the last boot",
+		},
+		func() float64 { return float64(a.miniblocksOffloaded.Load()) },
+	)
 	factory.NewGaugeFunc(
 		prometheus.GaugeOpts{
 			Name: "stats_new_stream_allocated",
@@ -550,6 +570,37 @@ func (a *Archiver) addNewStream(
 	a.streamsExamined.Add(1)
 }
 
+// onMiniblocksTrimmed handles a replica that removed the next miniblocks to archive by the stream history
+// retention policy. The miniblocks are requested from the other replicas. Once all replicas trimmed them the
+// archive can't catch up on its own: the stream update is recorded as failed, it is retried at a low frequency
+// and needs operator attention, e.g. restoring the miniblocks from another archive.
+func (a *Archiver) onMiniblocksTrimmed(
+	ctx context.Context,
+	stream *ArchiveStream,
+	nodeAddr common.Address,
+	fromInclusive int64,
+	err error,
+) error {
+	stream.trimmedReplicas++
+	if stream.trimmedReplicas < len(stream.nodes.GetNodes()) {
+		stream.nodes.AdvanceStickyPeer(nodeAddr)
+		time.AfterFunc(time.Second, func() {
+			a.tasks <- stream.streamId
+		})
+		return nil
+	}
+
+	stream.trimmedReplicas = 0
+	a.streamsTrimmed.Add(1)
+	err = AsRiverError(err).
+		Message("Miniblocks trimmed on all replicas before they were archived").
+		Tags("streamId", stream.streamId, "fromInclusive", fromInclusive).
+		Func("Archiver.ArchiveStream")
+	stream.corrupt.RecordBlockUpdateFailure(ctx, err)
+	a.advanceNodeAndRetryWithDelay(stream, time.Minute)
+	return err
+}
+
 func (a *Archiver) advanceNodeAndRetryWithDelay(stream *ArchiveStream, delay time.Duration) {
 	nodeAddr := stream.nodes.GetStickyPeer()
 	if a.nodeAdvances != nil {
@@ -642,11 +693,16 @@ func (a *Archiver) ArchiveStream(ctx context.Context, stream *ArchiveStream) (er
 		resp, err := stub.GetMiniblocks(
 			ctx,
 			connect.NewRequest(&GetMiniblocksRequest{
-				StreamId:      stream.streamId[:],
-				FromInclusive: mbsInDb,
-				ToExclusive:   toBlock,
+				StreamId:           stream.streamId[:],
+				FromInclusive:      mbsInDb,
+				ToExclusive:        toBlock,
+				ArchivedMiniblocks: mbsInDb,
 			}),
 		)
+		if IsRiverErrorCode(err, Err_MINIBLOCKS_TRIMMED) {
+			return a.onMiniblocksTrimmed(ctx, stream, nodeAddr, mbsInDb, err)
+		}
+
 		if err != nil && AsRiverError(err).Code != Err_NOT_FOUND {
 			log.Warnw(
 				"Error when calling GetMiniblocks on server",
@@ -721,6 +777,7 @@ func (a *Archiver) ArchiveStream(ctx context.Context, stream *ArchiveStream) (er
 		}
 		mbsInDb += int64(len(serialized))
 		stream.numBlocksInDb.Store(mbsInDb)
+		stream.trimmedReplicas = 0
 
 		a.miniblocksProcessed.Add(uint64(len(serialized)))
 	}
@@ -732,6 +789,19 @@ func (a *Archiver) ArchiveStream(ctx context.Context, stream *ArchiveStream) (er
 		a.successfulDownloads.With(prometheus.Labels{"node_address": nodeAddr.String()}).Inc()
 	}
 
+	// replicas only trim miniblocks that were reported as archived with GetMiniblocks,
+	// read from the next replica on the next update so that all replicas learn the archived miniblocks
+	stream.nodes.AdvanceStickyPeer(nodeAddr)
+
+	if a.coldTier != nil {
+		offloaded, err := a.coldTier.OffloadMiniblocks(ctx, stream.streamId, a.config.ColdTier.GetSegmentSize())
+		a.miniblocksOffloaded.Add(uint64(offloaded))
+		if err != nil {
+			// Miniblocks are archived, offloading is retried on the next update of the stream.
+			log.Warnw("Failed to offload miniblocks", "streamId", stream.streamId, "error", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1016,9 +1086,11 @@ func (a *Archiver) GetStats() *ArchiverStats {
 		StreamsExamined:            a.streamsExamined.Load(),
 		StreamsCreated:             a.streamsCreated.Load(),
 		StreamsUpToDate:            a.streamsUpToDate.Load(),
+		StreamsTrimmed:             a.streamsTrimmed.Load(),
 		SuccessOpsCount:            a.successOpsCount.Load(),
 		FailedOpsCount:             a.failedOpsCount.Load(),
 		MiniblocksProcessed:        a.miniblocksProcessed.Load(),
+		MiniblocksOffloaded:        a.miniblocksOffloaded.Load(),
 		NewStreamAllocated:         a.newStreamAllocated.Load(),
 		StreamPlacementUpdated:     a.streamPlacementUpdated.Load(),
 		StreamLastMiniblockUpdated: a.streamLastMiniblockUpdated.Load(),
@@ -17,6 +17,7 @@ import (
 	"github.com/towns-protocol/towns/core/node/crypto"
 	. "github.com/towns-protocol/towns/core/node/events"
 	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/notifications"
 	"github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/rpc/render"
 	"github.com/towns-protocol/towns/core/node/scrub"
@@ -71,7 +72,7 @@ func (s *Service) registerDebugHandlers(enableDebugEndpoints bool, cfg config.De
 	handler.HandleFunc(mux, "/debug/multi/json", s.handleDebugMultiJson)
 	handler.Handle(mux, "/debug/config", &onChainConfigHandler{onChainConfig: s.chainConfig})
 
-	if cfg.EnableStorageEndpoint || enableDebugEndpoints {
+	if (cfg.EnableStorageEndpoint || enableDebugEndpoints) && s.storagePoolInfo != nil {
 		handler.HandleFunc(mux, "/debug/storage", s.handleDebugStorage)
 	}
 
@@ -106,6 +107,30 @@ func (s *Service) registerDebugHandlers(enableDebugEndpoints bool, cfg config.De
 	if s.mode == ServerModeArchive && (cfg.CorruptStreams || enableDebugEndpoints) {
 		handler.Handle(mux, "/debug/corrupt_streams", &corruptStreamsHandler{service: s.Archiver})
 	}
+	if s.notificationOutbox != nil && (cfg.NotificationOutbox || enableDebugEndpoints) {
+		handler.Handle(mux, "/debug/notifications/outbox", &notificationOutboxHandler{outbox: s.notificationOutbox})
+	}
+}
+
+type notificationOutboxHandler struct {
+	outbox *notifications.Outbox
+}
+
+func (h *notificationOutboxHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status, err := h.outbox.Status(ctx)
+	if err != nil {
+		logging.FromCtx(ctx).Errorw("unable to get notification outbox status", "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(status)
 }
 
 type corruptStreamsHandler struct {
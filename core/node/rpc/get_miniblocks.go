@@ -15,6 +15,10 @@ func (s *Service) localGetMiniblocks(
 	req *connect.Request[GetMiniblocksRequest],
 	stream *Stream,
 ) (*connect.Response[GetMiniblocksResponse], error) {
+	if req.Msg.ArchivedMiniblocks > 0 {
+		stream.ReportArchived(req.Msg.ArchivedMiniblocks)
+	}
+
 	toExclusive := req.Msg.ToExclusive
 
 	if toExclusive <= req.Msg.FromInclusive {
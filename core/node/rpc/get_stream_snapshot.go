@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	. "github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+func (s *Service) GetStreamSnapshot(
+	ctx context.Context,
+	req *connect.Request[GetStreamSnapshotRequest],
+) (*connect.Response[GetStreamSnapshotResponse], error) {
+	return executeConnectHandler(ctx, req, s, s.getStreamSnapshotImpl, "GetStreamSnapshot")
+}
+
+func (s *Service) getStreamSnapshotImpl(
+	ctx context.Context,
+	req *connect.Request[GetStreamSnapshotRequest],
+) (*connect.Response[GetStreamSnapshotResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := s.cache.GetStreamNoWait(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if stream.IsLocal() {
+		return s.localGetStreamSnapshot(ctx, req, stream)
+	}
+
+	return peerNodeRequestWithRetries(
+		ctx,
+		stream,
+		s,
+		func(ctx context.Context, stub StreamServiceClient) (*connect.Response[GetStreamSnapshotResponse], error) {
+			ret, err := stub.GetStreamSnapshot(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(ret.Msg), nil
+		},
+		-1,
+	)
+}
+
+func (s *Service) localGetStreamSnapshot(
+	ctx context.Context,
+	req *connect.Request[GetStreamSnapshotRequest],
+	stream *Stream,
+) (*connect.Response[GetStreamSnapshotResponse], error) {
+	snapshot, miniblock, snapshotMiniblockNum, err := stream.GetSnapshotAt(ctx, req.Msg.MiniblockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&GetStreamSnapshotResponse{
+		Snapshot:             snapshot,
+		MiniblockNum:         miniblock.Ref.Num,
+		MiniblockHash:        miniblock.Ref.Hash[:],
+		SnapshotMiniblockNum: snapshotMiniblockNum,
+	}), nil
+}
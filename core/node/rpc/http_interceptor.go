@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+)
+
+// httpInterceptor applies the equivalent of the connect interceptors, tracing, metrics, the default request timeout
+// and logging, to rpcs that are served over plain http such as the WebSocket and SSE sync sessions.
+type httpInterceptor struct {
+	// tracer is used to trace the rpcs, tracing is disabled if nil
+	tracer         trace.Tracer
+	metrics        *metricsInterceptor
+	defaultTimeout time.Duration
+}
+
+// streamingHttpHandlerFunc serves a server stream over plain http. It returns the error that stopped the stream.
+type streamingHttpHandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+func (s *Service) newHttpInterceptor() *httpInterceptor {
+	return &httpInterceptor{
+		tracer:         s.otelTracer,
+		metrics:        s.newMetricsInterceptor(),
+		defaultTimeout: s.config.Network.RequestTimeout,
+	}
+}
+
+// wrapStreamingHandler returns a handler that serves the server stream of the given procedure with next.
+// Server streams are not subject to the default request timeout.
+func (i *httpInterceptor) wrapStreamingHandler(procedure string, next streamingHttpHandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := propagation.TraceContext{}.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, end := i.startSpan(ctx, procedure)
+
+		err := i.metrics.observeStream(procedure, func() error {
+			return next(w, r.WithContext(ctx))
+		})
+		end(err)
+
+		if err != nil {
+			logging.FromCtx(ctx).Debugw("Streaming rpc stopped", "procedure", procedure, "error", err)
+		}
+	})
+}
+
+// callUnary runs the unary call to the given procedure that is made outside a connect handler.
+func (i *httpInterceptor) callUnary(
+	ctx context.Context,
+	procedure string,
+	call func(ctx context.Context) error,
+) error {
+	ctx, end := i.startSpan(ctx, procedure)
+
+	ctx, cancel := withDefaultTimeout(ctx, i.defaultTimeout)
+	defer cancel()
+
+	err := i.metrics.observeUnary(procedure, func() error {
+		return call(ctx)
+	})
+	end(err)
+
+	if err != nil {
+		logging.FromCtx(ctx).Debugw("Unary rpc failed", "procedure", procedure, "error", err)
+	}
+	return err
+}
+
+// startSpan starts the server span of the given procedure. The returned function ends the span with the result
+// of the rpc.
+func (i *httpInterceptor) startSpan(ctx context.Context, procedure string) (context.Context, func(error)) {
+	if i.tracer == nil {
+		return ctx, func(error) {}
+	}
+
+	ctx, span := i.tracer.Start(ctx, procedure, trace.WithSpanKind(trace.SpanKindServer))
+	return ctx, func(err error) {
+		if err != nil {
+			span.SetStatus(codes.Error, AsRiverError(err).AsConnectError().Error())
+		}
+		span.End()
+	}
+}
@@ -29,6 +29,10 @@ type metricsInterceptor struct {
 }
 
 func (s *Service) NewMetricsInterceptor() connect.Interceptor {
+	return s.newMetricsInterceptor()
+}
+
+func (s *Service) newMetricsInterceptor() *metricsInterceptor {
 	return &metricsInterceptor{
 		rpcDuration: s.metrics.NewHistogramVecEx(
 			"rpc_duration_seconds",
@@ -67,12 +71,6 @@ func (i *metricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc
 		ctx context.Context,
 		req connect.AnyRequest,
 	) (connect.AnyResponse, error) {
-		var (
-			proc = req.Spec().Procedure
-			m    = i.unaryInflight.With(prometheus.Labels{"method": proc})
-			s, _ = i.unaryStatusCode.CurryWith(prometheus.Labels{"method": proc})
-		)
-
 		// add streamId to tracing span
 		r, ok := req.Any().(streamIdProvider)
 		if ok {
@@ -83,18 +81,34 @@ func (i *metricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc
 			}
 		}
 
-		m.Inc()
-		timer := prometheus.NewTimer(i.rpcDuration.WithLabelValues(proc))
-		resp, err := next(ctx, req)
-		timer.ObserveDuration()
-		m.Dec()
-
-		s.With(prometheus.Labels{"status": errorToStatus(err)}).Inc()
-
+		var resp connect.AnyResponse
+		err := i.observeUnary(req.Spec().Procedure, func() error {
+			var err error
+			resp, err = next(ctx, req)
+			return err
+		})
 		return resp, err
 	}
 }
 
+// observeUnary records the metrics of the unary call to the given procedure.
+func (i *metricsInterceptor) observeUnary(proc string, call func() error) error {
+	var (
+		m    = i.unaryInflight.With(prometheus.Labels{"method": proc})
+		s, _ = i.unaryStatusCode.CurryWith(prometheus.Labels{"method": proc})
+	)
+
+	m.Inc()
+	timer := prometheus.NewTimer(i.rpcDuration.WithLabelValues(proc))
+	err := call()
+	timer.ObserveDuration()
+	m.Dec()
+
+	s.With(prometheus.Labels{"status": errorToStatus(err)}).Inc()
+
+	return err
+}
+
 func (i *metricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
 	return func(
 		ctx context.Context,
@@ -114,21 +128,27 @@ func (i *metricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerF
 		ctx context.Context,
 		conn connect.StreamingHandlerConn,
 	) error {
-		var (
-			proc = conn.Spec().Procedure
-			m    = i.openClientStreams.With(prometheus.Labels{"method": proc})
-			s, _ = i.serverStreamsStatusCode.CurryWith(prometheus.Labels{"method": proc})
-		)
+		return i.observeStream(conn.Spec().Procedure, func() error {
+			return next(ctx, conn)
+		})
+	}
+}
 
-		m.Inc()
-		defer m.Dec()
+// observeStream records the metrics of the server stream of the given procedure.
+func (i *metricsInterceptor) observeStream(proc string, serve func() error) error {
+	var (
+		m    = i.openClientStreams.With(prometheus.Labels{"method": proc})
+		s, _ = i.serverStreamsStatusCode.CurryWith(prometheus.Labels{"method": proc})
+	)
 
-		err := next(ctx, conn)
+	m.Inc()
+	defer m.Dec()
 
-		s.With(prometheus.Labels{"status": errorToStatus(err)}).Inc()
+	err := serve()
 
-		return err
-	}
+	s.With(prometheus.Labels{"status": errorToStatus(err)}).Inc()
+
+	return err
 }
 
 func errorToStatus(err error) string {
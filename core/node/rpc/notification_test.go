@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"math/big"
 	"net/http"
 	"sync"
@@ -59,6 +60,7 @@ func TestNotifications(t *testing.T) {
 	notifications := &notificationCapture{
 		WebPushNotifications: make(map[common.Hash]map[common.Address]int),
 		ApnPushNotifications: make(map[common.Hash]map[common.Address]int),
+		FcmPushNotifications: make(map[common.Hash]map[common.Address]int),
 	}
 
 	notificationService := initNotificationService(ctx, tester, notifications)
@@ -113,6 +115,51 @@ func testGDMNotifications(
 		test := setupGDMNotificationTest(ctx, tester, notificationClient, authClient)
 		testGDMAPNNotificationAfterUnsubscribe(ctx, test, notifications)
 	})
+
+	tester.parallelSubtest("FCMNotification", func(tester *serviceTester) {
+		ctx := tester.ctx
+		test := setupGDMNotificationTest(ctx, tester, notificationClient, authClient)
+		testGDMFCMNotification(ctx, test, notifications)
+	})
+}
+
+func testGDMFCMNotification(
+	ctx context.Context,
+	test *gdmChannelNotificationsTestContext,
+	nc *notificationCapture,
+) {
+	userA := test.members[6]
+	userB := test.members[7]
+
+	test.subscribeFcmPush(ctx, userA)
+
+	getSettingsRequest := connect.NewRequest(&GetSettingsRequest{})
+	authenticateNS(ctx, test.req, test.authClient, userA, getSettingsRequest)
+	resp, err := test.notificationClient.GetSettings(ctx, getSettingsRequest)
+	test.req.NoError(err, "GetSettings failed")
+	test.req.Len(resp.Msg.GetFcmSubscriptions(), 1, "got no FCM subs")
+	test.req.Equal(userA.Address.Hex(), resp.Msg.GetFcmSubscriptions()[0].GetToken())
+	test.req.Equal(
+		NotificationPushVersion_NOTIFICATION_PUSH_VERSION_1,
+		resp.Msg.GetFcmSubscriptions()[0].GetPushVersion(),
+	)
+
+	expectedUsersToReceiveNotification := map[common.Address]int{userA.Address: 1}
+	event := test.sendMessageWithTags(ctx, userB, "hi!", &Tags{})
+	eventHash := common.BytesToHash(event.Hash)
+
+	test.req.Eventuallyf(func() bool {
+		nc.FcmPushNotificationsMu.Lock()
+		defer nc.FcmPushNotificationsMu.Unlock()
+
+		return cmp.Equal(nc.FcmPushNotifications[eventHash], expectedUsersToReceiveNotification)
+	}, notificationDeliveryDelay, 100*time.Millisecond, "Didn't receive expected FCM notification")
+
+	test.unsubscribeFcmPush(ctx, userA)
+
+	resp, err = test.notificationClient.GetSettings(ctx, getSettingsRequest)
+	test.req.NoError(err, "GetSettings failed")
+	test.req.Empty(resp.Msg.GetFcmSubscriptions(), "got FCM subs")
 }
 
 func testGDMAPNNotificationAfterUnsubscribe(
@@ -387,6 +434,47 @@ func testDMNotifications(
 		test := setupDMNotificationTest(ctx, tester, notificationClient, authClient)
 		testDMMessageWithBlockedUser(ctx, test, notifications)
 	})
+
+	tester.sequentialSubtest("UnreadCounts", func(tester *serviceTester) {
+		ctx := tester.ctx
+		test := setupDMNotificationTest(ctx, tester, notificationClient, authClient)
+		testDMUnreadCounts(ctx, test)
+	})
+}
+
+// testDMUnreadCounts tests that unread counts are incremented for new messages and reset
+// when the user marks the channel as read.
+func testDMUnreadCounts(
+	ctx context.Context,
+	test *dmChannelNotificationsTestContext,
+) {
+	test.subscribeWebPush(ctx, test.member)
+
+	test.sendMessageWithTags(ctx, test.initiator, "hi!", &Tags{})
+	test.sendMessageWithTags(ctx, test.initiator, "hi again!", &Tags{
+		MentionedUserAddresses: [][]byte{test.member.Address[:]},
+	})
+
+	getUnreadCounts := func() *GetUnreadCountsResponse {
+		request := connect.NewRequest(&GetUnreadCountsRequest{})
+		authenticateNS(ctx, test.req, test.authClient, test.member, request)
+		resp, err := test.notificationClient.GetUnreadCounts(ctx, request)
+		test.req.NoError(err, "GetUnreadCounts failed")
+		return resp.Msg
+	}
+
+	test.req.Eventually(func() bool {
+		counts := getUnreadCounts()
+		return counts.GetTotalUnread() == 2 && counts.GetTotalMentions() == 1 &&
+			len(counts.GetChannels()) == 1 &&
+			bytes.Equal(counts.GetChannels()[0].GetChannelId(), test.dmStreamID[:])
+	}, notificationDeliveryDelay, 100*time.Millisecond, "Unexpected unread counts")
+
+	test.markRead(ctx, test.member, test.MemberUserSettingsStreamID, test.dmStreamID)
+
+	test.req.Eventually(func() bool {
+		return len(getUnreadCounts().GetChannels()) == 0
+	}, notificationDeliveryDelay, 100*time.Millisecond, "Unread counts not reset")
 }
 
 func testDMMessageWithNotificationsMutedOnDmChannel(
@@ -571,6 +659,12 @@ func testSpaceChannelNotifications(
 		testSpaceChannelMentionTag(ctx, test, notifications)
 	})
 
+	tester.sequentialSubtest("TestDigest", func(tester *serviceTester) {
+		ctx := tester.ctx
+		test := setupSpaceChannelNotificationTest(ctx, tester, notificationClient, authClient)
+		testSpaceChannelDigest(ctx, test, notifications)
+	})
+
 	tester.sequentialSubtest("Settings", func(tester *serviceTester) {
 		ctx := tester.ctx
 		test := setupSpaceChannelNotificationTest(ctx, tester, notificationClient, authClient)
@@ -647,6 +741,49 @@ func testSpaceChannelPlainMessage(
 	}, time.Second, 100*time.Millisecond, "Received unexpected notifications")
 }
 
+// testSpaceChannelDigest tests that plain messages in a channel in digest mode are collapsed into
+// a single notification for the latest message and that mentions are sent immediately.
+func testSpaceChannelDigest(
+	ctx context.Context,
+	test *spaceChannelNotificationsTestContext,
+	nc *notificationCapture,
+) {
+	for _, wallet := range test.members {
+		test.setSpaceChannelSetting(ctx, wallet, SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_NO_MESSAGES)
+		test.subscribeWebPush(ctx, wallet)
+	}
+
+	digestUser := test.members[1]
+	test.setSpaceChannelSetting(ctx, digestUser, SpaceChannelSettingValue_SPACE_CHANNEL_SETTING_DIGEST)
+
+	received := func(eventHash common.Hash) int {
+		nc.WebPushNotificationsMu.Lock()
+		defer nc.WebPushNotificationsMu.Unlock()
+		return nc.WebPushNotifications[eventHash][digestUser.Address]
+	}
+
+	var digested []common.Hash
+	for _, msg := range []string{"one", "two", "three"} {
+		event := test.sendMessageWithTags(ctx, test.members[0], msg, &Tags{})
+		digested = append(digested, common.BytesToHash(event.Hash))
+	}
+
+	// mentions are not collapsed
+	mention := test.sendMessageWithTags(ctx, test.members[0], "hi!", &Tags{
+		MentionedUserAddresses: [][]byte{digestUser.Address[:]},
+	})
+	mentionHash := common.BytesToHash(mention.Hash)
+
+	test.req.Eventuallyf(func() bool {
+		return received(mentionHash) == 1 && received(digested[len(digested)-1]) == 1
+	}, notificationDeliveryDelay, 100*time.Millisecond, "Didn't receive digest notification")
+
+	// only a single notification is sent for the digested messages
+	test.req.Never(func() bool {
+		return received(digested[0]) != 0 || received(digested[1]) != 0 || received(mentionHash) != 1
+	}, time.Second, 100*time.Millisecond, "Received unexpected notifications")
+}
+
 func testSpaceChannelAtChannelTag(
 	ctx context.Context,
 	test *spaceChannelNotificationsTestContext,
@@ -819,6 +956,7 @@ func initNotificationService(
 	cfg := tester.getConfig()
 	cfg.Notifications.Authentication.SessionToken.Key.Algorithm = "HS256"
 	cfg.Notifications.Authentication.SessionToken.Key.Key = hex.EncodeToString(key[:])
+	cfg.Notifications.Digest.Window = 2 * time.Second
 
 	service, err := StartServerInNotificationMode(ctx, cfg, notifier, makeTestServerOpts(tester))
 	tester.require.NoError(err)
@@ -1270,6 +1408,34 @@ func (tc *gdmChannelNotificationsTestContext) subscribeApnPush(
 	tc.req.NoError(err, "SubscribeAPN failed")
 }
 
+func (tc *gdmChannelNotificationsTestContext) subscribeFcmPush(
+	ctx context.Context,
+	user *crypto.Wallet,
+) {
+	request := connect.NewRequest(&SubscribeFCMRequest{
+		Token: user.Address.Hex(), // (ab)used to determine who received a notification
+	})
+
+	authenticateNS(ctx, tc.req, tc.authClient, user, request)
+	_, err := tc.notificationClient.SubscribeFCM(ctx, request)
+
+	tc.req.NoError(err, "SubscribeFCM failed")
+}
+
+func (tc *gdmChannelNotificationsTestContext) unsubscribeFcmPush(
+	ctx context.Context,
+	user *crypto.Wallet,
+) {
+	request := connect.NewRequest(&UnsubscribeFCMRequest{
+		Token: user.Address.Hex(),
+	})
+
+	authenticateNS(ctx, tc.req, tc.authClient, user, request)
+	_, err := tc.notificationClient.UnsubscribeFCM(ctx, request)
+
+	tc.req.NoError(err, "UnsubscribeFCM failed")
+}
+
 func (tc *gdmChannelNotificationsTestContext) unsubscribeApnPush(
 	ctx context.Context,
 	user *crypto.Wallet,
@@ -1318,6 +1484,51 @@ func (tc *dmChannelNotificationsTestContext) sendMessageWithTags(
 	return event
 }
 
+// markRead adds a fully read marker for the given channel to the users settings stream.
+func (tc *dmChannelNotificationsTestContext) markRead(
+	ctx context.Context,
+	user *crypto.Wallet,
+	userSettingsStreamID StreamId,
+	channelID StreamId,
+) {
+	resp, err := tc.streamClient.GetLastMiniblockHash(ctx, connect.NewRequest(
+		&GetLastMiniblockHashRequest{
+			StreamId: userSettingsStreamID[:],
+		}))
+	tc.req.NoError(err)
+
+	markers, err := json.Marshal(map[string]any{
+		"markers": map[string]any{
+			channelID.String(): map[string]any{
+				"channelId": channelID.String(),
+				"eventNum":  "2",
+				"isUnread":  false,
+			},
+		},
+	})
+	tc.req.NoError(err)
+
+	event, err := events.MakeEnvelopeWithPayload(
+		user,
+		events.Make_UserSettingsPayload_FullyReadMarkers(&UserSettingsPayload_FullyReadMarkers{
+			StreamId: channelID[:],
+			Content:  &UserSettingsPayload_MarkerContent{Data: string(markers)},
+		}),
+		&MiniblockRef{
+			Hash: common.BytesToHash(resp.Msg.GetHash()),
+			Num:  resp.Msg.GetMiniblockNum(),
+		},
+	)
+	tc.req.NoError(err)
+
+	_, err = tc.streamClient.AddEvent(ctx, connect.NewRequest(&AddEventRequest{
+		StreamId: userSettingsStreamID[:],
+		Event:    event,
+		Optional: false,
+	}))
+	tc.req.NoError(err)
+}
+
 func (tc *dmChannelNotificationsTestContext) blockUser(
 	ctx context.Context,
 	streamID StreamId,
@@ -1564,6 +1775,8 @@ type notificationCapture struct {
 	WebPushNotifications   map[common.Hash]map[common.Address]int // event hash -> key=endpoint:count
 	ApnPushNotificationsMu sync.Mutex
 	ApnPushNotifications   map[common.Hash]map[common.Address]int // event hash -> key=device_token:count
+	FcmPushNotificationsMu sync.Mutex
+	FcmPushNotifications   map[common.Hash]map[common.Address]int // event hash -> key=token:count
 }
 
 func (nc *notificationCapture) SendWebPushNotification(
@@ -1571,7 +1784,8 @@ func (nc *notificationCapture) SendWebPushNotification(
 	subscription *webpush.Subscription,
 	eventHash common.Hash,
 	_ []byte,
-) (bool, error) {
+	_ string,
+) (bool, int, error) {
 	nc.WebPushNotificationsMu.Lock()
 	defer nc.WebPushNotificationsMu.Unlock()
 
@@ -1584,7 +1798,7 @@ func (nc *notificationCapture) SendWebPushNotification(
 	events[common.HexToAddress(subscription.Endpoint)]++
 	nc.WebPushNotifications[eventHash] = events
 
-	return false, nil
+	return false, http.StatusCreated, nil
 }
 
 func (nc *notificationCapture) SendApplePushNotification(
@@ -1593,6 +1807,7 @@ func (nc *notificationCapture) SendApplePushNotification(
 	eventHash common.Hash,
 	_ *payload2.Payload,
 	_ bool,
+	_ string,
 ) (bool, int, error) {
 	nc.ApnPushNotificationsMu.Lock()
 	defer nc.ApnPushNotificationsMu.Unlock()
@@ -1609,6 +1824,28 @@ func (nc *notificationCapture) SendApplePushNotification(
 	return false, http.StatusOK, nil
 }
 
+func (nc *notificationCapture) SendFCMNotification(
+	_ context.Context,
+	sub *types.FCMPushSubscription,
+	eventHash common.Hash,
+	_ map[string]string,
+	_ string,
+) (bool, int, error) {
+	nc.FcmPushNotificationsMu.Lock()
+	defer nc.FcmPushNotificationsMu.Unlock()
+
+	events, found := nc.FcmPushNotifications[eventHash]
+	if !found {
+		events = make(map[common.Address]int)
+	}
+
+	// for test purposes the users address is the token
+	events[common.HexToAddress(sub.Token)]++
+	nc.FcmPushNotifications[eventHash] = events
+
+	return false, http.StatusOK, nil
+}
+
 func spaceChannelSettings(
 	ctx context.Context,
 	test *spaceChannelNotificationsTestContext,
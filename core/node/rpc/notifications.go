@@ -57,6 +57,9 @@ func (s *Service) startNotificationMode(notifier push.MessageNotifier, opts *Ser
 		s.notifications,
 		s.config.Notifications,
 		notifier,
+		s.notificationOutbox,
+		s.notificationShards,
+		s.notificationDigests,
 	)
 
 	httpClient, err := s.httpClientMaker(s.serverCtx, s.config)
@@ -91,6 +94,7 @@ func (s *Service) startNotificationMode(notifier push.MessageNotifier, opts *Ser
 		registries,
 		s.metrics,
 		processor,
+		s.notificationShards,
 	)
 	if err != nil {
 		return AsRiverError(err).Message("Failed to instantiate notification service").LogError(s.defaultLogger)
@@ -107,6 +111,7 @@ func (s *Service) startNotificationMode(notifier push.MessageNotifier, opts *Ser
 		return err
 	}
 
+	s.notificationOutbox.Start(s.serverCtx, processor)
 	s.NotificationService.Start(s.serverCtx)
 
 	// Retrieve the TCP address of the listener
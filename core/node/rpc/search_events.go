@@ -0,0 +1,218 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/towns-protocol/towns/core/node/authentication"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
+	"github.com/towns-protocol/towns/core/node/search"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+const (
+	// defaultSearchEventsLimit is the number of events returned by SearchEvents if the request has no limit.
+	defaultSearchEventsLimit = 100
+	// searchEventsChallengePrefix is the prefix of the challenge users sign to get a SearchEvents session token.
+	searchEventsChallengePrefix = "SE_AUTH:"
+)
+
+// searchEventsAuthService issues the session tokens that are required to call SearchEvents.
+type searchEventsAuthService struct {
+	authentication.AuthServiceMixin
+}
+
+// initSearchEventsAuthentication mounts the authentication service for SearchEvents and returns
+// the interceptor that requires a session token for SearchEvents.
+func (s *Service) initSearchEventsAuthentication(interceptors connect.HandlerOption) (connect.Interceptor, error) {
+	authService := &searchEventsAuthService{}
+	if err := authService.InitAuthentication(
+		searchEventsChallengePrefix,
+		&s.config.EventIndex.Authentication,
+	); err != nil {
+		return nil, err
+	}
+
+	authInterceptor, err := authentication.NewAuthenticationInterceptor(
+		authService.ShortServiceName(),
+		s.config.EventIndex.Authentication.SessionToken.Key.Algorithm,
+		s.config.EventIndex.Authentication.SessionToken.Key.Key,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	authServicePattern, authServiceHandler := protocolconnect.NewAuthenticationServiceHandler(authService, interceptors)
+	s.mux.Handle(authServicePattern, newHttpHandler(authServiceHandler, s.defaultLogger))
+
+	return &procedureInterceptor{
+		procedure:   protocolconnect.StreamServiceSearchEventsProcedure,
+		interceptor: authInterceptor,
+	}, nil
+}
+
+// procedureInterceptor applies the interceptor to a single unary procedure of a service.
+type procedureInterceptor struct {
+	procedure   string
+	interceptor connect.Interceptor
+}
+
+func (i *procedureInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	wrapped := i.interceptor.WrapUnary(next)
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().Procedure == i.procedure {
+			return wrapped(ctx, req)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *procedureInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *procedureInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (s *Service) SearchEvents(
+	ctx context.Context,
+	req *connect.Request[SearchEventsRequest],
+) (*connect.Response[SearchEventsResponse], error) {
+	return executeConnectHandler(ctx, req, s, s.searchEventsImpl, "SearchEvents")
+}
+
+func (s *Service) searchEventsImpl(
+	ctx context.Context,
+	req *connect.Request[SearchEventsRequest],
+) (*connect.Response[SearchEventsResponse], error) {
+	if s.eventIndex == nil {
+		return nil, RiverError(Err_UNAVAILABLE, "Event index is not enabled on this node").Func("SearchEvents")
+	}
+
+	query, err := s.searchEventsQuery(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// session tokens are checked by the interceptor, the user must be a member of the searched stream
+	// unless the user is an admin that can search across streams
+	user := authentication.UserFromAuthenticatedContext(ctx)
+	if user == (common.Address{}) {
+		return nil, RiverError(Err_UNAUTHENTICATED, "Missing session token").Func("SearchEvents")
+	}
+	if !s.config.EventIndex.IsAdmin(user) {
+		if query.StreamId == nil {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "stream_id is required").Func("SearchEvents")
+		}
+		if err := s.checkSearchEventsMembership(ctx, user, *query.StreamId); err != nil {
+			return nil, err
+		}
+	}
+
+	found, err := s.eventIndex.SearchEvents(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SearchEventsResponse{
+		Events: make([]*SearchEventsResponse_Event, len(found)),
+	}
+	for i, e := range found {
+		event := &SearchEventsResponse_Event{
+			StreamId:       e.StreamId[:],
+			MiniblockNum:   e.MiniblockNum,
+			EventNum:       e.EventNum,
+			EventHash:      e.EventHash[:],
+			CreatorAddress: e.Creator[:],
+			EventType:      e.EventType,
+			CreatedAt:      timestamppb.New(e.CreatedAt),
+			PayloadJson:    string(e.Payload),
+		}
+		if e.User != (common.Address{}) {
+			event.UserAddress = e.User[:]
+		}
+		resp.Events[i] = event
+	}
+	if len(found) == query.Limit {
+		resp.NextPageToken = search.EncodePageToken(found[len(found)-1].Cursor())
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// checkSearchEventsMembership returns an error if the user is not a member of the local stream.
+// Only local streams are indexed by the node.
+func (s *Service) checkSearchEventsMembership(ctx context.Context, user common.Address, streamId shared.StreamId) error {
+	stream, err := s.cache.GetStreamNoWait(ctx, streamId)
+	if err != nil {
+		return err
+	}
+	view, err := stream.GetViewIfLocal(ctx)
+	if err != nil {
+		return err
+	}
+	if view == nil {
+		return RiverError(Err_FAILED_PRECONDITION, "Stream is not indexed on this node").
+			Tag("streamId", streamId).
+			Func("SearchEvents")
+	}
+
+	isMember, err := view.IsMember(user[:])
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return RiverError(Err_PERMISSION_DENIED, "User is not a member of the stream").
+			Tag("user", user).
+			Tag("streamId", streamId).
+			Func("SearchEvents")
+	}
+	return nil
+}
+
+func (s *Service) searchEventsQuery(req *SearchEventsRequest) (*storage.EventSearchQuery, error) {
+	maxResults := s.config.EventIndex.GetMaxResults()
+	query := &storage.EventSearchQuery{
+		EventTypes: req.EventTypes,
+		Limit:      min(defaultSearchEventsLimit, maxResults),
+	}
+	if req.Limit > 0 {
+		query.Limit = min(int(req.Limit), maxResults)
+	}
+
+	if req.StreamId != nil {
+		streamId, err := shared.StreamIdFromBytes(req.StreamId)
+		if err != nil {
+			return nil, err
+		}
+		query.StreamId = &streamId
+	}
+	if req.UserAddress != nil {
+		user, err := BytesToAddress(req.UserAddress)
+		if err != nil {
+			return nil, err
+		}
+		query.User = &user
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+	if len(req.PageToken) > 0 {
+		cursor, err := search.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		query.After = cursor
+	}
+	return query, nil
+}
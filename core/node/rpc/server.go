@@ -20,6 +20,7 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/app_registry"
 	"github.com/towns-protocol/towns/core/node/auth"
 	"github.com/towns-protocol/towns/core/node/authentication"
 	. "github.com/towns-protocol/towns/core/node/base"
@@ -32,9 +33,11 @@ import (
 	"github.com/towns-protocol/towns/core/node/notifications"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
+	"github.com/towns-protocol/towns/core/node/rebalance"
 	"github.com/towns-protocol/towns/core/node/registries"
 	"github.com/towns-protocol/towns/core/node/rpc/sync"
 	"github.com/towns-protocol/towns/core/node/scrub"
+	"github.com/towns-protocol/towns/core/node/search"
 	"github.com/towns-protocol/towns/core/node/storage"
 	"github.com/towns-protocol/towns/core/node/track_streams"
 	"github.com/towns-protocol/towns/core/node/utils"
@@ -192,7 +195,10 @@ func (s *Service) start(opts *ServerStartOpts) error {
 		return AsRiverError(err).Message("Failed to init cache and sync").LogError(s.defaultLogger)
 	}
 
-	s.initHandlers()
+	err = s.initHandlers()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init handlers").LogError(s.defaultLogger)
+	}
 
 	s.SetStatus("OK")
 
@@ -404,6 +410,28 @@ func (s *Service) prepareStore() error {
 		}
 		s.storagePoolInfo = pool
 
+		return nil
+	case storage.StreamStorageTypePebble:
+		// Embedded storage is opened in initStore, only stream nodes are supported.
+		if s.mode != ServerModeFull && s.mode != ServerModeArchive {
+			return RiverError(
+				Err_BAD_CONFIG,
+				"Server mode not supported for storage",
+				"mode",
+				s.mode,
+				"storageType",
+				s.config.StorageType,
+			).Func("prepareStore")
+		}
+		// Offloading into segments is only implemented by the postgres store.
+		if s.mode == ServerModeArchive && s.config.Archive.ColdTier.Enabled() {
+			return RiverError(
+				Err_BAD_CONFIG,
+				"Archive cold tier not supported for storage",
+				"storageType",
+				s.config.StorageType,
+			).Func("prepareStore")
+		}
 		return nil
 	default:
 		return RiverError(
@@ -442,7 +470,8 @@ func (s *Service) loadTLSConfig() (*tls.Config, error) {
 
 	return &tls.Config{
 		Certificates: []tls.Certificate{*cert},
-		NextProtos:   []string{"h2"},
+		// http/1.1 is used for WebSocket sync sessions
+		NextProtos: []string{"h2", "http/1.1"},
 	}, nil
 }
 
@@ -510,6 +539,7 @@ func (s *Service) runHttpServer() error {
 			"Connect-Timeout-Ms",
 			"x-river-request-id",
 			"Authorization",
+			"Last-Event-ID",
 		},
 	})
 
@@ -588,6 +618,14 @@ func (s *Service) initStore() error {
 		s.storage = store
 		s.onClose(store.Close)
 
+		if s.mode == ServerModeArchive && s.config.Archive.ColdTier.Enabled() {
+			segments, err := storage.NewLocalSegmentBackend(s.config.Archive.ColdTier.Directory)
+			if err != nil {
+				return err
+			}
+			store.EnableColdTier(segments)
+		}
+
 		streamsCount, err := store.GetStreamsNumber(ctx)
 		if err != nil {
 			return err
@@ -603,6 +641,33 @@ func (s *Service) initStore() error {
 			)
 		}
 		return nil
+	case storage.StreamStorageTypePebble:
+		store, err := storage.NewPebbleStreamStore(
+			ctx,
+			s.config.Database.DataDir,
+			s.chainConfig.Get().StreamEphemeralStreamTTL,
+		)
+		if err != nil {
+			return err
+		}
+		s.storage = store
+		s.onClose(store.Close)
+
+		streamsCount, err := store.GetStreamsNumber(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !s.config.Log.Simplify {
+			log.Infow(
+				"Created pebble event store",
+				"dataDir",
+				s.config.Database.DataDir,
+				"totalStreamsCount",
+				streamsCount,
+			)
+		}
+		return nil
 	default:
 		return RiverError(
 			Err_BAD_CONFIG,
@@ -630,6 +695,20 @@ func (s *Service) initNotificationsStore() error {
 		}
 
 		s.notifications = notifications.NewUserPreferencesCache(pgstore)
+		s.notificationOutbox = notifications.NewOutbox(
+			pgstore,
+			&s.config.Notifications.Outbox,
+			s.metrics,
+			notifications.ProviderWebPush,
+			notifications.ProviderAPN,
+			notifications.ProviderFCM,
+		)
+		s.notificationShards = notifications.NewShardManager(
+			pgstore,
+			&s.config.Notifications.Sharding,
+			s.metrics,
+		)
+		s.notificationDigests = pgstore
 		s.onClose(pgstore.Close)
 
 		if !s.config.Log.Simplify {
@@ -702,8 +781,36 @@ func (s *Service) initCacheAndSync(opts *ServerStartOpts) error {
 		Tracer:                  s.otelTracer,
 	}
 
+	if s.config.EventIndex.Enabled {
+		eventIndex, ok := s.storage.(storage.EventIndexStore)
+		if !ok {
+			return RiverError(Err_BAD_CONFIG, "Event index is not supported by storage type",
+				"storageType", s.config.StorageType).Func("initCacheAndSync")
+		}
+		s.eventIndex = eventIndex
+		cacheParams.EventIndexer = search.NewIndexer(s.serverCtx, eventIndex, &s.config.EventIndex, s.metrics)
+	}
+
 	s.cache = events.NewStreamCache(cacheParams)
 
+	var err error
+	s.addEventOutcomes, err = newAddEventOutcomes(&s.config.AddEventIdempotency)
+	if err != nil {
+		return err
+	}
+
+	if s.config.AppPermissions.AppRegistryUrl != "" {
+		httpClient, err := s.httpClientMaker(s.serverCtx, s.config)
+		if err != nil {
+			return err
+		}
+		s.appPermissions = app_registry.NewAppPermissionsCache(
+			&s.config.AppPermissions,
+			protocolconnect.NewAppRegistryServiceClient(httpClient, s.config.AppPermissions.AppRegistryUrl),
+		)
+		s.appPermissions.Start(s.serverCtx)
+	}
+
 	// There is circular dependency between the cache and the scrubber, so the scrubber
 	// needs to be patched into cache params after the cache is created.
 	if opts != nil && opts.ScrubberMaker != nil {
@@ -720,7 +827,7 @@ func (s *Service) initCacheAndSync(opts *ServerStartOpts) error {
 		)
 	}
 
-	err := s.cache.Start(s.serverCtx)
+	err = s.cache.Start(s.serverCtx)
 	if err != nil {
 		return err
 	}
@@ -728,16 +835,31 @@ func (s *Service) initCacheAndSync(opts *ServerStartOpts) error {
 	s.mbProducer = events.NewMiniblockProducer(s.serverCtx, s.cache, nil)
 
 	s.syncHandler = sync.NewHandler(
+		s.serverCtx,
 		s.wallet.Address,
 		s.cache,
 		s.nodeRegistry,
+		&s.config.StreamSync,
+		s.metrics,
 		s.otelTracer,
 	)
 
+	if s.config.StreamRebalancer.Enabled {
+		rebalance.NewRebalancer(
+			&s.config.StreamRebalancer,
+			s.wallet.Address,
+			s.riverChain,
+			s.registryContract,
+			s.chainConfig,
+			s.nodeRegistry,
+			s.cache,
+		).Start(s.serverCtx, s.riverChain.InitialBlockNum+1)
+	}
+
 	return nil
 }
 
-func (s *Service) initHandlers() {
+func (s *Service) initHandlers() error {
 	ii := []connect.Interceptor{}
 	if s.otelConnectIterceptor != nil {
 		ii = append(ii, s.otelConnectIterceptor)
@@ -746,13 +868,35 @@ func (s *Service) initHandlers() {
 	ii = append(ii, NewTimeoutInterceptor(s.config.Network.RequestTimeout))
 
 	interceptors := connect.WithInterceptors(ii...)
-	streamServicePattern, streamServiceHandler := protocolconnect.NewStreamServiceHandler(s, interceptors)
+
+	streamServiceInterceptors := interceptors
+	if s.eventIndex != nil {
+		authInterceptor, err := s.initSearchEventsAuthentication(interceptors)
+		if err != nil {
+			return err
+		}
+		streamServiceInterceptors = connect.WithInterceptors(append(ii, authInterceptor)...)
+	}
+
+	streamServicePattern, streamServiceHandler := protocolconnect.NewStreamServiceHandler(
+		s,
+		streamServiceInterceptors,
+	)
 	s.mux.Handle(streamServicePattern, newHttpHandler(streamServiceHandler, s.defaultLogger))
 
+	// sync sessions for clients behind proxies that don't support long running http/2 response streams
+	s.httpInterceptor = s.newHttpInterceptor()
+	s.mux.Handle(SyncWebSocketPath, newHttpHandler(
+		s.httpInterceptor.wrapStreamingHandler(SyncWebSocketPath, s.handleSyncWebSocket), s.defaultLogger))
+	s.mux.Handle(SyncSsePath, newHttpHandler(
+		s.httpInterceptor.wrapStreamingHandler(SyncSsePath, s.handleSyncSse), s.defaultLogger))
+
 	nodeServicePattern, nodeServiceHandler := protocolconnect.NewNodeToNodeHandler(s, interceptors)
 	s.mux.Handle(nodeServicePattern, newHttpHandler(nodeServiceHandler, s.defaultLogger))
 
 	s.registerDebugHandlers(s.config.EnableDebugEndpoints, s.config.DebugEndpoints)
+
+	return nil
 }
 
 func (s *Service) initNotificationHandlers() error {
@@ -805,6 +949,8 @@ func (s *Service) initAppRegistryHandlers() error {
 		s.config.AppRegistry.Authentication.SessionToken.Key.Algorithm,
 		s.config.AppRegistry.Authentication.SessionToken.Key.Key,
 		"/river.AppRegistryService/GetStatus",
+		"/river.AppRegistryService/GetPermissions",
+		"/river.AppRegistryService/GetApps",
 	)
 	if err != nil {
 		return err
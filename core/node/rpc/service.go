@@ -49,13 +49,28 @@ type Service struct {
 	storagePoolInfo *storage.PgxPoolInfo
 	storage         storage.StreamStorage
 
+	// eventIndex is not nil if the event search index is enabled
+	eventIndex storage.EventIndexStore
+
 	// Streams
 	cache       *StreamCache
 	mbProducer  TestMiniblockProducer
 	syncHandler river_sync.Handler
 
+	// addEventOutcomes retains outcomes of AddEvent requests with an idempotency key
+	addEventOutcomes *addEventOutcomes
+
+	// appPermissions is not nil if the permissions of registered apps are enforced
+	appPermissions *app_registry.AppPermissionsCache
+
 	// Notifications
 	notifications notifications.UserPreferencesStore
+	// notificationOutbox keeps notifications until they are delivered, only set in notification mode
+	notificationOutbox *notifications.Outbox
+	// notificationShards decides which streams this instance handles, only set in notification mode
+	notificationShards *notifications.ShardManager
+	// notificationDigests keeps digests until their notification is sent, only set in notification mode
+	notificationDigests storage.NotificationDigestStore
 
 	// App Registry
 	appStore storage.AppRegistryStore
@@ -98,6 +113,8 @@ type Service struct {
 	otelTraceProvider     trace.TracerProvider
 	otelTracer            trace.Tracer
 	otelConnectIterceptor *otelconnect.Interceptor
+	// httpInterceptor applies the connect interceptors to the sync sessions that are served over plain http
+	httpInterceptor *httpInterceptor
 
 	// onCloseFuncs are called in reverse order from Service.Close()
 	onCloseFuncs []func()
@@ -0,0 +1,212 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	river_sync "github.com/towns-protocol/towns/core/node/rpc/sync"
+)
+
+const (
+	// SyncSsePath is the path on which streams sync sessions are served as Server-Sent Events.
+	SyncSsePath = "/sync/sse"
+	// SyncSseRequestParam is the query parameter that holds the protobuf JSON encoded SyncStreamsRequest.
+	SyncSseRequestParam = "request"
+	// SyncSseCloseEvent is the event that is sent when the sync session stopped, its data is a SyncSocketResult.
+	SyncSseCloseEvent = "close"
+
+	// syncSseKeepAliveInterval is the interval in which comments are sent to keep the connection alive
+	syncSseKeepAliveInterval = 15 * time.Second
+)
+
+// syncSse is a Server-Sent Events response that serves a streams sync session. Each SyncStreamsResponse is sent as
+// a message event with protobuf JSON data.
+type syncSse struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	// syncId is set as last event id prefix for messages of resumable sync sessions, empty otherwise
+	syncId string
+	// muWrite serializes writes from the sync operation and keep alives
+	muWrite sync.Mutex
+	// closed is true when the close event was sent, the response must not be written afterwards
+	closed bool
+}
+
+var _ river_sync.StreamsResponseSubscriber = (*syncSse)(nil)
+
+// handleSyncSse serves a streams sync session as Server-Sent Events. The SyncStreamsRequest is given in the request
+// query parameter for GET requests and in the body for POST requests. Streams are added and removed from the sync
+// session through the connect sync rpcs with the sync id of the SYNC_NEW message.
+//
+// Messages of resumable sync sessions have the event id "<syncId>/<seq>". When an EventSource reconnects with the
+// Last-Event-ID header of such a message the sync session is resumed after it. It returns the error that stopped
+// the sync session.
+func (s *Service) handleSyncSse(w http.ResponseWriter, r *http.Request) error {
+	log := logging.FromCtx(r.Context())
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return RiverError(Err_UNAVAILABLE, "Streaming is not supported").Func("handleSyncSse")
+	}
+
+	syncReq, err := parseSyncSseRequest(r)
+	if err != nil {
+		log.Debugw("SyncSse: invalid request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+
+	syncId := syncIdForRequest(syncReq)
+	sse := &syncSse{w: w, flusher: flusher}
+	if syncReq.GetResumable() || syncReq.GetResumeSyncId() != "" {
+		sse.syncId = syncId
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// disable response buffering in nginx based proxies
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go sse.keepAlive(ctx)
+
+	err = s.syncStreams(ctx, syncId, connect.NewRequest(syncReq), sse)
+	cancel()
+	sse.close(err)
+	return err
+}
+
+// parseSyncSseRequest decodes the SyncStreamsRequest from the http request. For EventSource reconnects the
+// Last-Event-ID header resumes the sync session.
+func parseSyncSseRequest(r *http.Request) (*SyncStreamsRequest, error) {
+	var data []byte
+	if r.Method == http.MethodPost {
+		var err error
+		if data, err = io.ReadAll(io.LimitReader(r.Body, syncSocketMaxRequestSize)); err != nil {
+			return nil, AsRiverError(err, Err_INVALID_ARGUMENT).Func("parseSyncSseRequest")
+		}
+	} else if r.Method == http.MethodGet {
+		data = []byte(r.URL.Query().Get(SyncSseRequestParam))
+	} else {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Unsupported method").
+			Tag("method", r.Method).
+			Func("parseSyncSseRequest")
+	}
+
+	var req SyncStreamsRequest
+	if len(data) > 0 {
+		if err := protojson.Unmarshal(data, &req); err != nil {
+			return nil, AsRiverError(err, Err_INVALID_ARGUMENT).
+				Message("Unable to decode sync request").
+				Func("parseSyncSseRequest")
+		}
+	}
+
+	if lastEventId := r.Header.Get("Last-Event-ID"); lastEventId != "" {
+		syncId, seq, ok := strings.Cut(lastEventId, "/")
+		afterSeq, err := strconv.ParseUint(seq, 10, 64)
+		if !ok || syncId == "" || err != nil {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid Last-Event-ID").
+				Tag("lastEventId", lastEventId).
+				Func("parseSyncSseRequest")
+		}
+		req.ResumeSyncId = syncId
+		req.ResumeAfterSeq = afterSeq
+	}
+
+	return &req, nil
+}
+
+// Send implements river_sync.StreamsResponseSubscriber.
+func (sse *syncSse) Send(msg *SyncStreamsResponse) error {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL).Func("syncSse.Send")
+	}
+
+	var id string
+	if sse.syncId != "" && (msg.GetSeq() > 0 || msg.GetSyncOp() == SyncOp_SYNC_NEW) {
+		// SYNC_NEW resets the event id, resuming from it replays all buffered updates
+		id = fmt.Sprintf("%s/%d", sse.syncId, msg.GetSeq())
+	}
+	return sse.write("", id, data)
+}
+
+// close sends the close event to the client. Its result holds the error that stopped the sync session.
+func (sse *syncSse) close(err error) {
+	result := &SyncSocketResult{}
+	if err != nil {
+		connectErr := AsRiverError(err).AsConnectError()
+		result.Code = int32(connectErr.Code())
+		result.Message = connectErr.Error()
+	}
+	if data, err := protojson.Marshal(result); err == nil {
+		_ = sse.write(SyncSseCloseEvent, "", data)
+	}
+
+	sse.muWrite.Lock()
+	sse.closed = true
+	sse.muWrite.Unlock()
+}
+
+func (sse *syncSse) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(syncSseKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := sse.writeRaw(": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (sse *syncSse) write(event string, id string, data []byte) error {
+	var b strings.Builder
+	if event != "" {
+		b.WriteString("event: " + event + "\n")
+	}
+	if id != "" {
+		b.WriteString("id: " + id + "\n")
+	}
+	// protobuf JSON doesn't contain newlines without multiline formatting
+	b.WriteString("data: ")
+	b.Write(data)
+	b.WriteString("\n\n")
+
+	return sse.writeRaw(b.String())
+}
+
+func (sse *syncSse) writeRaw(s string) error {
+	sse.muWrite.Lock()
+	defer sse.muWrite.Unlock()
+
+	if sse.closed {
+		return RiverError(Err_CANCELED, "Sync response is closed").Func("syncSse.write")
+	}
+	if _, err := io.WriteString(sse.w, s); err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Func("syncSse.write")
+	}
+	sse.flusher.Flush()
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	. "github.com/towns-protocol/towns/core/node/base"
 	. "github.com/towns-protocol/towns/core/node/protocol"
+	river_sync "github.com/towns-protocol/towns/core/node/rpc/sync"
 	"github.com/towns-protocol/towns/core/node/utils"
 )
 
@@ -30,11 +31,32 @@ func (s *Service) SyncStreams(
 	ctx context.Context,
 	req *connect.Request[SyncStreamsRequest],
 	res *connect.ServerStream[SyncStreamsResponse],
+) error {
+	if err := s.syncStreams(ctx, syncIdForRequest(req.Msg), req, res); err != nil {
+		return AsRiverError(err).AsConnectError()
+	}
+	return nil
+}
+
+// syncIdForRequest returns the id of the sync operation that req starts or resumes.
+func syncIdForRequest(req *SyncStreamsRequest) string {
+	if syncId := req.GetResumeSyncId(); syncId != "" {
+		return syncId
+	}
+	return GenNanoid()
+}
+
+// syncStreams runs the sync operation for req and sends its messages to res until it stops.
+// It is shared by the connect, WebSocket and SSE sync transports.
+func (s *Service) syncStreams(
+	ctx context.Context,
+	syncId string,
+	req *connect.Request[SyncStreamsRequest],
+	res river_sync.StreamsResponseSubscriber,
 ) error {
 	ctx, log := utils.CtxAndLogForRequest(ctx, req)
 	startTime := time.Now()
-	syncId := GenNanoid()
-	log.Debugw("SyncStreams START", "syncId", syncId)
+	log.Debugw("SyncStreams START", "syncId", syncId, "resume", req.Msg.GetResumeSyncId() != "")
 
 	var err error
 	runWithLabels(ctx, syncId, func(ctx context.Context) {
@@ -45,16 +67,14 @@ func (s *Service) SyncStreams(
 		if errors.Is(err, context.Canceled) {
 			level = zap.DebugLevel
 		}
-		err = AsRiverError(
+		return AsRiverError(
 			err,
 		).Func("SyncStreams").
 			Tags("syncId", syncId, "duration", time.Since(startTime)).
-			LogLevel(log, level).
-			AsConnectError()
-	} else {
-		log.Debugw("SyncStreams DONE", "syncId", syncId, "duration", time.Since(startTime))
+			LogLevel(log, level)
 	}
-	return err
+	log.Debugw("SyncStreams DONE", "syncId", syncId, "duration", time.Since(startTime))
+	return nil
 }
 
 func (s *Service) AddStreamToSync(
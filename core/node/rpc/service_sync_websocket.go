@@ -0,0 +1,292 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
+	river_sync "github.com/towns-protocol/towns/core/node/rpc/sync"
+)
+
+const (
+	// SyncWebSocketPath is the path on which streams sync sessions are served over WebSocket.
+	SyncWebSocketPath = "/sync/ws"
+
+	// syncSocketMaxRequestSize is the max size of a message that a client sends over a sync WebSocket
+	syncSocketMaxRequestSize = 4 * 1024 * 1024
+	// syncSocketWriteTimeout is the max duration to write a message to a sync WebSocket
+	syncSocketWriteTimeout = 10 * time.Second
+	// syncSocketPingInterval is the interval in which WebSocket pings are sent to keep the connection alive
+	syncSocketPingInterval = 20 * time.Second
+	// syncSocketPongTimeout is the max duration without a message from the client before the connection is closed
+	syncSocketPongTimeout = 2 * syncSocketPingInterval
+)
+
+var syncSocketUpgrader = websocket.Upgrader{
+	// the connect services allow all origins, sync ids act as bearer tokens for the sync session
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// syncSocket is a WebSocket connection that serves a streams sync session. Messages are encoded as protobuf in
+// binary frames or protobuf JSON in text frames, depending on the encoding of the first message of the client.
+type syncSocket struct {
+	conn *websocket.Conn
+	// binary is true when the client sends protobuf encoded messages
+	binary bool
+	// muWrite serializes writes, the connection supports one concurrent writer
+	muWrite sync.Mutex
+}
+
+var _ river_sync.StreamsResponseSubscriber = (*syncSocket)(nil)
+
+// handleSyncWebSocket serves a streams sync session over WebSocket. The client starts the sync session with the
+// first message and can add and remove streams, ping and cancel the sync session over the same connection.
+// The sync operation stops when the connection is closed, resumable sync operations can be resumed on a new connection.
+// It returns the error that stopped the sync session, nil if the client closed the connection.
+func (s *Service) handleSyncWebSocket(w http.ResponseWriter, r *http.Request) error {
+	log := logging.FromCtx(r.Context())
+
+	conn, err := syncSocketUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader replied with an http error
+		log.Debugw("SyncWebSocket: unable to upgrade connection", "error", err)
+		return AsRiverError(err, Err_INVALID_ARGUMENT).Func("handleSyncWebSocket")
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	socket := &syncSocket{conn: conn}
+	conn.SetReadLimit(syncSocketMaxRequestSize)
+	_ = conn.SetReadDeadline(time.Now().Add(syncSocketPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(syncSocketPongTimeout))
+	})
+
+	first, err := socket.read(true)
+	if err != nil {
+		socket.close(err)
+		return err
+	}
+	syncReq := first.GetSyncStreams()
+	if syncReq == nil {
+		err := RiverError(Err_INVALID_ARGUMENT, "First message must start the sync session").
+			Func("handleSyncWebSocket")
+		socket.close(err)
+		return err
+	}
+
+	syncId := syncIdForRequest(syncReq)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.syncStreams(ctx, syncId, connect.NewRequest(syncReq), socket)
+	}()
+
+	requests := make(chan *SyncSocketRequest)
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := socket.read(false)
+			if err != nil {
+				readErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// control requests are applied in order by a worker to not delay pings and the shutdown of the connection.
+	// inFlight is held while a request is applied so that its result is written before the connection is closed.
+	var inFlight sync.Mutex
+	writeErr := make(chan error, 1)
+	go func() {
+		for {
+			select {
+			case req := <-requests:
+				inFlight.Lock()
+				err := socket.write(&SyncSocketResponse{
+					Response: &SyncSocketResponse_Result{Result: s.handleSyncSocketRequest(ctx, syncId, req)},
+				})
+				inFlight.Unlock()
+				if err != nil {
+					writeErr <- err
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(syncSocketPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			inFlight.Lock()
+			socket.close(err)
+			inFlight.Unlock()
+			return err
+		case err := <-readErr:
+			// the client closed the connection or sent a request that can't be decoded, stop the sync operation
+			log.Debugw("SyncWebSocket: connection closed", "syncId", syncId, "error", err)
+			cancel()
+			<-done
+			if IsRiverErrorCode(err, Err_INVALID_ARGUMENT) {
+				socket.close(err)
+				return err
+			}
+			return nil
+		case err := <-writeErr:
+			cancel()
+			<-done
+			return err
+		case <-ping.C:
+			if err := conn.WriteControl(
+				websocket.PingMessage, nil, time.Now().Add(syncSocketWriteTimeout)); err != nil {
+				cancel()
+				<-done
+				return err
+			}
+		}
+	}
+}
+
+// handleSyncSocketRequest applies req on the sync operation of the connection and returns its result.
+func (s *Service) handleSyncSocketRequest(
+	ctx context.Context,
+	syncId string,
+	req *SyncSocketRequest,
+) *SyncSocketResult {
+	var err error
+	switch r := req.GetRequest().(type) {
+	case *SyncSocketRequest_AddStream:
+		r.AddStream.SyncId = syncId
+		err = s.httpInterceptor.callUnary(ctx, protocolconnect.StreamServiceAddStreamToSyncProcedure,
+			func(ctx context.Context) error {
+				_, err := s.AddStreamToSync(ctx, connect.NewRequest(r.AddStream))
+				return err
+			})
+	case *SyncSocketRequest_RemoveStream:
+		r.RemoveStream.SyncId = syncId
+		err = s.httpInterceptor.callUnary(ctx, protocolconnect.StreamServiceRemoveStreamFromSyncProcedure,
+			func(ctx context.Context) error {
+				_, err := s.RemoveStreamFromSync(ctx, connect.NewRequest(r.RemoveStream))
+				return err
+			})
+	case *SyncSocketRequest_Ping:
+		r.Ping.SyncId = syncId
+		err = s.httpInterceptor.callUnary(ctx, protocolconnect.StreamServicePingSyncProcedure,
+			func(ctx context.Context) error {
+				_, err := s.PingSync(ctx, connect.NewRequest(r.Ping))
+				return err
+			})
+	case *SyncSocketRequest_Cancel:
+		r.Cancel.SyncId = syncId
+		err = s.httpInterceptor.callUnary(ctx, protocolconnect.StreamServiceCancelSyncProcedure,
+			func(ctx context.Context) error {
+				_, err := s.CancelSync(ctx, connect.NewRequest(r.Cancel))
+				return err
+			})
+	case *SyncSocketRequest_SyncStreams:
+		err = RiverError(Err_INVALID_ARGUMENT, "Sync session already started").Tag("syncId", syncId)
+	default:
+		err = RiverError(Err_INVALID_ARGUMENT, "Unknown sync socket request").Tag("syncId", syncId)
+	}
+
+	result := &SyncSocketResult{RequestId: req.GetRequestId()}
+	if err != nil {
+		connectErr := AsRiverError(err).AsConnectError()
+		result.Code = int32(connectErr.Code())
+		result.Message = connectErr.Error()
+	}
+	return result
+}
+
+// Send implements river_sync.StreamsResponseSubscriber.
+func (sc *syncSocket) Send(msg *SyncStreamsResponse) error {
+	return sc.write(&SyncSocketResponse{Response: &SyncSocketResponse_Update{Update: msg}})
+}
+
+// read the next request from the client. The first request determines the encoding of the connection.
+func (sc *syncSocket) read(first bool) (*SyncSocketRequest, error) {
+	frameType, data, err := sc.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	if first {
+		sc.binary = frameType == websocket.BinaryMessage
+	}
+
+	var req SyncSocketRequest
+	if frameType == websocket.BinaryMessage {
+		err = proto.Unmarshal(data, &req)
+	} else {
+		err = protojson.Unmarshal(data, &req)
+	}
+	if err != nil {
+		return nil, AsRiverError(err, Err_INVALID_ARGUMENT).
+			Message("Unable to decode sync socket request").
+			Func("syncSocket.read")
+	}
+	return &req, nil
+}
+
+func (sc *syncSocket) write(msg *SyncSocketResponse) error {
+	var (
+		frameType = websocket.TextMessage
+		data      []byte
+		err       error
+	)
+	if sc.binary {
+		frameType = websocket.BinaryMessage
+		data, err = proto.Marshal(msg)
+	} else {
+		data, err = protojson.Marshal(msg)
+	}
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL).Func("syncSocket.write")
+	}
+
+	sc.muWrite.Lock()
+	defer sc.muWrite.Unlock()
+
+	_ = sc.conn.SetWriteDeadline(time.Now().Add(syncSocketWriteTimeout))
+	return sc.conn.WriteMessage(frameType, data)
+}
+
+// close sends the close frame to the client. The close reason holds the error that stopped the sync session.
+func (sc *syncSocket) close(err error) {
+	code, reason := websocket.CloseNormalClosure, ""
+	if err != nil {
+		code, reason = websocket.CloseInternalServerErr, AsRiverError(err).AsConnectError().Error()
+		if IsRiverErrorCode(err, Err_INVALID_ARGUMENT) {
+			code = websocket.ClosePolicyViolation
+		}
+		// control frames are limited to 125 bytes, including the close code
+		if len(reason) > 123 {
+			reason = reason[:123]
+		}
+	}
+	_ = sc.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(code, reason),
+		time.Now().Add(syncSocketWriteTimeout),
+	)
+}
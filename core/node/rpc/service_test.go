@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/towns-protocol/towns/core/config"
 	. "github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/crypto"
 	"github.com/towns-protocol/towns/core/node/events"
@@ -1605,7 +1606,7 @@ func (s slowStreamsResponseSender) Send(msg *protocol.SyncStreamsResponse) error
 }
 
 // TestSyncSubscriptionWithTooSlowClient ensures that a sync operation cancels itself when a subscriber isn't able to
-// keep up with sync updates.
+// keep up with sync updates and resetting its streams doesn't keep the buffered updates within budget.
 func TestSyncSubscriptionWithTooSlowClient(t *testing.T) {
 	var (
 		req      = require.New(t)
@@ -1654,7 +1655,8 @@ func TestSyncSubscriptionWithTooSlowClient(t *testing.T) {
 	testfmt.Logf(t, "subscribe on node %s", node1.address)
 	syncPos := append(users, channels...)
 	syncOp, err := river_sync.NewStreamsSyncOperation(
-		ctx, syncID, node1.address, node1.service.cache, node1.service.nodeRegistry, nil)
+		ctx, syncID, node1.address, node1.service.cache, node1.service.nodeRegistry, nil,
+		&config.StreamSyncConfig{SessionBufferBytes: 1}, false, nil)
 	req.NoError(err, "NewStreamsSyncOperation")
 
 	syncOpResult := make(chan error)
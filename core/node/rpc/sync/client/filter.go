@@ -0,0 +1,183 @@
+package client
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+type (
+	// syncFilter is a validated SyncFilter.
+	syncFilter struct {
+		// payloadCases holds the payload and payload.content names of the events to send, all if empty
+		payloadCases map[string]struct{}
+		// creators holds the creators of the events to send, all if empty
+		creators map[common.Address]struct{}
+		// headersOnly only sends miniblock header events
+		headersOnly bool
+	}
+
+	// streamFilters holds the event filters of the streams in a sync operation. It is safe for concurrent use.
+	// A nil streamFilters doesn't filter events.
+	streamFilters struct {
+		filters sync.Map // StreamId -> *syncFilter
+	}
+)
+
+// ValidateSyncFilter returns an error if the filter contains unknown payload cases or invalid creator addresses.
+func ValidateSyncFilter(filter *SyncFilter) error {
+	_, err := newSyncFilter(filter)
+	return err
+}
+
+func newSyncFilter(filter *SyncFilter) (*syncFilter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	f := &syncFilter{
+		payloadCases: make(map[string]struct{}),
+		creators:     make(map[common.Address]struct{}),
+		headersOnly:  filter.GetMiniblockHeadersOnly(),
+	}
+
+	payloads := (&StreamEvent{}).ProtoReflect().Descriptor().Oneofs().ByName("payload").Fields()
+	for _, payloadCase := range filter.GetPayloadCases() {
+		name, content, hasContent := strings.Cut(payloadCase, ".")
+		payload := payloads.ByName(protoreflect.Name(name))
+		if payload == nil || payload.Message() == nil {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Unknown payload case in sync filter", "case", payloadCase)
+		}
+		if hasContent {
+			contents := payload.Message().Oneofs().ByName("content")
+			if contents == nil || contents.Fields().ByName(protoreflect.Name(content)) == nil {
+				return nil, RiverError(
+					Err_INVALID_ARGUMENT, "Unknown payload content in sync filter", "case", payloadCase)
+			}
+		}
+		f.payloadCases[payloadCase] = struct{}{}
+	}
+
+	for _, creator := range filter.GetCreators() {
+		if len(creator) != common.AddressLength {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid creator address in sync filter")
+		}
+		f.creators[common.BytesToAddress(creator)] = struct{}{}
+	}
+
+	return f, nil
+}
+
+// keep returns true if the event must be sent to the client.
+func (f *syncFilter) keep(envelope *Envelope) bool {
+	var event StreamEvent
+	if err := proto.Unmarshal(envelope.GetEvent(), &event); err != nil {
+		return true // let the client decide what to do with it
+	}
+
+	if event.GetMiniblockHeader() != nil {
+		return true
+	}
+	if f.headersOnly {
+		return false
+	}
+
+	if len(f.creators) > 0 {
+		if _, ok := f.creators[common.BytesToAddress(event.GetCreatorAddress())]; !ok {
+			return false
+		}
+	}
+
+	if len(f.payloadCases) > 0 {
+		msg := event.ProtoReflect()
+		payload := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("payload"))
+		if payload == nil {
+			return false
+		}
+		if _, ok := f.payloadCases[string(payload.Name())]; ok {
+			return true
+		}
+		if payload.Message() == nil {
+			return false
+		}
+		contentMsg := msg.Get(payload).Message()
+		contents := contentMsg.Descriptor().Oneofs().ByName("content")
+		if contents == nil {
+			return false
+		}
+		content := contentMsg.WhichOneof(contents)
+		if content == nil {
+			return false
+		}
+		_, ok := f.payloadCases[string(payload.Name())+"."+string(content.Name())]
+		return ok
+	}
+
+	return true
+}
+
+// set the filter for the stream, a nil filter removes the filter.
+func (sf *streamFilters) set(streamID StreamId, filter *SyncFilter) error {
+	f, err := newSyncFilter(filter)
+	if err != nil {
+		return err
+	}
+	if f == nil {
+		sf.filters.Delete(streamID)
+	} else {
+		sf.filters.Store(streamID, f)
+	}
+	return nil
+}
+
+func (sf *streamFilters) remove(streamID StreamId) {
+	sf.filters.Delete(streamID)
+}
+
+// apply returns the update with the events that pass the filter of the stream. It returns nil if the update must
+// not be sent because all its events are filtered. The next sync cookie is kept and includes the filtered events,
+// resuming from it doesn't return these events again.
+func (sf *streamFilters) apply(update *StreamAndCookie) *StreamAndCookie {
+	if sf == nil {
+		return update
+	}
+
+	streamID, err := StreamIdFromBytes(update.GetNextSyncCookie().GetStreamId())
+	if err != nil {
+		return update
+	}
+	f, ok := sf.filters.Load(streamID)
+	if !ok {
+		return update
+	}
+	filter := f.(*syncFilter)
+
+	events := make([]*Envelope, 0, len(update.GetEvents()))
+	for _, envelope := range update.GetEvents() {
+		if filter.keep(envelope) {
+			events = append(events, envelope)
+		}
+	}
+
+	if len(events) == len(update.GetEvents()) {
+		return update
+	}
+	if len(events) == 0 && len(update.GetMiniblocks()) == 0 && !update.GetSyncReset() {
+		return nil
+	}
+
+	// update can be shared with other sync operations, don't modify it
+	return &StreamAndCookie{
+		Events:         events,
+		NextSyncCookie: update.GetNextSyncCookie(),
+		Miniblocks:     update.GetMiniblocks(),
+		SyncReset:      update.GetSyncReset(),
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestStreamFilters(t *testing.T) {
+	require := require.New(t)
+
+	alice := common.Address{1}
+	bob := common.Address{2}
+	streamID := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	envelope := func(event *StreamEvent) *Envelope {
+		data, err := proto.Marshal(event)
+		require.NoError(err)
+		return &Envelope{Event: data}
+	}
+
+	var (
+		header = envelope(&StreamEvent{
+			CreatorAddress: common.Address{3}.Bytes(),
+			Payload:        &StreamEvent_MiniblockHeader{MiniblockHeader: &MiniblockHeader{}},
+		})
+		message = envelope(&StreamEvent{
+			CreatorAddress: alice.Bytes(),
+			Payload: &StreamEvent_ChannelPayload{ChannelPayload: &ChannelPayload{
+				Content: &ChannelPayload_Message{Message: &EncryptedData{}},
+			}},
+		})
+		solicitation = envelope(&StreamEvent{
+			CreatorAddress: bob.Bytes(),
+			Payload: &StreamEvent_MemberPayload{MemberPayload: &MemberPayload{
+				Content: &MemberPayload_KeySolicitation_{KeySolicitation: &MemberPayload_KeySolicitation{}},
+			}},
+		})
+		cookie = &SyncCookie{StreamId: streamID[:], MinipoolGen: 1, MinipoolSlot: 3}
+		update = &StreamAndCookie{Events: []*Envelope{header, message, solicitation}, NextSyncCookie: cookie}
+	)
+
+	filters := &streamFilters{}
+
+	// streams without filter receive all events
+	require.Same(update, filters.apply(update))
+
+	require.NoError(filters.set(streamID, &SyncFilter{PayloadCases: []string{"channel_payload.message"}}))
+	filtered := filters.apply(update)
+	require.Equal([]*Envelope{header, message}, filtered.GetEvents())
+	require.Same(cookie, filtered.GetNextSyncCookie())
+	require.Len(update.GetEvents(), 3, "update must not be modified")
+
+	require.NoError(filters.set(streamID, &SyncFilter{PayloadCases: []string{"member_payload"}}))
+	require.Equal([]*Envelope{header, solicitation}, filters.apply(update).GetEvents())
+
+	require.NoError(filters.set(streamID, &SyncFilter{Creators: [][]byte{bob.Bytes()}}))
+	require.Equal([]*Envelope{header, solicitation}, filters.apply(update).GetEvents())
+
+	require.NoError(filters.set(streamID, &SyncFilter{MiniblockHeadersOnly: true}))
+	require.Equal([]*Envelope{header}, filters.apply(update).GetEvents())
+
+	// updates without remaining events are not sent, unless they reset the stream
+	withoutHeader := &StreamAndCookie{Events: []*Envelope{message}, NextSyncCookie: cookie}
+	require.Nil(filters.apply(withoutHeader))
+	withoutHeader.SyncReset = true
+	require.NotNil(filters.apply(withoutHeader))
+
+	filters.remove(streamID)
+	require.Same(update, filters.apply(update))
+
+	var disabled *streamFilters
+	require.Same(update, disabled.apply(update))
+}
+
+func TestValidateSyncFilter(t *testing.T) {
+	require := require.New(t)
+
+	require.NoError(ValidateSyncFilter(nil))
+	require.NoError(ValidateSyncFilter(&SyncFilter{
+		PayloadCases: []string{"channel_payload", "member_payload.membership", "user_payload.user_membership"},
+		Creators:     [][]byte{common.Address{1}.Bytes()},
+	}))
+	require.Error(ValidateSyncFilter(&SyncFilter{PayloadCases: []string{"unknown_payload"}}))
+	require.Error(ValidateSyncFilter(&SyncFilter{PayloadCases: []string{"channel_payload.unknown"}}))
+	require.Error(ValidateSyncFilter(&SyncFilter{Creators: [][]byte{{1, 2, 3}}}))
+}
@@ -0,0 +1,263 @@
+package client
+
+import (
+	"math"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/infra"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+type (
+	// FlowControl limits the updates that are buffered for a client that doesn't keep up with its sync session.
+	FlowControl struct {
+		// BufferBytes is the max size of the buffered updates of a sync session
+		BufferBytes int
+		// Metrics is used to report lagging sessions, not reported if nil
+		Metrics *FlowControlMetrics
+	}
+
+	// FlowControlMetrics reports sync sessions with clients that don't keep up.
+	FlowControlMetrics struct {
+		// laggingSessionBytes has the buffered bytes of sync sessions that use more than half of their buffer
+		laggingSessionBytes *prometheus.GaugeVec
+		coalescedUpdates    prometheus.Counter
+		streamResets        prometheus.Counter
+		cancelledSessions   prometheus.Counter
+	}
+
+	// syncQueue buffers the messages for the client of a sync session. Updates of a stream that wait to be sent are
+	// coalesced into one update. When the buffered updates exceed the budget the largest pending update is dropped
+	// and its stream is reset. The queue is not safe for concurrent use.
+	syncQueue struct {
+		syncID  string
+		budget  int
+		metrics *FlowControlMetrics
+		// msgs holds the messages in the order they are sent to the client
+		msgs []*queuedMsg
+		// pending holds the update per stream that wasn't sent yet, new updates for the stream are added to it
+		pending map[StreamId]*queuedMsg
+		// resetting holds the streams for which updates are dropped until the sync reset arrives
+		resetting map[StreamId]struct{}
+		// bytes is the size of the messages in msgs
+		bytes int
+		// lagging is true when more than half of the budget is used
+		lagging bool
+	}
+
+	queuedMsg struct {
+		msg  *SyncStreamsResponse
+		size int
+		// dropped is true when the stream was reset and the message must not be sent
+		dropped bool
+	}
+)
+
+// NewFlowControlMetrics creates the metrics for sync sessions with clients that don't keep up.
+func NewFlowControlMetrics(metrics infra.MetricsFactory) *FlowControlMetrics {
+	return &FlowControlMetrics{
+		laggingSessionBytes: metrics.NewGaugeVecEx(
+			"stream_sync_lagging_session_bytes",
+			"Buffered update bytes of sync sessions that use more than half of their buffer",
+			"sync_id",
+		),
+		coalescedUpdates: metrics.NewCounterEx(
+			"stream_sync_coalesced_updates",
+			"Number of stream updates that were coalesced into a pending update for a slow client",
+		),
+		streamResets: metrics.NewCounterEx(
+			"stream_sync_stream_resets",
+			"Number of streams that were reset because the client fell too far behind",
+		),
+		cancelledSessions: metrics.NewCounterEx(
+			"stream_sync_cancelled_sessions",
+			"Number of sync sessions that were cancelled because the client fell too far behind",
+		),
+	}
+}
+
+func newSyncQueue(syncID string, flow FlowControl) *syncQueue {
+	return &syncQueue{
+		syncID:    syncID,
+		budget:    flow.BufferBytes,
+		metrics:   flow.Metrics,
+		pending:   make(map[StreamId]*queuedMsg),
+		resetting: make(map[StreamId]struct{}),
+	}
+}
+
+// push adds the message to the queue. It returns the cookies of the streams that must be reset because the budget
+// was exceeded, or an error if the budget can't be met by resetting streams.
+func (q *syncQueue) push(msg *SyncStreamsResponse) ([]*SyncCookie, error) {
+	if msg.GetSyncOp() == SyncOp_SYNC_UPDATE {
+		streamID, err := StreamIdFromBytes(msg.GetStream().GetNextSyncCookie().GetStreamId())
+		if err != nil {
+			q.append(msg)
+			return q.enforceBudget()
+		}
+
+		if _, ok := q.resetting[streamID]; ok {
+			if !msg.GetStream().GetSyncReset() {
+				return nil, nil // update is included in the sync reset
+			}
+			delete(q.resetting, streamID)
+		}
+
+		if pending, ok := q.pending[streamID]; ok {
+			q.coalesce(pending, msg)
+		} else {
+			q.pending[streamID] = q.append(msg)
+		}
+	} else {
+		if msg.GetSyncOp() == SyncOp_SYNC_DOWN {
+			if streamID, err := StreamIdFromBytes(msg.GetStreamId()); err == nil {
+				delete(q.pending, streamID)
+				delete(q.resetting, streamID)
+			}
+		}
+		q.append(msg)
+	}
+
+	return q.enforceBudget()
+}
+
+// peek returns the next message to send, nil if the queue is empty.
+func (q *syncQueue) peek() *SyncStreamsResponse {
+	for len(q.msgs) > 0 && q.msgs[0].dropped {
+		q.msgs = q.msgs[1:]
+	}
+	if len(q.msgs) == 0 {
+		return nil
+	}
+	return q.msgs[0].msg
+}
+
+// pop removes the message that peek returned.
+func (q *syncQueue) pop() {
+	if q.peek() == nil {
+		return
+	}
+
+	head := q.msgs[0]
+	q.msgs = q.msgs[1:]
+	q.bytes -= head.size
+
+	if head.msg.GetSyncOp() == SyncOp_SYNC_UPDATE {
+		streamID, err := StreamIdFromBytes(head.msg.GetStream().GetNextSyncCookie().GetStreamId())
+		if err == nil && q.pending[streamID] == head {
+			delete(q.pending, streamID)
+		}
+	}
+
+	q.reportLag()
+}
+
+// close removes the metrics of the sync session.
+func (q *syncQueue) close() {
+	if q.metrics != nil && q.lagging {
+		q.metrics.laggingSessionBytes.DeleteLabelValues(q.syncID)
+	}
+}
+
+func (q *syncQueue) append(msg *SyncStreamsResponse) *queuedMsg {
+	m := &queuedMsg{msg: msg, size: proto.Size(msg)}
+	q.msgs = append(q.msgs, m)
+	q.bytes += m.size
+	return m
+}
+
+// coalesce adds the update to the pending update of the stream. A sync reset replaces the pending update.
+func (q *syncQueue) coalesce(pending *queuedMsg, msg *SyncStreamsResponse) {
+	size := proto.Size(msg)
+	if msg.GetStream().GetSyncReset() {
+		q.bytes += size - pending.size
+		pending.size = size
+		pending.msg.Stream = msg.GetStream()
+	} else {
+		// updates can be shared with other sync sessions, don't modify them
+		prev := pending.msg.GetStream()
+		events := make([]*Envelope, 0, len(prev.GetEvents())+len(msg.GetStream().GetEvents()))
+		events = append(events, prev.GetEvents()...)
+		events = append(events, msg.GetStream().GetEvents()...)
+
+		pending.msg.Stream = &StreamAndCookie{
+			Events:         events,
+			NextSyncCookie: msg.GetStream().GetNextSyncCookie(),
+			Miniblocks:     prev.GetMiniblocks(),
+			SyncReset:      prev.GetSyncReset(),
+		}
+		pending.size += size
+		q.bytes += size
+	}
+
+	if q.metrics != nil {
+		q.metrics.coalescedUpdates.Inc()
+	}
+}
+
+// enforceBudget drops the largest pending updates until the queue is within its budget and returns the cookies of
+// their streams that must be reset. Sync resets are never dropped, if only they remain the budget can't be met.
+func (q *syncQueue) enforceBudget() ([]*SyncCookie, error) {
+	var resets []*SyncCookie
+	for q.bytes > q.budget {
+		var (
+			largestID StreamId
+			largest   *queuedMsg
+		)
+		for streamID, pending := range q.pending {
+			if !pending.msg.GetStream().GetSyncReset() && (largest == nil || pending.size > largest.size) {
+				largestID, largest = streamID, pending
+			}
+		}
+
+		if largest == nil {
+			if q.metrics != nil {
+				q.metrics.cancelledSessions.Inc()
+			}
+			return resets, RiverError(Err_BUFFER_FULL, "Client sync session buffer is full").
+				Tags("syncId", q.syncID, "bytes", q.bytes).
+				Func("syncQueue.enforceBudget")
+		}
+
+		largest.dropped = true
+		q.bytes -= largest.size
+		delete(q.pending, largestID)
+		q.resetting[largestID] = struct{}{}
+		resets = append(resets, largest.msg.GetStream().GetNextSyncCookie())
+
+		if q.metrics != nil {
+			q.metrics.streamResets.Inc()
+		}
+	}
+
+	q.reportLag()
+
+	return resets, nil
+}
+
+func (q *syncQueue) reportLag() {
+	lagging := q.bytes > q.budget/2
+	if q.metrics != nil {
+		if lagging {
+			q.metrics.laggingSessionBytes.WithLabelValues(q.syncID).Set(float64(q.bytes))
+		} else if q.lagging {
+			q.metrics.laggingSessionBytes.DeleteLabelValues(q.syncID)
+		}
+	}
+	q.lagging = lagging
+}
+
+// resetSyncCookie returns a cookie for the stream from which the stream node responds with a sync reset. The cookie
+// points to a miniblock that doesn't exist yet, which the stream node handles as an outdated cookie.
+func resetSyncCookie(cookie *SyncCookie) *SyncCookie {
+	return &SyncCookie{
+		NodeAddress:       cookie.GetNodeAddress(),
+		StreamId:          cookie.GetStreamId(),
+		MinipoolGen:       math.MaxInt64,
+		PrevMiniblockHash: cookie.GetPrevMiniblockHash(),
+	}
+}
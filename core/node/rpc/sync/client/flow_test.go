@@ -0,0 +1,100 @@
+package client
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestSyncQueue(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		stream1 = testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		stream2 = testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		event   = func(size int) *Envelope { return &Envelope{Event: make([]byte, size)} }
+		update  = func(streamID []byte, slot int64, reset bool, events ...*Envelope) *SyncStreamsResponse {
+			return &SyncStreamsResponse{
+				SyncOp: SyncOp_SYNC_UPDATE,
+				Stream: &StreamAndCookie{
+					Events: events,
+					NextSyncCookie: &SyncCookie{
+						StreamId:          streamID,
+						MinipoolGen:       1,
+						MinipoolSlot:      slot,
+						PrevMiniblockHash: []byte{1},
+					},
+					SyncReset: reset,
+				},
+			}
+		}
+	)
+
+	q := newSyncQueue("sync", FlowControl{BufferBytes: 1000})
+	require.Nil(q.peek())
+
+	// updates for a stream that wait to be sent are coalesced
+	first := update(stream1[:], 1, false, event(10))
+	resets, err := q.push(first)
+	require.NoError(err)
+	require.Empty(resets)
+	_, err = q.push(update(stream2[:], 1, false, event(10)))
+	require.NoError(err)
+	shared := update(stream1[:], 2, false, event(20))
+	_, err = q.push(shared)
+	require.NoError(err)
+
+	next := q.peek()
+	require.Same(first, next)
+	require.Len(next.GetStream().GetEvents(), 2)
+	require.EqualValues(2, next.GetStream().GetNextSyncCookie().GetMinipoolSlot())
+	require.Len(shared.GetStream().GetEvents(), 1, "coalesced update must not be modified")
+	q.pop()
+
+	// an update after the pending update was sent isn't coalesced
+	_, err = q.push(update(stream1[:], 3, false, event(10)))
+	require.NoError(err)
+	require.Len(q.msgs, 2)
+
+	q.pop()
+	q.pop()
+	require.Nil(q.peek())
+	require.Zero(q.bytes)
+
+	// the stream with the largest pending update is reset when the budget is exceeded
+	_, err = q.push(update(stream2[:], 2, false, event(100)))
+	require.NoError(err)
+	resets, err = q.push(update(stream1[:], 4, false, event(950)))
+	require.NoError(err)
+	require.Len(resets, 1)
+	require.Equal(stream1[:], resets[0].GetStreamId())
+
+	// updates are dropped until the sync reset arrives
+	_, err = q.push(update(stream1[:], 5, false, event(10)))
+	require.NoError(err)
+	require.Equal(SyncOp_SYNC_UPDATE, q.peek().GetSyncOp())
+	require.Equal(stream2[:], q.peek().GetStream().GetNextSyncCookie().GetStreamId())
+	q.pop()
+	require.Nil(q.peek())
+
+	_, err = q.push(update(stream1[:], 6, true, event(600)))
+	require.NoError(err)
+	require.True(q.lagging)
+	require.True(q.peek().GetStream().GetSyncReset())
+	q.pop()
+	require.False(q.lagging)
+
+	// the sync session is cancelled when only sync resets exceed the budget
+	_, err = q.push(update(stream1[:], 7, true, event(2000)))
+	require.True(IsRiverErrorCode(err, Err_BUFFER_FULL))
+
+	cookie := resetSyncCookie(resets[0])
+	require.EqualValues(math.MaxInt64, cookie.GetMinipoolGen())
+	require.Equal(stream1[:], cookie.GetStreamId())
+}
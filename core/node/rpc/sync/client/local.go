@@ -28,6 +28,9 @@ type localSyncer struct {
 	activeStreamsMu deadlock.Mutex
 	activeStreams   map[StreamId]*Stream
 
+	// filters restricts the events that are sent to the client
+	filters *streamFilters
+
 	// otelTracer is used to trace individual sync Send operations, tracing is disabled if nil
 	otelTracer trace.Tracer
 }
@@ -40,6 +43,7 @@ func newLocalSyncer(
 	streamCache *StreamCache,
 	cookies []*SyncCookie,
 	messages chan<- *SyncStreamsResponse,
+	filters *streamFilters,
 	otelTracer trace.Tracer,
 ) (*localSyncer, error) {
 	return &localSyncer{
@@ -51,6 +55,7 @@ func newLocalSyncer(
 		cookies:            cookies,
 		messages:           messages,
 		activeStreams:      make(map[StreamId]*Stream),
+		filters:            filters,
 		otelTracer:         otelTracer,
 	}, nil
 }
@@ -116,6 +121,10 @@ func (s *localSyncer) RemoveStream(ctx context.Context, streamID StreamId) (bool
 
 // OnUpdate is called each time a new cookie is available for a stream
 func (s *localSyncer) OnUpdate(r *StreamAndCookie) {
+	if r = s.filters.apply(r); r == nil {
+		return
+	}
+
 	select {
 	case s.messages <- &SyncStreamsResponse{SyncOp: SyncOp_SYNC_UPDATE, Stream: r}:
 		return
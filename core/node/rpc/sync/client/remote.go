@@ -32,6 +32,8 @@ type remoteSyncer struct {
 	streams            sync.Map
 	responseStream     *connect.ServerStreamForClient[SyncStreamsResponse]
 	unsubStream        func(streamID StreamId)
+	// filters restricts the events that are sent to the client, nil if not filtered
+	filters *streamFilters
 	// otelTracer is used to trace individual sync Send operations, tracing is disabled if nil
 	otelTracer trace.Tracer
 }
@@ -45,6 +47,7 @@ func newRemoteSyncer(
 	cookies []*SyncCookie,
 	unsubStream func(streamID StreamId),
 	messages chan<- *SyncStreamsResponse,
+	filters *streamFilters,
 	otelTracer trace.Tracer,
 ) (*remoteSyncer, error) {
 	syncStreamCtx, syncStreamCancel := context.WithCancel(ctx)
@@ -86,6 +89,7 @@ func newRemoteSyncer(
 		responseStream:     responseStream,
 		remoteAddr:         remoteAddr,
 		unsubStream:        unsubStream,
+		filters:            filters,
 		otelTracer:         otelTracer,
 	}
 
@@ -120,6 +124,9 @@ func (s *remoteSyncer) Run() {
 		res := s.responseStream.Msg()
 
 		if res.GetSyncOp() == SyncOp_SYNC_UPDATE {
+			if res.Stream = s.filters.apply(res.GetStream()); res.Stream == nil {
+				continue
+			}
 			if err := s.sendSyncStreamResponseToClient(res); err != nil {
 				if !errors.Is(err, context.Canceled) {
 					log.Errorw("Cancel remote sync with client", "remote", s.remoteAddr, "err", err)
@@ -0,0 +1,676 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/linkdata/deadlock"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/nodes"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+const (
+	// upstreamBufferSize is the number of updates from a remote node that can be buffered before they are
+	// fanned out to the subscribed sync operations
+	upstreamBufferSize = 1024
+	// catchUpTimeout is the max duration of the sync session that brings a late subscriber up to date
+	catchUpTimeout = 10 * time.Second
+	// removeStreamTimeout is the max duration to remove a stream from the upstream sync session
+	removeStreamTimeout = 10 * time.Second
+)
+
+type (
+	// RemoteStreamsMux multiplexes the subscriptions of all sync operations on this node on remote streams.
+	// It keeps one upstream sync session per remote node that syncs each stream once and fans out the received
+	// updates to the sync operations that subscribed on the stream. A stream is removed from the upstream sync
+	// session when the last sync operation unsubscribed.
+	//
+	// A sync operation that subscribes on a stream that is already synced is brought up to date with a short-lived
+	// sync session from its own cookie. Updates that are received in the meantime are queued and only the part
+	// after the position the catch-up ended at is forwarded to the sync operation.
+	RemoteStreamsMux struct {
+		// ctx is the root context for all upstream sync sessions
+		ctx context.Context
+		// nodeRegistry is used to find the endpoint of remote nodes
+		nodeRegistry nodes.NodeRegistry
+		// otelTracer is used to trace upstream operations, tracing is disabled if nil
+		otelTracer trace.Tracer
+		// mu guards upstreams, if both are needed upstream.mu must be claimed first
+		mu deadlock.Mutex
+		// upstreams holds the sync session for each remote node
+		upstreams map[common.Address]*upstream
+	}
+
+	// upstream is the shared sync session with a remote node.
+	upstream struct {
+		mux    *RemoteStreamsMux
+		remote common.Address
+		ctx    context.Context
+		cancel context.CancelCauseFunc
+		// messages receives the updates from syncer
+		messages chan *SyncStreamsResponse
+		// syncMu serializes the changes of the streams in the sync session with the remote so that they reach
+		// the remote in the order they were made in streams. It is held during the RPC to the remote, mu isn't.
+		// If both are needed syncMu must be claimed first.
+		syncMu deadlock.Mutex
+		// mu guards the fields below
+		mu deadlock.Mutex
+		// closed is true when the sync session stopped and must not be used for new subscriptions
+		closed bool
+		// syncer is the sync session with the remote, created for the first subscription
+		syncer *remoteSyncer
+		// streams holds the subscribers for each synced stream
+		streams map[StreamId]*sharedStream
+	}
+
+	// sharedStream is a stream that is synced through an upstream.
+	sharedStream struct {
+		// pos is the sync cookie after the last update that was received from the remote, nil before the first update
+		pos *SyncCookie
+		// subs holds the sync operations that subscribed on the stream
+		subs map[*sharedRemoteSyncer]*streamSubscription
+	}
+
+	// streamSubscription is the state of a subscription on a shared stream.
+	streamSubscription struct {
+		// catchingUp is true while the subscriber is brought up to date with the stream
+		catchingUp bool
+		// queued holds the updates that were received while the subscriber was catching up
+		queued []queuedUpdate
+		// after is the position the subscriber was brought up to, updates that end before it are skipped
+		after *SyncCookie
+	}
+
+	// queuedUpdate is an update for a subscriber that is catching up.
+	queuedUpdate struct {
+		// start is the stream position the update starts at
+		start  *SyncCookie
+		update *StreamAndCookie
+	}
+)
+
+// NewRemoteStreamsMux creates a multiplexer for subscriptions on remote streams. Upstream sync sessions are
+// cancelled when ctx expires.
+func NewRemoteStreamsMux(
+	ctx context.Context,
+	nodeRegistry nodes.NodeRegistry,
+	otelTracer trace.Tracer,
+) *RemoteStreamsMux {
+	return &RemoteStreamsMux{
+		ctx:          ctx,
+		nodeRegistry: nodeRegistry,
+		otelTracer:   otelTracer,
+		upstreams:    make(map[common.Address]*upstream),
+	}
+}
+
+// newSyncer creates the syncer for a sync operation that syncs streams from the remote through the multiplexer.
+func (m *RemoteStreamsMux) newSyncer(
+	ctx context.Context,
+	cancelGlobalSyncOp context.CancelCauseFunc,
+	syncID string,
+	remote common.Address,
+	messages chan<- *SyncStreamsResponse,
+	unsubStream func(streamID StreamId),
+	filters *streamFilters,
+) *sharedRemoteSyncer {
+	return &sharedRemoteSyncer{
+		ctx:                ctx,
+		cancelGlobalSyncOp: cancelGlobalSyncOp,
+		syncID:             syncID,
+		remote:             remote,
+		mux:                m,
+		messages:           messages,
+		unsubStream:        unsubStream,
+		filters:            filters,
+		streams:            make(map[StreamId]struct{}),
+	}
+}
+
+// upstream returns the sync session with the remote, it is created if it doesn't exist.
+func (m *RemoteStreamsMux) upstream(remote common.Address) *upstream {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	up, ok := m.upstreams[remote]
+	if !ok {
+		ctx, cancel := context.WithCancelCause(m.ctx)
+		up = &upstream{
+			mux:      m,
+			remote:   remote,
+			ctx:      ctx,
+			cancel:   cancel,
+			messages: make(chan *SyncStreamsResponse, upstreamBufferSize),
+			streams:  make(map[StreamId]*sharedStream),
+		}
+		m.upstreams[remote] = up
+	}
+	return up
+}
+
+// remove drops up from the set of upstream sync sessions if it is still the session for its remote.
+func (m *RemoteStreamsMux) remove(up *upstream) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.upstreams[up.remote] == up {
+		delete(m.upstreams, up.remote)
+	}
+}
+
+func (m *RemoteStreamsMux) subscribe(
+	ctx context.Context,
+	s *sharedRemoteSyncer,
+	streamID StreamId,
+	cookie *SyncCookie,
+) error {
+	for {
+		// retry with a new upstream when the upstream stopped concurrently
+		closed, err := m.upstream(s.remote).subscribe(ctx, s, streamID, cookie)
+		if !closed {
+			return err
+		}
+	}
+}
+
+func (m *RemoteStreamsMux) unsubscribe(s *sharedRemoteSyncer, streamID StreamId) {
+	m.mu.Lock()
+	up, ok := m.upstreams[s.remote]
+	m.mu.Unlock()
+
+	if ok {
+		up.unsubscribe(s, streamID)
+	}
+}
+
+// subscribe adds the subscriber to the stream. It returns true if the upstream is closed and the caller must
+// retry with a new upstream.
+func (up *upstream) subscribe(
+	ctx context.Context,
+	s *sharedRemoteSyncer,
+	streamID StreamId,
+	cookie *SyncCookie,
+) (bool, error) {
+	up.syncMu.Lock()
+	defer up.syncMu.Unlock()
+
+	up.mu.Lock()
+	if up.closed {
+		up.mu.Unlock()
+		return true, nil
+	}
+
+	if stream, ok := up.streams[streamID]; ok {
+		defer up.mu.Unlock()
+
+		if _, ok := stream.subs[s]; ok {
+			return false, nil
+		}
+
+		sub := &streamSubscription{}
+		stream.subs[s] = sub
+
+		if stream.pos != nil && compareSyncCookies(cookie, stream.pos) == 0 {
+			// subscriber is up to date, confirm its position as a catch-up without events would
+			s.deliverUpdate(&StreamAndCookie{NextSyncCookie: stream.pos})
+			return false, nil
+		}
+
+		sub.catchingUp = true
+		go up.catchUp(s, streamID, sub, cookie)
+		return false, nil
+	}
+
+	// first subscriber on the stream, it receives all updates from the upstream sync session. The stream is added
+	// before the remote is asked to sync it to not miss the first update. The position is unknown until the first
+	// update is received, the cookie can be for a sync reset.
+	stream := &sharedStream{
+		subs: map[*sharedRemoteSyncer]*streamSubscription{s: {}},
+	}
+	up.streams[streamID] = stream
+	syncer := up.syncer
+	up.mu.Unlock()
+
+	var err error
+	if syncer == nil {
+		syncer, err = up.startSyncer(cookie)
+	} else {
+		err = syncer.AddStream(ctx, cookie)
+	}
+
+	up.mu.Lock()
+	if err == nil {
+		if up.syncer == nil {
+			up.syncer = syncer
+			go up.run()
+		}
+		up.mu.Unlock()
+		return false, nil
+	}
+
+	// the remote doesn't sync the stream, subscribers that joined in the meantime are reported as down
+	var downs []func()
+	if up.streams[streamID] == stream {
+		delete(up.streams, streamID)
+		for sub := range stream.subs {
+			if sub != s {
+				downs = append(downs, func() { sub.streamDown(streamID) })
+			}
+		}
+	}
+	if (up.syncer == nil || len(up.streams) == 0) && !up.closed {
+		downs = append(downs, up.closeLocked(err)...)
+	}
+	up.mu.Unlock()
+
+	for _, down := range downs {
+		down()
+	}
+	return false, err
+}
+
+// startSyncer starts the sync session with the remote for the first stream.
+func (up *upstream) startSyncer(cookie *SyncCookie) (*remoteSyncer, error) {
+	client, err := up.mux.nodeRegistry.GetStreamServiceClientForAddress(up.remote)
+	if err != nil {
+		return nil, err
+	}
+
+	syncer, err := newRemoteSyncer(
+		up.ctx, up.cancel, "", up.remote, client, []*SyncCookie{cookie},
+		func(StreamId) {}, up.messages, nil, up.mux.otelTracer)
+	if syncer == nil {
+		if err == nil {
+			err = RiverError(Err_UNAVAILABLE, "Unable to start upstream sync session").
+				Tag("remote", up.remote)
+		}
+		return nil, err
+	}
+	return syncer, nil
+}
+
+// unsubscribe removes the subscriber from the stream and the stream from the upstream sync session if it was the
+// last subscriber.
+func (up *upstream) unsubscribe(s *sharedRemoteSyncer, streamID StreamId) {
+	up.mu.Lock()
+	stream, ok := up.streams[streamID]
+	if ok {
+		delete(stream.subs, s)
+	}
+	up.mu.Unlock()
+
+	if ok {
+		up.removeStreamIfUnused(streamID)
+	}
+}
+
+// removeStreamIfUnused removes the stream from the upstream sync session if it has no subscribers. The stream is
+// kept when a new subscriber joined after the last one left. The upstream sync session is stopped when it has no
+// more streams.
+func (up *upstream) removeStreamIfUnused(streamID StreamId) {
+	up.syncMu.Lock()
+	defer up.syncMu.Unlock()
+
+	up.mu.Lock()
+	stream, ok := up.streams[streamID]
+	if up.closed || !ok || len(stream.subs) > 0 {
+		up.mu.Unlock()
+		return
+	}
+	delete(up.streams, streamID)
+	up.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(up.ctx, removeStreamTimeout)
+	defer cancel()
+
+	if _, err := up.syncer.RemoveStream(ctx, streamID); err != nil {
+		// the remote keeps sending updates for the stream, they are dropped because it has no subscribers
+		logging.FromCtx(up.ctx).Warnw("Unable to remove stream from upstream sync",
+			"remote", up.remote, "stream", streamID, "err", err)
+	}
+
+	// the syncer also reports no more streams when the remove failed, use the streams of the upstream instead
+	up.mu.Lock()
+	if len(up.streams) == 0 && !up.closed {
+		up.closeLocked(nil)
+	}
+	up.mu.Unlock()
+}
+
+// closeLocked stops the upstream sync session and removes it from the multiplexer. It returns the functions that
+// report the remaining streams as down to their subscribers, they must be called without up.mu claimed.
+func (up *upstream) closeLocked(cause error) []func() {
+	up.closed = true
+	up.cancel(cause)
+	up.mux.remove(up)
+
+	var downs []func()
+	for streamID, stream := range up.streams {
+		for s := range stream.subs {
+			downs = append(downs, func() { s.streamDown(streamID) })
+		}
+	}
+	up.streams = make(map[StreamId]*sharedStream)
+
+	return downs
+}
+
+// run fans out the updates from the remote until the upstream sync session stopped.
+func (up *upstream) run() {
+	go func() {
+		up.syncer.Run()
+		close(up.messages)
+	}()
+
+	for msg := range up.messages {
+		up.fanOut(msg)
+	}
+
+	logging.FromCtx(up.ctx).Infow("Upstream sync session stopped", "remote", up.remote)
+
+	up.mu.Lock()
+	downs := up.closeLocked(nil)
+	up.mu.Unlock()
+
+	for _, down := range downs {
+		down()
+	}
+}
+
+func (up *upstream) fanOut(msg *SyncStreamsResponse) {
+	var downs []func()
+
+	up.mu.Lock()
+	if msg.GetSyncOp() == SyncOp_SYNC_UPDATE {
+		streamID, err := StreamIdFromBytes(msg.GetStream().GetNextSyncCookie().GetStreamId())
+		if stream, ok := up.streams[streamID]; err == nil && ok {
+			start := stream.pos
+			stream.pos = msg.GetStream().GetNextSyncCookie()
+			for s, sub := range stream.subs {
+				if sub.catchingUp {
+					sub.queued = append(sub.queued, queuedUpdate{start: start, update: msg.GetStream()})
+				} else {
+					up.forwardLocked(s, streamID, sub, start, msg.GetStream())
+				}
+			}
+		}
+	} else if msg.GetSyncOp() == SyncOp_SYNC_DOWN {
+		streamID, err := StreamIdFromBytes(msg.GetStreamId())
+		if stream, ok := up.streams[streamID]; err == nil && ok {
+			delete(up.streams, streamID)
+			for s := range stream.subs {
+				downs = append(downs, func() { s.streamDown(streamID) })
+			}
+		}
+	}
+	up.mu.Unlock()
+
+	for _, down := range downs {
+		down()
+	}
+}
+
+// forwardLocked sends the update that starts at the given position to the subscriber. Updates that the subscriber
+// already received with its catch-up are skipped. If the update doesn't line up with the position of the subscriber
+// it is brought up to date again.
+func (up *upstream) forwardLocked(
+	s *sharedRemoteSyncer,
+	streamID StreamId,
+	sub *streamSubscription,
+	start *SyncCookie,
+	update *StreamAndCookie,
+) {
+	if sub.after != nil {
+		if compareSyncCookies(update.GetNextSyncCookie(), sub.after) <= 0 {
+			return
+		}
+		if !update.GetSyncReset() && compareSyncCookies(start, sub.after) != 0 {
+			sub.catchingUp = true
+			go up.catchUp(s, streamID, sub, sub.after)
+			return
+		}
+		sub.after = nil
+	}
+
+	s.deliverUpdate(update)
+}
+
+// catchUp brings the subscriber up to date from the given cookie.
+func (up *upstream) catchUp(s *sharedRemoteSyncer, streamID StreamId, sub *streamSubscription, cookie *SyncCookie) {
+	update, err := up.fetchUpdate(cookie)
+	if err != nil {
+		logging.FromCtx(up.ctx).Warnw("Unable to catch up on shared stream",
+			"remote", up.remote, "stream", streamID, "err", err)
+	}
+
+	up.mu.Lock()
+	down := up.finishCatchUpLocked(s, streamID, sub, update, err)
+	up.mu.Unlock()
+
+	if down {
+		up.removeStreamIfUnused(streamID)
+		s.streamDown(streamID)
+	}
+}
+
+// finishCatchUpLocked sends the catch-up update to the subscriber followed by the queued updates. It returns true if
+// the catch-up failed, the subscriber is then removed from the stream and the stream must be reported as down to it.
+func (up *upstream) finishCatchUpLocked(
+	s *sharedRemoteSyncer,
+	streamID StreamId,
+	sub *streamSubscription,
+	update *StreamAndCookie,
+	err error,
+) bool {
+	stream, ok := up.streams[streamID]
+	if !ok || stream.subs[s] != sub || !sub.catchingUp {
+		return false // subscriber is gone
+	}
+
+	if err != nil {
+		delete(stream.subs, s)
+		return true
+	}
+
+	s.deliverUpdate(update)
+
+	sub.catchingUp = false
+	sub.after = update.GetNextSyncCookie()
+
+	queued := sub.queued
+	sub.queued = nil
+	for _, q := range queued {
+		if sub.catchingUp {
+			sub.queued = append(sub.queued, q)
+		} else {
+			up.forwardLocked(s, streamID, sub, q.start, q.update)
+		}
+	}
+
+	return false
+}
+
+// fetchUpdate runs a short-lived sync session on the remote that returns the update from the given cookie to the
+// current position of the stream.
+func (up *upstream) fetchUpdate(cookie *SyncCookie) (*StreamAndCookie, error) {
+	ctx, cancel := context.WithTimeout(up.ctx, catchUpTimeout)
+	defer cancel()
+
+	res, err := up.syncer.client.SyncStreams(ctx, connect.NewRequest(&SyncStreamsRequest{
+		SyncPos: []*SyncCookie{cookie},
+	}))
+	if err != nil {
+		return nil, AsRiverError(err)
+	}
+	defer func() {
+		cancel()
+		_ = res.Close()
+	}()
+
+	for res.Receive() {
+		msg := res.Msg()
+		if msg.GetSyncOp() == SyncOp_SYNC_UPDATE {
+			return msg.GetStream(), nil
+		}
+		if msg.GetSyncOp() == SyncOp_SYNC_DOWN {
+			return nil, RiverError(Err_UNAVAILABLE, "Stream down during catch-up").Tag("remote", up.remote)
+		}
+	}
+
+	if err := res.Err(); err != nil {
+		return nil, AsRiverError(err)
+	}
+	return nil, RiverError(Err_UNAVAILABLE, "Catch-up sync closed by remote").Tag("remote", up.remote)
+}
+
+// compareSyncCookies compares the stream positions of the given cookies.
+func compareSyncCookies(a, b *SyncCookie) int {
+	if a.GetMinipoolGen() != b.GetMinipoolGen() {
+		if a.GetMinipoolGen() < b.GetMinipoolGen() {
+			return -1
+		}
+		return 1
+	}
+	if a.GetMinipoolSlot() != b.GetMinipoolSlot() {
+		if a.GetMinipoolSlot() < b.GetMinipoolSlot() {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// sharedRemoteSyncer is the StreamsSyncer of a sync operation for the streams of a remote node that are synced
+// through the RemoteStreamsMux.
+type sharedRemoteSyncer struct {
+	// ctx is the context of the sync operation
+	ctx                context.Context
+	cancelGlobalSyncOp context.CancelCauseFunc
+	syncID             string
+	remote             common.Address
+	mux                *RemoteStreamsMux
+	messages           chan<- *SyncStreamsResponse
+	unsubStream        func(streamID StreamId)
+	// filters restricts the events that are sent to the client
+	filters *streamFilters
+	// mu guards stopped and streams
+	mu      sync.Mutex
+	stopped bool
+	streams map[StreamId]struct{}
+}
+
+var (
+	_ StreamsSyncer      = (*sharedRemoteSyncer)(nil)
+	_ DebugStreamsSyncer = (*sharedRemoteSyncer)(nil)
+)
+
+// Run waits until the sync operation stopped and unsubscribes its streams.
+func (s *sharedRemoteSyncer) Run() {
+	<-s.ctx.Done()
+
+	s.mu.Lock()
+	s.stopped = true
+	streams := s.streams
+	s.streams = make(map[StreamId]struct{})
+	s.mu.Unlock()
+
+	for streamID := range streams {
+		s.mux.unsubscribe(s, streamID)
+	}
+}
+
+func (s *sharedRemoteSyncer) Address() common.Address {
+	return s.remote
+}
+
+func (s *sharedRemoteSyncer) AddStream(ctx context.Context, cookie *SyncCookie) error {
+	streamID, err := StreamIdFromBytes(cookie.GetStreamId())
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return RiverError(Err_CANCELED, "Sync operation stopped", "syncId", s.syncID)
+	}
+	s.streams[streamID] = struct{}{}
+	s.mu.Unlock()
+
+	if err := s.mux.subscribe(ctx, s, streamID, cookie); err != nil {
+		s.mu.Lock()
+		delete(s.streams, streamID)
+		s.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// RemoveStream unsubscribes from the stream. The syncer keeps running without streams because it doesn't hold a
+// connection with the remote.
+func (s *sharedRemoteSyncer) RemoveStream(_ context.Context, streamID StreamId) (bool, error) {
+	s.mu.Lock()
+	_, ok := s.streams[streamID]
+	delete(s.streams, streamID)
+	s.mu.Unlock()
+
+	if ok {
+		s.mux.unsubscribe(s, streamID)
+	}
+
+	return false, nil
+}
+
+func (s *sharedRemoteSyncer) DebugDropStream(ctx context.Context, streamID StreamId) (bool, error) {
+	if _, err := s.RemoveStream(ctx, streamID); err != nil {
+		return false, err
+	}
+
+	s.deliver(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]})
+
+	return false, nil
+}
+
+// streamDown removes the stream from the sync operation and notifies the client.
+func (s *sharedRemoteSyncer) streamDown(streamID StreamId) {
+	s.mu.Lock()
+	_, ok := s.streams[streamID]
+	delete(s.streams, streamID)
+	s.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	s.unsubStream(streamID)
+	s.deliver(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]})
+}
+
+// deliverUpdate sends the update with the events that pass the filter of the stream.
+func (s *sharedRemoteSyncer) deliverUpdate(update *StreamAndCookie) {
+	if update = s.filters.apply(update); update != nil {
+		s.deliver(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_UPDATE, Stream: update})
+	}
+}
+
+// deliver writes msg to the message channel of the sync operation without blocking the upstream. The sync operation
+// is cancelled if the channel is full.
+func (s *sharedRemoteSyncer) deliver(msg *SyncStreamsResponse) {
+	select {
+	case s.messages <- msg:
+	case <-s.ctx.Done():
+	default:
+		s.cancelGlobalSyncOp(RiverError(Err_BUFFER_FULL, "Client sync subscription message channel is full").
+			Tag("syncId", s.syncID).
+			Func("sharedRemoteSyncer.deliver"))
+	}
+}
@@ -0,0 +1,198 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestRemoteStreamsMuxFanOut(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	remote := common.Address{1}
+	streamID := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	cookie := func(gen int64, slot int64) *SyncCookie {
+		return &SyncCookie{
+			NodeAddress:  remote[:],
+			StreamId:     streamID[:],
+			MinipoolGen:  gen,
+			MinipoolSlot: slot,
+		}
+	}
+	update := func(gen int64, slot int64) *SyncStreamsResponse {
+		return &SyncStreamsResponse{
+			SyncOp: SyncOp_SYNC_UPDATE,
+			Stream: &StreamAndCookie{NextSyncCookie: cookie(gen, slot)},
+		}
+	}
+
+	mux := NewRemoteStreamsMux(ctx, nil, nil)
+	up := mux.upstream(remote)
+
+	var unsubscribed []StreamId
+	newSubscriber := func() (*sharedRemoteSyncer, chan *SyncStreamsResponse) {
+		messages := make(chan *SyncStreamsResponse, 16)
+		s := mux.newSyncer(ctx, cancel, "sync", remote, messages, func(streamID StreamId) {
+			unsubscribed = append(unsubscribed, streamID)
+		}, nil)
+		s.streams[streamID] = struct{}{}
+		return s, messages
+	}
+	receive := func(messages chan *SyncStreamsResponse) []*SyncStreamsResponse {
+		var msgs []*SyncStreamsResponse
+		for {
+			select {
+			case msg := <-messages:
+				msgs = append(msgs, msg)
+			default:
+				return msgs
+			}
+		}
+	}
+
+	first, firstMsgs := newSubscriber()
+	late, lateMsgs := newSubscriber()
+	lateSub := &streamSubscription{catchingUp: true}
+	up.streams[streamID] = &sharedStream{
+		pos:  cookie(1, 0),
+		subs: map[*sharedRemoteSyncer]*streamSubscription{first: {}, late: lateSub},
+	}
+
+	// updates are forwarded to subscribers that are up to date and queued for subscribers that catch up
+	up.fanOut(update(1, 1))
+	up.fanOut(update(1, 2))
+
+	msgs := receive(firstMsgs)
+	require.Len(msgs, 2)
+	require.EqualValues(2, msgs[1].GetStream().GetNextSyncCookie().GetMinipoolSlot())
+	require.Empty(receive(lateMsgs))
+
+	// queued updates that the late subscriber received with its catch-up are skipped
+	up.mu.Lock()
+	down := up.finishCatchUpLocked(late, streamID, lateSub, update(1, 1).GetStream(), nil)
+	up.mu.Unlock()
+	require.False(down)
+
+	msgs = receive(lateMsgs)
+	require.Len(msgs, 2)
+	require.EqualValues(1, msgs[0].GetStream().GetNextSyncCookie().GetMinipoolSlot())
+	require.EqualValues(2, msgs[1].GetStream().GetNextSyncCookie().GetMinipoolSlot())
+
+	// a subscriber at the current position doesn't need to catch up
+	current, currentMsgs := newSubscriber()
+	closed, err := up.subscribe(ctx, current, streamID, cookie(1, 2))
+	require.False(closed)
+	require.NoError(err)
+	msgs = receive(currentMsgs)
+	require.Len(msgs, 1)
+	require.Empty(msgs[0].GetStream().GetEvents())
+	require.EqualValues(2, msgs[0].GetStream().GetNextSyncCookie().GetMinipoolSlot())
+
+	// the stream is reported as down when the catch-up failed
+	failed, failedMsgs := newSubscriber()
+	failedSub := &streamSubscription{catchingUp: true}
+	up.streams[streamID].subs[failed] = failedSub
+
+	up.mu.Lock()
+	down = up.finishCatchUpLocked(failed, streamID, failedSub, nil, errors.New("unavailable"))
+	up.mu.Unlock()
+	require.True(down)
+	failed.streamDown(streamID)
+
+	msgs = receive(failedMsgs)
+	require.Len(msgs, 1)
+	require.Equal(SyncOp_SYNC_DOWN, msgs[0].GetSyncOp())
+	require.Equal([]StreamId{streamID}, unsubscribed)
+
+	// stream down is sent to all subscribers
+	up.fanOut(update(1, 3))
+	up.fanOut(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]})
+
+	for _, messages := range []chan *SyncStreamsResponse{firstMsgs, lateMsgs, currentMsgs} {
+		msgs = receive(messages)
+		require.Len(msgs, 2)
+		require.Equal(SyncOp_SYNC_UPDATE, msgs[0].GetSyncOp())
+		require.Equal(SyncOp_SYNC_DOWN, msgs[1].GetSyncOp())
+	}
+	require.Empty(receive(failedMsgs))
+	require.Empty(up.streams)
+	require.NoError(ctx.Err())
+}
+
+// failingStreamClient fails to add streams to and remove streams from a sync session.
+type failingStreamClient struct {
+	protocolconnect.StreamServiceClient
+}
+
+func (failingStreamClient) AddStreamToSync(
+	context.Context,
+	*connect.Request[AddStreamToSyncRequest],
+) (*connect.Response[AddStreamToSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
+}
+
+func (failingStreamClient) RemoveStreamFromSync(
+	context.Context,
+	*connect.Request[RemoveStreamFromSyncRequest],
+) (*connect.Response[RemoveStreamFromSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
+}
+
+func TestRemoteStreamsMuxReconcile(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	remote := common.Address{1}
+	synced := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	failed := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	mux := NewRemoteStreamsMux(ctx, nil, nil)
+	up := mux.upstream(remote)
+	syncCtx, syncCancel := context.WithCancel(up.ctx)
+	up.syncer = &remoteSyncer{
+		syncStreamCtx:    syncCtx,
+		syncStreamCancel: syncCancel,
+		client:           failingStreamClient{},
+	}
+	up.syncer.streams.Store(synced, struct{}{})
+
+	s := mux.newSyncer(ctx, cancel, "sync", remote, make(chan *SyncStreamsResponse, 16), func(StreamId) {}, nil)
+	up.streams[synced] = &sharedStream{subs: map[*sharedRemoteSyncer]*streamSubscription{s: {}}}
+
+	// the stream isn't kept when the remote fails to sync it
+	closed, err := up.subscribe(ctx, s, failed, &SyncCookie{NodeAddress: remote[:], StreamId: failed[:]})
+	require.False(closed)
+	require.Error(err)
+	require.NotContains(up.streams, failed)
+	require.Contains(up.streams, synced)
+	require.False(up.closed)
+
+	// the upstream is stopped when its last stream is removed even if the remote failed to remove it
+	up.unsubscribe(s, synced)
+	require.Empty(up.streams)
+	require.True(up.closed)
+	require.Empty(mux.upstreams)
+}
+
+func TestCompareSyncCookies(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(0, compareSyncCookies(
+		&SyncCookie{MinipoolGen: 2, MinipoolSlot: 3}, &SyncCookie{MinipoolGen: 2, MinipoolSlot: 3}))
+	require.Equal(-1, compareSyncCookies(
+		&SyncCookie{MinipoolGen: 2, MinipoolSlot: 3}, &SyncCookie{MinipoolGen: 2, MinipoolSlot: 4}))
+	require.Equal(1, compareSyncCookies(
+		&SyncCookie{MinipoolGen: 3, MinipoolSlot: 0}, &SyncCookie{MinipoolGen: 2, MinipoolSlot: 4}))
+}
@@ -38,12 +38,16 @@ type (
 		syncID string
 		// localNodeAddress is the node address for this stream node instance
 		localNodeAddress common.Address
-		// messages is the channel to which StreamsSyncers write updates that must be sent to the client
+		// messages is the channel to which StreamsSyncers write updates that must be sent to the client, they are
+		// buffered in a syncQueue until the sync operation is able to send them
 		messages chan *SyncStreamsResponse
 		// streamCache is used to subscribe to streams managed by this node instance
 		streamCache *StreamCache
 		// nodeRegistry keeps a mapping from node address to node meta-data
 		nodeRegistry nodes.NodeRegistry
+		// remotes shares the subscriptions on remote streams with other sync operations,
+		// each syncer connects to its remote if nil
+		remotes *RemoteStreamsMux
 		// syncerTasks is a wait group for running background StreamsSyncers that is used to ensure all syncers stopped
 		syncerTasks sync.WaitGroup
 		// muSyncers guards syncers and streamID2Syncer
@@ -54,6 +58,8 @@ type (
 		syncers map[common.Address]StreamsSyncer
 		// streamID2Syncer maps from a stream to its syncer
 		streamID2Syncer map[StreamId]StreamsSyncer
+		// filters holds the event filters of the streams, they are applied by the syncers
+		filters *streamFilters
 		// otelTracer is used to trace individual sync Send operations, tracing is disabled if nil
 		otelTracer trace.Tracer
 	}
@@ -89,8 +95,10 @@ func NewSyncers(
 	syncID string,
 	streamCache *StreamCache,
 	nodeRegistry nodes.NodeRegistry,
+	remotes *RemoteStreamsMux,
 	localNodeAddress common.Address,
 	cookies StreamCookieSetGroupedByNodeAddress,
+	flow FlowControl,
 	otelTracer trace.Tracer,
 ) (*SyncerSet, chan *SyncStreamsResponse, error) {
 	var (
@@ -104,9 +112,11 @@ func NewSyncers(
 			syncID:                syncID,
 			streamCache:           streamCache,
 			nodeRegistry:          nodeRegistry,
+			remotes:               remotes,
 			localNodeAddress:      localNodeAddress,
 			syncers:               syncers,
 			streamID2Syncer:       streamID2Syncer,
+			filters:               &streamFilters{},
 			messages:              messages,
 			otelTracer:            otelTracer,
 		}
@@ -132,11 +142,25 @@ func NewSyncers(
 		if nodeAddress == localNodeAddress { // stream managed by this node
 			syncer, err := newLocalSyncer(
 				ctx, syncID, globalSyncOpCtxCancel, localNodeAddress,
-				streamCache, cookieSet.AsSlice(), messages, ss.otelTracer)
+				streamCache, cookieSet.AsSlice(), messages, ss.filters, ss.otelTracer)
 			if err != nil {
 				return nil, nil, err
 			}
 			syncers[nodeAddress] = syncer
+		} else if remotes != nil {
+			syncer := remotes.newSyncer(
+				ctx, globalSyncOpCtxCancel, syncID, nodeAddress, messages, ss.rmStream, ss.filters)
+			for streamID, cookie := range cookieSet {
+				if err := syncer.AddStream(ctx, cookie); err != nil {
+					log.Warnw("Unable to subscribe on remote stream when starting stream sync",
+						"err", err, "remoteNode", nodeAddress, "stream", streamID)
+					delete(cookieSet, streamID)
+					go unavailableRemote(SyncCookieSet{streamID: cookie})
+				}
+			}
+			syncers[nodeAddress] = syncer
+			// unsubscribes from the shared streams when the sync operation stops
+			ss.startSyncer(syncer)
 		} else {
 			client, err := nodeRegistry.GetStreamServiceClientForAddress(nodeAddress)
 			if err != nil {
@@ -148,7 +172,7 @@ func NewSyncers(
 
 			syncer, err := newRemoteSyncer(
 				ctx, globalSyncOpCtxCancel, syncID, nodeAddress, client, cookieSet.AsSlice(),
-				ss.rmStream, messages, ss.otelTracer)
+				ss.rmStream, messages, ss.filters, ss.otelTracer)
 			if err != nil {
 				log.Warnw("Unable to connect to remote stream when starting stream sync",
 					"err", err, "remoteNode", nodeAddress)
@@ -166,7 +190,101 @@ func NewSyncers(
 		}
 	}
 
-	return ss, messages, nil
+	// updates are buffered and coalesced when the client doesn't keep up
+	out := make(chan *SyncStreamsResponse)
+	go ss.forward(newSyncQueue(syncID, flow), out)
+
+	return ss, out, nil
+}
+
+// forward moves messages from the syncers to the sync operation. Messages are buffered in the queue while the
+// sync operation is busy sending to the client. Streams are reset when the client falls too far behind and the sync
+// operation is cancelled when that doesn't keep the buffered updates within budget.
+func (ss *SyncerSet) forward(queue *syncQueue, out chan<- *SyncStreamsResponse) {
+	log := logging.FromCtx(ss.ctx)
+	defer queue.close()
+
+	for {
+		var (
+			next = queue.peek()
+			outC chan<- *SyncStreamsResponse
+		)
+		if next != nil {
+			outC = out
+		}
+
+		select {
+		case msg := <-ss.messages:
+			wasLagging := queue.lagging
+			resets, err := queue.push(msg)
+			if !wasLagging && queue.lagging {
+				log.Infow("Client falls behind on sync session", "syncId", ss.syncID, "bytes", queue.bytes)
+			}
+			for _, cookie := range resets {
+				log.Infow("Reset stream for client that fell behind",
+					"syncId", ss.syncID, "stream", cookie.GetStreamId())
+				go ss.resetStream(cookie)
+			}
+			if err != nil {
+				log.Warnw("Cancel sync session for client that fell behind", "syncId", ss.syncID, "err", err)
+				ss.globalSyncOpCtxCancel(err)
+				return
+			}
+		case outC <- next:
+			queue.pop()
+		case <-ss.ctx.Done():
+			return
+		}
+	}
+}
+
+// resetStream subscribes again on the stream with a cookie for which the stream node responds with a sync reset.
+// The stream is reported as down if that fails or the stream isn't synced anymore, which also ends the reset in
+// the queue.
+func (ss *SyncerSet) resetStream(cookie *SyncCookie) {
+	streamID, err := StreamIdFromBytes(cookie.GetStreamId())
+	if err != nil {
+		return
+	}
+
+	ss.muSyncers.Lock()
+	defer ss.muSyncers.Unlock()
+
+	if ss.stopped {
+		return
+	}
+
+	syncer, found := ss.streamID2Syncer[streamID]
+	if !found {
+		go ss.streamDown(streamID)
+		return
+	}
+
+	syncerStopped, err := syncer.RemoveStream(ss.ctx, streamID)
+	if err != nil {
+		logging.FromCtx(ss.ctx).Warnw("Unable to unsubscribe from stream to reset",
+			"syncId", ss.syncID, "stream", streamID, "err", err)
+	}
+	delete(ss.streamID2Syncer, streamID)
+	if syncerStopped {
+		delete(ss.syncers, syncer.Address())
+	}
+
+	nodeAddress := common.BytesToAddress(cookie.GetNodeAddress())
+	if err := ss.addStreamLocked(ss.ctx, nodeAddress, streamID, resetSyncCookie(cookie)); err != nil {
+		logging.FromCtx(ss.ctx).Warnw("Unable to reset stream",
+			"syncId", ss.syncID, "stream", streamID, "err", err)
+		ss.filters.remove(streamID)
+		go ss.streamDown(streamID)
+	}
+}
+
+// streamDown reports to the client that it doesn't receive updates for the stream anymore.
+func (ss *SyncerSet) streamDown(streamID StreamId) {
+	select {
+	case ss.messages <- &SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]}:
+	case <-ss.ctx.Done():
+	}
 }
 
 func (ss *SyncerSet) Run() {
@@ -179,11 +297,14 @@ func (ss *SyncerSet) Run() {
 	ss.syncerTasks.Wait() // background syncers finished -> safe to close messages channel
 }
 
+// AddStream adds the stream to the sync operation. If filter is not nil only the events that pass the filter are
+// sent to the client.
 func (ss *SyncerSet) AddStream(
 	ctx context.Context,
 	nodeAddress common.Address,
 	streamID StreamId,
 	cookie *SyncCookie,
+	filter *SyncFilter,
 ) error {
 	if ss.otelTracer != nil {
 		_, span := ss.otelTracer.Start(ctx, "AddStream",
@@ -203,6 +324,27 @@ func (ss *SyncerSet) AddStream(
 		return nil // stream is already part of sync operation
 	}
 
+	// set the filter before the syncer sends the first update
+	if err := ss.filters.set(streamID, filter); err != nil {
+		return err
+	}
+
+	if err := ss.addStreamLocked(ctx, nodeAddress, streamID, cookie); err != nil {
+		ss.filters.remove(streamID)
+		return err
+	}
+
+	return nil
+}
+
+// addStreamLocked subscribes on the stream with the syncer for the node, the syncer is created if it doesn't exist.
+// Caller must have ss.muSyncers claimed.
+func (ss *SyncerSet) addStreamLocked(
+	ctx context.Context,
+	nodeAddress common.Address,
+	streamID StreamId,
+	cookie *SyncCookie,
+) error {
 	// check if there is already a syncer that can sync the given stream -> add stream to the syncer
 	if syncer, found := ss.syncers[nodeAddress]; found {
 		if err := syncer.AddStream(ctx, cookie); err != nil {
@@ -225,7 +367,7 @@ func (ss *SyncerSet) AddStream(
 		}
 		if syncer, err = newLocalSyncer(
 			ss.ctx, ss.syncID, ss.globalSyncOpCtxCancel, ss.localNodeAddress,
-			ss.streamCache, []*SyncCookie{cookie}, ss.messages, ss.otelTracer); err != nil {
+			ss.streamCache, []*SyncCookie{cookie}, ss.messages, ss.filters, ss.otelTracer); err != nil {
 			if span != nil {
 				span.End()
 			}
@@ -234,6 +376,13 @@ func (ss *SyncerSet) AddStream(
 		if span != nil {
 			span.End()
 		}
+	} else if ss.remotes != nil {
+		shared := ss.remotes.newSyncer(
+			ss.ctx, ss.globalSyncOpCtxCancel, ss.syncID, nodeAddress, ss.messages, ss.rmStream, ss.filters)
+		if err := shared.AddStream(ctx, cookie); err != nil {
+			return err
+		}
+		syncer = shared
 	} else {
 		client, err := ss.nodeRegistry.GetStreamServiceClientForAddress(nodeAddress)
 		if err != nil {
@@ -247,7 +396,7 @@ func (ss *SyncerSet) AddStream(
 		}
 		if syncer, err = newRemoteSyncer(
 			ss.ctx, ss.globalSyncOpCtxCancel, ss.syncID, nodeAddress, client,
-			[]*SyncCookie{cookie}, ss.rmStream, ss.messages, ss.otelTracer); err != nil {
+			[]*SyncCookie{cookie}, ss.rmStream, ss.messages, ss.filters, ss.otelTracer); err != nil {
 			if span != nil {
 				span.End()
 			}
@@ -295,6 +444,7 @@ func (ss *SyncerSet) RemoveStream(ctx context.Context, streamID StreamId) error
 	}
 
 	delete(ss.streamID2Syncer, streamID)
+	ss.filters.remove(streamID)
 	if syncerStopped {
 		delete(ss.syncers, syncer.Address())
 	}
@@ -325,6 +475,7 @@ func (ss *SyncerSet) DebugDropStream(ctx context.Context, streamID StreamId) err
 	}
 
 	delete(ss.streamID2Syncer, streamID)
+	ss.filters.remove(streamID)
 	if syncerStopped {
 		delete(ss.syncers, syncer.Address())
 	}
@@ -358,4 +509,5 @@ func (ss *SyncerSet) rmStream(streamID StreamId) {
 	ss.muSyncers.Lock()
 	delete(ss.streamID2Syncer, streamID)
 	ss.muSyncers.Unlock()
+	ss.filters.remove(streamID)
 }
@@ -7,10 +7,13 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/towns-protocol/towns/core/config"
 	. "github.com/towns-protocol/towns/core/node/base"
 	. "github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/infra"
 	"github.com/towns-protocol/towns/core/node/nodes"
 	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/rpc/sync/client"
 	"github.com/towns-protocol/towns/core/node/shared"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -21,12 +24,14 @@ type (
 	// Handler defines the external grpc interface that clients can call.
 	Handler interface {
 		// SyncStreams runs a stream sync operation that subscribes to streams on the local node and remote nodes.
+		// If the request has a resume sync id the resumable sync operation with that id is continued instead.
+		// Updates are sent to res, which is a connect server stream or a WebSocket or SSE connection.
 		// It returns syncId, if any and an error.
 		SyncStreams(
 			ctx context.Context,
 			syncId string,
 			req *connect.Request[SyncStreamsRequest],
-			res *connect.ServerStream[SyncStreamsResponse],
+			res StreamsResponseSubscriber,
 		) error
 
 		AddStreamToSync(
@@ -67,6 +72,12 @@ type (
 		streamCache *StreamCache
 		// nodeRegistry is used to find a node endpoint to subscribe on remote streams
 		nodeRegistry nodes.NodeRegistry
+		// remotes shares subscriptions on remote streams between sync operations
+		remotes *client.RemoteStreamsMux
+		// flowMetrics reports sync operations with clients that don't keep up
+		flowMetrics *client.FlowControlMetrics
+		// cfg holds the settings for resumable sync operations
+		cfg *config.StreamSyncConfig
 		// otelTracer is used to trace individual sync Send operations, tracing is disabled if nil
 		otelTracer trace.Tracer
 		// activeSyncOperations keeps a mapping from SyncID -> *StreamSyncOperation
@@ -81,17 +92,24 @@ var (
 
 // NewHandler returns a structure that implements the Handler interface.
 // It keeps internally a map of in progress stream sync operations and forwards add stream, remove sream, cancel sync
-// requests to the associated stream sync operation.
+// requests to the associated stream sync operation. Subscriptions on remote streams are shared between sync
+// operations through upstream sync sessions that stop when ctx expires.
 func NewHandler(
+	ctx context.Context,
 	nodeAddr common.Address,
 	cache *StreamCache,
 	nodeRegistry nodes.NodeRegistry,
+	cfg *config.StreamSyncConfig,
+	metrics infra.MetricsFactory,
 	otelTracer trace.Tracer,
 ) *handlerImpl {
 	return &handlerImpl{
 		nodeAddr:     nodeAddr,
 		streamCache:  cache,
 		nodeRegistry: nodeRegistry,
+		remotes:      client.NewRemoteStreamsMux(ctx, nodeRegistry, otelTracer),
+		flowMetrics:  client.NewFlowControlMetrics(metrics),
+		cfg:          cfg,
 		otelTracer:   otelTracer,
 	}
 }
@@ -100,19 +118,8 @@ func (h *handlerImpl) SyncStreams(
 	ctx context.Context,
 	syncId string,
 	req *connect.Request[SyncStreamsRequest],
-	res *connect.ServerStream[SyncStreamsResponse],
+	res StreamsResponseSubscriber,
 ) error {
-	op, err := NewStreamsSyncOperation(ctx, syncId, h.nodeAddr, h.streamCache, h.nodeRegistry, h.otelTracer)
-	if err != nil {
-		return err
-	}
-
-	h.activeSyncOperations.Store(op.SyncID, op)
-	defer h.activeSyncOperations.Delete(op.SyncID)
-
-	doneChan := make(chan error, 1)
-	defer close(doneChan)
-
 	var sender StreamsResponseSubscriber = res
 	if h.otelTracer != nil {
 		sender = &otelSender{
@@ -122,11 +129,37 @@ func (h *handlerImpl) SyncStreams(
 		}
 	}
 
+	if resumeSyncId := req.Msg.GetResumeSyncId(); resumeSyncId != "" {
+		op, ok := h.activeSyncOperations.Load(resumeSyncId)
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "unknown sync operation").Tag("syncId", resumeSyncId)
+		}
+		return op.(*StreamSyncOperation).Resume(ctx, req.Msg.GetResumeAfterSeq(), sender)
+	}
+
+	op, err := NewStreamsSyncOperation(
+		ctx, syncId, h.nodeAddr, h.streamCache, h.nodeRegistry, h.remotes, h.cfg, req.Msg.GetResumable(), h.otelTracer)
+	if err != nil {
+		return err
+	}
+	op.flowMetrics = h.flowMetrics
+
+	// resumable sync operations outlive the request, drop the operation when it stopped
+	h.activeSyncOperations.Store(op.SyncID, op)
+	go func() {
+		<-op.Done()
+		h.activeSyncOperations.Delete(op.SyncID)
+	}()
+
+	doneChan := make(chan error, 1)
+	defer close(doneChan)
+
 	go h.runSyncStreams(req, sender, op, doneChan)
 	return <-doneChan
 }
 
-// StreamsResponseSubscriber is helper interface that allows a custom streams sync subscriber to be given in unit tests.
+// StreamsResponseSubscriber receives the messages of a sync operation. It allows sync operations to be served over
+// other transports than connect and custom subscribers to be given in unit tests.
 type StreamsResponseSubscriber interface {
 	Send(msg *SyncStreamsResponse) error
 }
@@ -175,6 +208,7 @@ func (h *handlerImpl) runSyncStreams(
 		SyncId: op.SyncID,
 		SyncOp: SyncOp_SYNC_NEW,
 	}); err != nil {
+		op.abort(err)
 		doneChan <- AsRiverError(err).Func("SyncStreams")
 		return
 	}
@@ -0,0 +1,73 @@
+package sync
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// syncHistory keeps the most recent updates of a resumable sync operation to replay them to a client
+// that resumes the operation. The history is bounded by the number of messages and their total size.
+// It is not safe for concurrent use.
+type syncHistory struct {
+	// msgs is a ring buffer with the last len messages, the oldest at start
+	msgs  []*SyncStreamsResponse
+	sizes []int
+	start int
+	len   int
+	// bytes is the total size of the kept messages, at most maxBytes unless the last message is larger
+	bytes    int
+	maxBytes int
+	// lastSeq is the sequence number of the last message that was added
+	lastSeq uint64
+}
+
+func newSyncHistory(size int, maxBytes int) *syncHistory {
+	return &syncHistory{
+		msgs:     make([]*SyncStreamsResponse, size),
+		sizes:    make([]int, size),
+		maxBytes: maxBytes,
+	}
+}
+
+// add assigns the next sequence number to msg and keeps it. The oldest messages are dropped when the
+// history is full, it returns the sequence number of the last dropped message or 0 if no message was dropped.
+func (h *syncHistory) add(msg *SyncStreamsResponse) (evictedSeq uint64) {
+	h.lastSeq++
+	msg.Seq = h.lastSeq
+	size := proto.Size(msg)
+
+	for h.len > 0 && (h.len == len(h.msgs) || h.bytes+size > h.maxBytes) {
+		evictedSeq = h.msgs[h.start].Seq
+		h.bytes -= h.sizes[h.start]
+		h.msgs[h.start] = nil
+		h.start = (h.start + 1) % len(h.msgs)
+		h.len--
+	}
+
+	i := (h.start + h.len) % len(h.msgs)
+	h.msgs[i] = msg
+	h.sizes[i] = size
+	h.bytes += size
+	h.len++
+	return evictedSeq
+}
+
+// after returns the messages with a sequence number larger than seq. It returns false if some of
+// these messages were already dropped or seq was never assigned.
+func (h *syncHistory) after(seq uint64) ([]*SyncStreamsResponse, bool) {
+	if seq > h.lastSeq {
+		return nil, false
+	}
+
+	missed := int(h.lastSeq - seq)
+	if missed > h.len {
+		return nil, false
+	}
+
+	msgs := make([]*SyncStreamsResponse, 0, missed)
+	for i := h.len - missed; i < h.len; i++ {
+		msgs = append(msgs, h.msgs[(h.start+i)%len(h.msgs)])
+	}
+	return msgs, true
+}
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+func TestSyncHistory(t *testing.T) {
+	require := require.New(t)
+
+	h := newSyncHistory(3, 1<<20)
+
+	msgs, ok := h.after(0)
+	require.True(ok)
+	require.Empty(msgs)
+
+	_, ok = h.after(1)
+	require.False(ok, "seq was never assigned")
+
+	for range 3 {
+		require.Zero(h.add(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_UPDATE}))
+	}
+
+	msgs, ok = h.after(1)
+	require.True(ok)
+	require.Len(msgs, 2)
+	require.Equal(uint64(2), msgs[0].Seq)
+	require.Equal(uint64(3), msgs[1].Seq)
+
+	// the oldest message is dropped when the history is full
+	require.Equal(uint64(1), h.add(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN}))
+
+	_, ok = h.after(0)
+	require.False(ok, "message 1 was dropped")
+
+	msgs, ok = h.after(1)
+	require.True(ok)
+	require.Len(msgs, 3)
+	require.Equal([]uint64{2, 3, 4}, []uint64{msgs[0].Seq, msgs[1].Seq, msgs[2].Seq})
+
+	msgs, ok = h.after(4)
+	require.True(ok)
+	require.Empty(msgs)
+}
+
+func TestSyncHistoryBytes(t *testing.T) {
+	require := require.New(t)
+
+	msg := func() *SyncStreamsResponse {
+		return &SyncStreamsResponse{SyncOp: SyncOp_SYNC_UPDATE, SyncId: "0123456789"}
+	}
+	size := proto.Size(msg())
+
+	// room for three messages by count but only for two by size
+	h := newSyncHistory(3, 2*size+size/2)
+	require.Zero(h.add(msg()))
+	require.Zero(h.add(msg()))
+	require.Equal(uint64(1), h.add(msg()))
+
+	msgs, ok := h.after(1)
+	require.True(ok)
+	require.Equal([]uint64{2, 3}, []uint64{msgs[0].Seq, msgs[1].Seq})
+
+	// a message that is larger than the budget drops all older messages but is kept
+	large := &SyncStreamsResponse{SyncOp: SyncOp_SYNC_UPDATE, SyncId: strings.Repeat("x", 4*size)}
+	require.Equal(uint64(3), h.add(large))
+
+	_, ok = h.after(2)
+	require.False(ok, "message 3 was dropped")
+	msgs, ok = h.after(3)
+	require.True(ok)
+	require.Len(msgs, 1)
+	require.Equal(uint64(4), msgs[0].Seq)
+}
@@ -6,6 +6,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/towns-protocol/towns/core/config"
 	. "github.com/towns-protocol/towns/core/node/base"
 	. "github.com/towns-protocol/towns/core/node/events"
 	"github.com/towns-protocol/towns/core/node/logging"
@@ -31,12 +32,28 @@ type (
 		cancel context.CancelCauseFunc
 		// commands holds incoming requests from the client to add/remove/cancel commands
 		commands chan *subCommand
+		// resumes holds client connections that resume the sync operation
+		resumes chan *syncAttachment
+		// done is closed when the sync operation stopped
+		done chan struct{}
+		// resumable is true if the sync operation outlives the client connection for a grace period
+		resumable bool
+		// history keeps recent updates to replay them on resume, nil if the sync operation is not resumable
+		history *syncHistory
+		// cfg holds the resume grace period and buffer size
+		cfg *config.StreamSyncConfig
+		// filter is the event filter for streams that are added without a filter, nil if not filtered
+		filter *SyncFilter
+		// flowMetrics reports sync operations with clients that don't keep up, not reported if nil
+		flowMetrics *client.FlowControlMetrics
 		// thisNodeAddress keeps the address of this stream  thisNodeAddress instance
 		thisNodeAddress common.Address
 		// streamCache gives access to streams managed by this thisNodeAddress
 		streamCache *StreamCache
 		// nodeRegistry is used to get the remote remoteNode endpoint from a thisNodeAddress address
 		nodeRegistry nodes.NodeRegistry
+		// remotes shares subscriptions on remote streams with other sync operations, not shared if nil
+		remotes *client.RemoteStreamsMux
 		// otelTracer is used to trace individual sync Send operations, tracing is disabled if nil
 		otelTracer trace.Tracer
 	}
@@ -51,6 +68,16 @@ type (
 		DebugDropStream shared.StreamId
 		reply           chan error
 	}
+
+	// syncAttachment is a client connection that receives the updates of a sync operation.
+	syncAttachment struct {
+		ctx context.Context
+		res StreamsResponseSubscriber
+		// afterSeq is the seq of the last update the client received before it resumed the sync operation
+		afterSeq uint64
+		// done receives the reason the connection stopped receiving updates
+		done chan error
+	}
 )
 
 func (cmd *subCommand) Reply(err error) {
@@ -63,6 +90,9 @@ func (cmd *subCommand) Reply(err error) {
 // NewStreamsSyncOperation initialises a new sync stream operation. It groups the given syncCookies per stream node
 // by its address and subscribes on the internal stream streamCache for local streams.
 //
+// A resumable sync operation is not stopped when the client disconnects. It buffers updates for the grace period
+// from cfg and can be continued with Resume.
+//
 // Use the Run method to start syncing.
 func NewStreamsSyncOperation(
 	ctx context.Context,
@@ -70,10 +100,21 @@ func NewStreamsSyncOperation(
 	node common.Address,
 	streamCache *StreamCache,
 	nodeRegistry nodes.NodeRegistry,
+	remotes *client.RemoteStreamsMux,
+	cfg *config.StreamSyncConfig,
+	resumable bool,
 	otelTracer trace.Tracer,
 ) (*StreamSyncOperation, error) {
+	// a resumable sync operation outlives the client request
+	opCtx := ctx
+	var history *syncHistory
+	if resumable {
+		opCtx = context.WithoutCancel(ctx)
+		history = newSyncHistory(cfg.GetResumeBufferSize(), cfg.GetResumeBufferBytes())
+	}
+
 	// make the sync operation cancellable for CancelSync
-	syncOpCtx, cancel := context.WithCancelCause(ctx)
+	syncOpCtx, cancel := context.WithCancelCause(opCtx)
 
 	return &StreamSyncOperation{
 		rootCtx:         ctx,
@@ -82,28 +123,49 @@ func NewStreamsSyncOperation(
 		SyncID:          syncId,
 		thisNodeAddress: node,
 		commands:        make(chan *subCommand, 64),
+		resumes:         make(chan *syncAttachment),
+		done:            make(chan struct{}),
+		resumable:       resumable,
+		history:         history,
+		cfg:             cfg,
 		streamCache:     streamCache,
 		nodeRegistry:    nodeRegistry,
+		remotes:         remotes,
 		otelTracer:      otelTracer,
 	}, nil
 }
 
-// Run the stream sync until either sub.Cancel is called or until sub.ctx expired
+// Done returns a channel that is closed when the sync operation stopped.
+func (syncOp *StreamSyncOperation) Done() <-chan struct{} {
+	return syncOp.done
+}
+
+// abort stops a sync operation that didn't run.
+func (syncOp *StreamSyncOperation) abort(err error) {
+	syncOp.cancel(err)
+	close(syncOp.done)
+}
+
+// Run the stream sync until either sub.Cancel is called or until sub.ctx expired. For resumable sync operations
+// Run returns when the client connection stopped receiving updates while the sync operation continues in the
+// background until it is resumed or its grace period expired.
 func (syncOp *StreamSyncOperation) Run(
 	req *connect.Request[SyncStreamsRequest],
 	res StreamsResponseSubscriber,
 ) error {
-	log := logging.FromCtx(syncOp.ctx).With("syncId", syncOp.SyncID)
-
-	messagesSendToClient := 0
-
-	log.Debug("Stream sync operation start")
-	defer log.Debugw("Stream sync operation stopped", "send", messagesSendToClient)
+	if err := client.ValidateSyncFilter(req.Msg.GetFilter()); err != nil {
+		syncOp.abort(err)
+		return err
+	}
+	syncOp.filter = req.Msg.GetFilter()
 
 	syncers, messages, err := client.NewSyncers(
 		syncOp.ctx, syncOp.cancel, syncOp.SyncID, syncOp.streamCache,
-		syncOp.nodeRegistry, syncOp.thisNodeAddress, nil, syncOp.otelTracer)
+		syncOp.nodeRegistry, syncOp.remotes, syncOp.thisNodeAddress, nil,
+		client.FlowControl{BufferBytes: syncOp.cfg.GetSessionBufferBytes(), Metrics: syncOp.flowMetrics},
+		syncOp.otelTracer)
 	if err != nil {
+		syncOp.abort(err)
 		return err
 	}
 
@@ -135,34 +197,196 @@ func (syncOp *StreamSyncOperation) Run(
 		}
 	}()
 
+	attachment := &syncAttachment{
+		ctx:  syncOp.rootCtx,
+		res:  res,
+		done: make(chan error, 1),
+	}
+
+	go syncOp.run(syncers, messages, attachment)
+
+	return <-attachment.done
+}
+
+// Resume continues a resumable sync operation on the client connection res. Updates after afterSeq are replayed
+// before new updates are sent. If another connection receives updates for the sync operation it is stopped.
+// Resume returns when the connection stopped receiving updates.
+func (syncOp *StreamSyncOperation) Resume(
+	ctx context.Context,
+	afterSeq uint64,
+	res StreamsResponseSubscriber,
+) error {
+	if !syncOp.resumable {
+		return RiverError(Err_FAILED_PRECONDITION, "sync operation is not resumable").Tags("syncId", syncOp.SyncID)
+	}
+
+	attachment := &syncAttachment{
+		ctx:      ctx,
+		res:      res,
+		afterSeq: afterSeq,
+		done:     make(chan error, 1),
+	}
+
+	select {
+	case syncOp.resumes <- attachment:
+		return <-attachment.done
+	case <-syncOp.done:
+		return RiverError(Err_NOT_FOUND, "unknown sync operation").Tags("syncId", syncOp.SyncID)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run sends updates to the attached client connection and processes client commands until the sync operation
+// stops.
+func (syncOp *StreamSyncOperation) run(
+	syncers *client.SyncerSet,
+	messages chan *SyncStreamsResponse,
+	attachment *syncAttachment,
+) {
+	log := logging.FromCtx(syncOp.ctx).With("syncId", syncOp.SyncID)
+
+	messagesSendToClient := 0
+
+	log.Debug("Stream sync operation start")
+	defer log.Debugw("Stream sync operation stopped", "send", messagesSendToClient)
+
+	defer close(syncOp.done)
+	defer syncOp.cancel(nil)
+
+	var (
+		// sentSeq is the seq of the last update that was sent to a client connection
+		sentSeq uint64
+		// resumeDeadline expires when a detached resumable sync operation was not resumed in time
+		resumeDeadline *time.Timer
+	)
+
+	// detach stops sending updates to the attached connection. It returns true if the sync operation must stop
+	// because it is not resumable.
+	detach := func(reason error) bool {
+		attachment.done <- reason
+		attachment = nil
+		if !syncOp.resumable {
+			syncOp.cancel(reason)
+			return true
+		}
+		resumeDeadline = time.NewTimer(syncOp.cfg.GetResumeGracePeriod())
+		log.Debugw("Client disconnected from resumable sync operation", "reason", reason)
+		return false
+	}
+
 	for {
+		var (
+			clientGone      <-chan struct{}
+			resumeDeadlineC <-chan time.Time
+		)
+		if attachment != nil {
+			clientGone = attachment.ctx.Done()
+		}
+		if resumeDeadline != nil {
+			resumeDeadlineC = resumeDeadline.C
+		}
+
 		select {
 		case msg, ok := <-messages:
 			if !ok {
-				_ = res.Send(&SyncStreamsResponse{
-					SyncId: syncOp.SyncID,
-					SyncOp: SyncOp_SYNC_CLOSE,
-				})
-				return nil
+				if attachment != nil {
+					_ = attachment.res.Send(&SyncStreamsResponse{
+						SyncId: syncOp.SyncID,
+						SyncOp: SyncOp_SYNC_CLOSE,
+					})
+					attachment.done <- nil
+				}
+				return
 			}
 
 			msg.SyncId = syncOp.SyncID
-			if err := res.Send(msg); err != nil {
+			if syncOp.history != nil {
+				evictedSeq := syncOp.history.add(msg)
+				if attachment == nil && evictedSeq > sentSeq {
+					err := RiverError(Err_BUFFER_FULL, "Resumable sync operation buffer is full").
+						Tag("syncId", syncOp.SyncID)
+					log.Infow("Drop resumable sync operation", "err", err)
+					syncOp.cancel(err)
+					return
+				}
+			}
+
+			if attachment == nil {
+				continue
+			}
+
+			if err := attachment.res.Send(msg); err != nil {
 				log.Errorw("Unable to send sync stream update to client", "err", err)
-				return err
+				if detach(err) {
+					return
+				}
+				continue
 			}
 
+			sentSeq = msg.Seq
 			messagesSendToClient++
 
 			log.Debug("Pending messages in sync operation", "count", len(messages))
 
+		case <-clientGone:
+			if detach(attachment.ctx.Err()) {
+				return
+			}
+
+		case <-resumeDeadlineC:
+			log.Debug("Resumable sync operation was not resumed in time")
+			syncOp.cancel(RiverError(Err_DEADLINE_EXCEEDED, "sync operation was not resumed in time"))
+			return
+
 		case <-syncOp.ctx.Done():
-			// clientErr non-nil indicates client hung up, get the error from the root ctx.
-			if clientErr := syncOp.rootCtx.Err(); clientErr != nil {
-				return clientErr
+			if attachment != nil {
+				// clientErr non-nil indicates client hung up, get the error from the connection ctx.
+				if clientErr := attachment.ctx.Err(); clientErr != nil {
+					attachment.done <- clientErr
+				} else {
+					// otherwise syncOp is stopped internally.
+					attachment.done <- context.Cause(syncOp.ctx)
+				}
+			}
+			return
+
+		case resumed := <-syncOp.resumes:
+			if attachment != nil {
+				detach(RiverError(Err_CANCELED, "sync operation resumed on another connection").
+					Tag("syncId", syncOp.SyncID))
+			}
+
+			missed, ok := syncOp.history.after(resumed.afterSeq)
+			if !ok {
+				resumed.done <- RiverError(Err_NOT_FOUND, "sync operation can't be resumed from this position").
+					Tags("syncId", syncOp.SyncID, "afterSeq", resumed.afterSeq, "lastSeq", syncOp.history.lastSeq)
+				continue
+			}
+
+			resumeDeadline.Stop()
+			resumeDeadline = nil
+			attachment = resumed
+
+			log.Debugw("Client resumed sync operation", "afterSeq", resumed.afterSeq, "replay", len(missed))
+
+			err := attachment.res.Send(&SyncStreamsResponse{
+				SyncId: syncOp.SyncID,
+				SyncOp: SyncOp_SYNC_NEW,
+			})
+			for _, msg := range missed {
+				if err != nil {
+					break
+				}
+				if err = attachment.res.Send(msg); err == nil {
+					sentSeq = msg.Seq
+					messagesSendToClient++
+				}
+			}
+			if err != nil {
+				log.Errorw("Unable to replay sync stream updates to client", "err", err)
+				detach(err)
 			}
-			// otherwise syncOp is stopped internally.
-			return context.Cause(syncOp.ctx)
 
 		case cmd := <-syncOp.commands:
 			if cmd.AddStreamReq != nil {
@@ -173,7 +397,12 @@ func (syncOp *StreamSyncOperation) Run(
 					continue
 				}
 
-				cmd.Reply(syncers.AddStream(cmd.Ctx, nodeAddress, streamID, cmd.AddStreamReq.Msg.GetSyncPos()))
+				filter := cmd.AddStreamReq.Msg.GetFilter()
+				if filter == nil {
+					filter = syncOp.filter
+				}
+
+				cmd.Reply(syncers.AddStream(cmd.Ctx, nodeAddress, streamID, cmd.AddStreamReq.Msg.GetSyncPos(), filter))
 			} else if cmd.RmStreamReq != nil {
 				streamID, err := shared.StreamIdFromBytes(cmd.RmStreamReq.Msg.GetStreamId())
 				if err != nil {
@@ -182,7 +411,12 @@ func (syncOp *StreamSyncOperation) Run(
 				}
 				cmd.Reply(syncers.RemoveStream(cmd.Ctx, streamID))
 			} else if cmd.PingReq != nil {
-				err := res.Send(&SyncStreamsResponse{
+				if attachment == nil {
+					cmd.Reply(RiverError(Err_UNAVAILABLE, "no client connected to sync operation").
+						Tag("syncId", syncOp.SyncID))
+					continue
+				}
+				err := attachment.res.Send(&SyncStreamsResponse{
 					SyncId:    syncOp.SyncID,
 					SyncOp:    SyncOp_SYNC_PONG,
 					PongNonce: cmd.PingReq.Msg.GetNonce(),
@@ -191,13 +425,16 @@ func (syncOp *StreamSyncOperation) Run(
 			} else if cmd.DebugDropStream != (shared.StreamId{}) {
 				cmd.Reply(syncers.DebugDropStream(cmd.Ctx, cmd.DebugDropStream))
 			} else if cmd.CancelReq != nil {
-				_ = res.Send(&SyncStreamsResponse{
-					SyncId: syncOp.SyncID,
-					SyncOp: SyncOp_SYNC_CLOSE,
-				})
+				if attachment != nil {
+					_ = attachment.res.Send(&SyncStreamsResponse{
+						SyncId: syncOp.SyncID,
+						SyncOp: SyncOp_SYNC_CLOSE,
+					})
+					attachment.done <- nil
+				}
 
 				cmd.Reply(nil)
-				return nil
+				return
 			}
 		}
 	}
@@ -211,6 +448,10 @@ func (syncOp *StreamSyncOperation) AddStreamToSync(
 		return nil, err
 	}
 
+	if err := client.ValidateSyncFilter(req.Msg.GetFilter()); err != nil {
+		return nil, err
+	}
+
 	if syncOp.otelTracer != nil {
 		var span trace.Span
 		streamID, _ := shared.StreamIdFromBytes(req.Msg.GetSyncPos().GetStreamId())
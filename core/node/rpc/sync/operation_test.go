@@ -0,0 +1,142 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+type testSubscriber struct {
+	msgs chan *SyncStreamsResponse
+	err  error
+}
+
+func (s *testSubscriber) Send(msg *SyncStreamsResponse) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.msgs <- msg
+	return nil
+}
+
+func newTestSubscriber() *testSubscriber {
+	return &testSubscriber{msgs: make(chan *SyncStreamsResponse, 16)}
+}
+
+func TestResumableSyncOperation(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientCtx, disconnect := context.WithCancel(ctx)
+	op, err := NewStreamsSyncOperation(
+		clientCtx,
+		"sync1",
+		common.Address{1},
+		nil,
+		nil,
+		nil,
+		&config.StreamSyncConfig{ResumeGracePeriod: time.Second},
+		true,
+		nil,
+	)
+	require.NoError(err)
+
+	runResult := make(chan error, 1)
+	go func() {
+		runResult <- op.Run(connect.NewRequest(&SyncStreamsRequest{}), newTestSubscriber())
+	}()
+
+	// the sync operation continues after the client disconnected
+	disconnect()
+	require.ErrorIs(<-runResult, context.Canceled)
+	select {
+	case <-op.Done():
+		require.Fail("resumable sync operation stopped after client disconnected")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	_, err = op.PingSync(ctx, connect.NewRequest(&PingSyncRequest{SyncId: "sync1", Nonce: "1"}))
+	require.True(IsRiverErrorCode(err, Err_UNAVAILABLE))
+
+	// resuming from an unknown position fails
+	err = op.Resume(ctx, 10, newTestSubscriber())
+	require.True(IsRiverErrorCode(err, Err_NOT_FOUND))
+
+	// resume and receive pongs on the new connection
+	resumeCtx, stopResume := context.WithCancel(ctx)
+	defer stopResume()
+	sub := newTestSubscriber()
+	resumeResult := make(chan error, 1)
+	go func() {
+		resumeResult <- op.Resume(resumeCtx, 0, sub)
+	}()
+
+	msg := <-sub.msgs
+	require.Equal(SyncOp_SYNC_NEW, msg.SyncOp)
+	require.Equal("sync1", msg.SyncId)
+
+	_, err = op.PingSync(ctx, connect.NewRequest(&PingSyncRequest{SyncId: "sync1", Nonce: "2"}))
+	require.NoError(err)
+	msg = <-sub.msgs
+	require.Equal(SyncOp_SYNC_PONG, msg.SyncOp)
+	require.Equal("2", msg.PongNonce)
+
+	// the sync operation stops when it isn't resumed within the grace period
+	stopResume()
+	require.ErrorIs(<-resumeResult, context.Canceled)
+	select {
+	case <-op.Done():
+	case <-time.After(5 * time.Second):
+		require.Fail("resumable sync operation didn't stop after grace period")
+	}
+
+	err = op.Resume(ctx, 0, newTestSubscriber())
+	require.True(IsRiverErrorCode(err, Err_NOT_FOUND))
+}
+
+func TestSyncOperationNotResumable(t *testing.T) {
+	require := require.New(t)
+
+	op, err := NewStreamsSyncOperation(
+		context.Background(),
+		"sync1",
+		common.Address{1},
+		nil,
+		nil,
+		nil,
+		&config.StreamSyncConfig{},
+		false,
+		nil,
+	)
+	require.NoError(err)
+
+	sub := newTestSubscriber()
+	runResult := make(chan error, 1)
+	go func() {
+		runResult <- op.Run(connect.NewRequest(&SyncStreamsRequest{}), sub)
+	}()
+
+	sendErr := errors.New("connection lost")
+	sub.err = sendErr
+	_, err = op.PingSync(context.Background(), connect.NewRequest(&PingSyncRequest{SyncId: "sync1", Nonce: "1"}))
+	require.ErrorIs(err, sendErr)
+
+	_, err = op.CancelSync(context.Background(), connect.NewRequest(&CancelSyncRequest{SyncId: "sync1"}))
+	require.NoError(err)
+	require.NoError(<-runResult)
+	<-op.Done()
+
+	err = op.Resume(context.Background(), 0, newTestSubscriber())
+	require.True(IsRiverErrorCode(err, Err_FAILED_PRECONDITION))
+}
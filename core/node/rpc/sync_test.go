@@ -1,8 +1,12 @@
 package rpc
 
 import (
+	"bufio"
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
 	"strings"
 	"sync/atomic"
@@ -10,6 +14,7 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 	"github.com/towns-protocol/towns/core/node/crypto"
 	. "github.com/towns-protocol/towns/core/node/events"
@@ -17,7 +22,10 @@ import (
 	"github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
 	. "github.com/towns-protocol/towns/core/node/shared"
 	"github.com/towns-protocol/towns/core/node/testutils"
+	"github.com/towns-protocol/towns/core/node/testutils/testcert"
 	"github.com/towns-protocol/towns/core/node/testutils/testfmt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const defaultTimeout = 5 * time.Second
@@ -416,3 +424,350 @@ func TestSyncWithManyStreams(t *testing.T) {
 	syncClients.cancelAll(t, ctx)
 	syncClients.checkDone(t)
 }
+
+// TestSyncResume ensures that a client can resume a resumable sync session after it disconnected and receives
+// the updates that were added while it was disconnected.
+func TestSyncResume(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, cookie, _, err := createUserSettingsStream(
+		ctx,
+		wallet,
+		client,
+		&protocol.StreamSettings{DisableMiniblockCreation: true},
+	)
+	require.NoError(err)
+
+	receive := func(resp *connect.ServerStreamForClient[protocol.SyncStreamsResponse]) *protocol.SyncStreamsResponse {
+		require.True(resp.Receive(), "sync stream closed: %v", resp.Err())
+		return resp.Msg()
+	}
+
+	syncCtx, disconnect := context.WithCancel(ctx)
+	resp, err := client.SyncStreams(syncCtx, connect.NewRequest(&protocol.SyncStreamsRequest{
+		SyncPos:   []*protocol.SyncCookie{cookie},
+		Resumable: true,
+	}))
+	require.NoError(err)
+
+	msg := receive(resp)
+	require.Equal(protocol.SyncOp_SYNC_NEW, msg.SyncOp)
+	syncId := msg.SyncId
+
+	msg = receive(resp)
+	require.Equal(protocol.SyncOp_SYNC_UPDATE, msg.SyncOp)
+	require.Equal(uint64(1), msg.Seq)
+
+	// add an event while the client is disconnected
+	disconnect()
+	require.NoError(addUserBlockedFillerEvent(ctx, wallet, client, streamId, MiniblockRefFromCookie(cookie)))
+
+	resp, err = client.SyncStreams(ctx, connect.NewRequest(&protocol.SyncStreamsRequest{
+		ResumeSyncId:   syncId,
+		ResumeAfterSeq: 1,
+	}))
+	require.NoError(err)
+	defer resp.Close()
+
+	msg = receive(resp)
+	require.Equal(protocol.SyncOp_SYNC_NEW, msg.SyncOp)
+	require.Equal(syncId, msg.SyncId)
+
+	msg = receive(resp)
+	require.Equal(protocol.SyncOp_SYNC_UPDATE, msg.SyncOp)
+	require.Equal(uint64(2), msg.Seq)
+	checkUpdate(t, msg.Stream, &updateOpts{events: 1, eventType: "UserSettingsPayload"})
+
+	_, err = client.CancelSync(ctx, connect.NewRequest(&protocol.CancelSyncRequest{SyncId: syncId}))
+	require.NoError(err)
+
+	// cancelled sync sessions can't be resumed
+	resp, err = client.SyncStreams(ctx, connect.NewRequest(&protocol.SyncStreamsRequest{ResumeSyncId: syncId}))
+	if err == nil {
+		for resp.Receive() {
+		}
+		err = resp.Err()
+	}
+	require.Error(err)
+}
+
+func TestSyncWebSocket(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, cookie, _, err := createUserSettingsStream(
+		ctx,
+		wallet,
+		client,
+		&protocol.StreamSettings{DisableMiniblockCreation: true},
+	)
+	require.NoError(err)
+
+	dial := func() *websocket.Conn {
+		dialer := websocket.Dialer{TLSClientConfig: &tls.Config{RootCAs: testcert.LocalhostCertPool}}
+		conn, _, err := dialer.DialContext(ctx, "wss"+strings.TrimPrefix(tt.nodes[0].url, "https")+SyncWebSocketPath, nil)
+		require.NoError(err)
+		t.Cleanup(func() { _ = conn.Close() })
+		return conn
+	}
+
+	conn := dial()
+	send := func(req *protocol.SyncSocketRequest) {
+		data, err := proto.Marshal(req)
+		require.NoError(err)
+		require.NoError(conn.WriteMessage(websocket.BinaryMessage, data))
+	}
+	receive := func() *protocol.SyncSocketResponse {
+		require.NoError(conn.SetReadDeadline(time.Now().Add(defaultTimeout)))
+		frameType, data, err := conn.ReadMessage()
+		require.NoError(err)
+		require.Equal(websocket.BinaryMessage, frameType)
+		var res protocol.SyncSocketResponse
+		require.NoError(proto.Unmarshal(data, &res))
+		return &res
+	}
+
+	// updates that arrive before the result of a request are kept for receiveUpdate
+	var updates []*protocol.SyncStreamsResponse
+	receiveResult := func(requestId string) *protocol.SyncSocketResult {
+		for {
+			res := receive()
+			if result := res.GetResult(); result != nil {
+				require.Equal(requestId, result.GetRequestId())
+				return result
+			}
+			updates = append(updates, res.GetUpdate())
+		}
+	}
+	receiveUpdate := func(match func(*protocol.SyncStreamsResponse) bool) *protocol.SyncStreamsResponse {
+		for {
+			var update *protocol.SyncStreamsResponse
+			if len(updates) > 0 {
+				update, updates = updates[0], updates[1:]
+			} else {
+				update = receive().GetUpdate()
+				require.NotNil(update, "unexpected request result")
+			}
+			if match(update) {
+				return update
+			}
+		}
+	}
+	isOp := func(op protocol.SyncOp) func(*protocol.SyncStreamsResponse) bool {
+		return func(update *protocol.SyncStreamsResponse) bool { return update.GetSyncOp() == op }
+	}
+
+	send(&protocol.SyncSocketRequest{
+		Request: &protocol.SyncSocketRequest_SyncStreams{SyncStreams: &protocol.SyncStreamsRequest{
+			SyncPos: []*protocol.SyncCookie{cookie},
+		}},
+	})
+	syncId := receiveUpdate(isOp(protocol.SyncOp_SYNC_NEW)).GetSyncId()
+	require.NotEmpty(syncId)
+	receiveUpdate(isOp(protocol.SyncOp_SYNC_UPDATE))
+
+	// ping, remove and add are multiplexed on the connection
+	send(&protocol.SyncSocketRequest{
+		RequestId: "ping",
+		Request:   &protocol.SyncSocketRequest_Ping{Ping: &protocol.PingSyncRequest{Nonce: "nonce"}},
+	})
+	require.Zero(receiveResult("ping").GetCode())
+	require.Equal("nonce", receiveUpdate(isOp(protocol.SyncOp_SYNC_PONG)).GetPongNonce())
+
+	send(&protocol.SyncSocketRequest{
+		RequestId: "remove",
+		Request: &protocol.SyncSocketRequest_RemoveStream{
+			RemoveStream: &protocol.RemoveStreamFromSyncRequest{StreamId: streamId[:]},
+		},
+	})
+	require.Zero(receiveResult("remove").GetCode())
+
+	send(&protocol.SyncSocketRequest{
+		RequestId: "add",
+		Request: &protocol.SyncSocketRequest_AddStream{
+			AddStream: &protocol.AddStreamToSyncRequest{SyncPos: cookie},
+		},
+	})
+	require.Zero(receiveResult("add").GetCode())
+
+	require.NoError(addUserBlockedFillerEvent(ctx, wallet, client, streamId, MiniblockRefFromCookie(cookie)))
+	update := receiveUpdate(func(update *protocol.SyncStreamsResponse) bool {
+		return update.GetSyncOp() == protocol.SyncOp_SYNC_UPDATE && len(update.GetStream().GetEvents()) > 0
+	})
+	require.Equal(syncId, update.GetSyncId())
+	checkUpdate(t, update.GetStream(), &updateOpts{events: 1, eventType: "UserSettingsPayload"})
+
+	// errors are returned in the result of the request
+	send(&protocol.SyncSocketRequest{
+		RequestId: "restart",
+		Request:   &protocol.SyncSocketRequest_SyncStreams{SyncStreams: &protocol.SyncStreamsRequest{}},
+	})
+	require.EqualValues(connect.CodeInvalidArgument, receiveResult("restart").GetCode())
+
+	// the connection is closed when the sync session is cancelled
+	send(&protocol.SyncSocketRequest{
+		RequestId: "cancel",
+		Request:   &protocol.SyncSocketRequest_Cancel{Cancel: &protocol.CancelSyncRequest{}},
+	})
+	require.Zero(receiveResult("cancel").GetCode())
+	receiveUpdate(isOp(protocol.SyncOp_SYNC_CLOSE))
+	_, _, err = conn.ReadMessage()
+	require.True(websocket.IsCloseError(err, websocket.CloseNormalClosure), "unexpected error: %v", err)
+
+	// the sync session must be started with the first message
+	conn = dial()
+	require.NoError(conn.WriteMessage(websocket.TextMessage, []byte(`{"ping": {"nonce": "nonce"}}`)))
+	_, _, err = conn.ReadMessage()
+	require.True(websocket.IsCloseError(err, websocket.ClosePolicyViolation), "unexpected error: %v", err)
+
+	// clients that send protobuf JSON receive protobuf JSON
+	conn = dial()
+	require.NoError(conn.WriteMessage(websocket.TextMessage, []byte(`{"syncStreams": {}}`)))
+	frameType, data, err := conn.ReadMessage()
+	require.NoError(err)
+	require.Equal(websocket.TextMessage, frameType)
+	var res protocol.SyncSocketResponse
+	require.NoError(protojson.Unmarshal(data, &res))
+	require.Equal(protocol.SyncOp_SYNC_NEW, res.GetUpdate().GetSyncOp())
+}
+
+func TestSyncSse(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, cookie, _, err := createUserSettingsStream(
+		ctx,
+		wallet,
+		client,
+		&protocol.StreamSettings{DisableMiniblockCreation: true},
+	)
+	require.NoError(err)
+
+	type sseEvent struct {
+		event string
+		id    string
+		data  string
+	}
+
+	// openSync starts the sync session and returns the received events, cancel disconnects the client
+	openSync := func(req *protocol.SyncStreamsRequest, lastEventId string) (chan sseEvent, context.CancelFunc) {
+		reqJson, err := protojson.Marshal(req)
+		require.NoError(err)
+		sseUrl := tt.nodes[0].url + SyncSsePath + "?" + url.Values{SyncSseRequestParam: {string(reqJson)}}.Encode()
+
+		sseCtx, cancel := context.WithCancel(ctx)
+		httpReq, err := http.NewRequestWithContext(sseCtx, http.MethodGet, sseUrl, nil)
+		require.NoError(err)
+		if lastEventId != "" {
+			httpReq.Header.Set("Last-Event-ID", lastEventId)
+		}
+		resp, err := tt.httpClient().Do(httpReq)
+		require.NoError(err)
+		require.Equal(http.StatusOK, resp.StatusCode)
+		require.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+
+		events := make(chan sseEvent, 16)
+		go func() {
+			defer close(events)
+			defer resp.Body.Close()
+
+			scanner := bufio.NewScanner(resp.Body)
+			scanner.Buffer(nil, 1024*1024)
+			var ev sseEvent
+			for scanner.Scan() {
+				line := scanner.Text()
+				if line == "" {
+					if ev.data != "" {
+						events <- ev
+					}
+					ev = sseEvent{}
+				} else if data, ok := strings.CutPrefix(line, "data: "); ok {
+					ev.data = data
+				} else if id, ok := strings.CutPrefix(line, "id: "); ok {
+					ev.id = id
+				} else if event, ok := strings.CutPrefix(line, "event: "); ok {
+					ev.event = event
+				}
+			}
+		}()
+		return events, cancel
+	}
+	receive := func(events chan sseEvent) sseEvent {
+		select {
+		case ev, ok := <-events:
+			require.True(ok, "sse response closed")
+			return ev
+		case <-time.After(defaultTimeout):
+			require.FailNow("no sse event received")
+			return sseEvent{}
+		}
+	}
+	receiveMsg := func(events chan sseEvent) (*protocol.SyncStreamsResponse, string) {
+		ev := receive(events)
+		require.Empty(ev.event)
+		var msg protocol.SyncStreamsResponse
+		require.NoError(protojson.Unmarshal([]byte(ev.data), &msg))
+		return &msg, ev.id
+	}
+
+	events, disconnect := openSync(&protocol.SyncStreamsRequest{
+		SyncPos:   []*protocol.SyncCookie{cookie},
+		Resumable: true,
+	}, "")
+
+	msg, id := receiveMsg(events)
+	require.Equal(protocol.SyncOp_SYNC_NEW, msg.GetSyncOp())
+	syncId := msg.GetSyncId()
+	require.Equal(syncId+"/0", id)
+
+	msg, id = receiveMsg(events)
+	require.Equal(protocol.SyncOp_SYNC_UPDATE, msg.GetSyncOp())
+	require.Equal(syncId+"/1", id)
+
+	// the sync session is controlled with the connect rpcs
+	_, err = client.PingSync(ctx, connect.NewRequest(&protocol.PingSyncRequest{SyncId: syncId, Nonce: "nonce"}))
+	require.NoError(err)
+	msg, _ = receiveMsg(events)
+	require.Equal(protocol.SyncOp_SYNC_PONG, msg.GetSyncOp())
+	require.Equal("nonce", msg.GetPongNonce())
+
+	// the sync session is resumed after the last event id when the client reconnects
+	disconnect()
+	require.NoError(addUserBlockedFillerEvent(ctx, wallet, client, streamId, MiniblockRefFromCookie(cookie)))
+
+	events, disconnect = openSync(&protocol.SyncStreamsRequest{}, syncId+"/1")
+	defer disconnect()
+
+	msg, _ = receiveMsg(events)
+	require.Equal(protocol.SyncOp_SYNC_NEW, msg.GetSyncOp())
+	require.Equal(syncId, msg.GetSyncId())
+
+	msg, id = receiveMsg(events)
+	require.Equal(protocol.SyncOp_SYNC_UPDATE, msg.GetSyncOp())
+	require.Equal(syncId+"/2", id)
+	checkUpdate(t, msg.GetStream(), &updateOpts{events: 1, eventType: "UserSettingsPayload"})
+
+	_, err = client.CancelSync(ctx, connect.NewRequest(&protocol.CancelSyncRequest{SyncId: syncId}))
+	require.NoError(err)
+	msg, _ = receiveMsg(events)
+	require.Equal(protocol.SyncOp_SYNC_CLOSE, msg.GetSyncOp())
+
+	ev := receive(events)
+	require.Equal(SyncSseCloseEvent, ev.event)
+	var result protocol.SyncSocketResult
+	require.NoError(protojson.Unmarshal([]byte(ev.data), &result))
+	require.Zero(result.GetCode())
+}
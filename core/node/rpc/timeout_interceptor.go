@@ -13,16 +13,20 @@ func NewTimeoutInterceptor(defaultTimeout time.Duration) connect.UnaryIntercepto
 			ctx context.Context,
 			req connect.AnyRequest,
 		) (connect.AnyResponse, error) {
-			if defaultTimeout != 0 {
-				var cancel context.CancelFunc
-				_, ok := ctx.Deadline()
-				if !ok {
-					ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
-					defer cancel()
-				}
-			}
+			ctx, cancel := withDefaultTimeout(ctx, defaultTimeout)
+			defer cancel()
 			return next(ctx, req)
 		}
 	}
 	return interceptor
 }
+
+// withDefaultTimeout returns a context that expires after defaultTimeout if ctx has no deadline.
+func withDefaultTimeout(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	if defaultTimeout != 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			return context.WithTimeout(ctx, defaultTimeout)
+		}
+	}
+	return ctx, func() {}
+}
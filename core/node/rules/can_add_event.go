@@ -119,6 +119,8 @@ type aeHideUserJoinLeaveEventsWrapperRules struct {
 * for adding an event to a stream.
 *
 
+  - @param appPermissions *AppPermissions // permissions of the event creator if it is a registered app, nil if the creator is not restricted
+
   - @return canAddEvent bool // true if the event can be added to the stream, will be false in case of duplictate state
 
   - @return verifications *AddEventVerifications // a list of on chain requirements, such that, if defined, at least one must be satisfied in order to add the event to the stream
@@ -144,6 +146,7 @@ func CanAddEvent(
 	currentTime time.Time,
 	parsedEvent *events.ParsedEvent,
 	streamView *events.StreamView,
+	appPermissions *AppPermissions,
 ) (bool, *AddEventVerifications, *AddEventSideEffects, error) {
 	if parsedEvent.Event.DelegateExpiryEpochMs > 0 &&
 		isPastExpiry(currentTime, parsedEvent.Event.DelegateExpiryEpochMs) {
@@ -172,6 +175,10 @@ func CanAddEvent(
 		return false, nil, nil, err
 	}
 
+	if err := checkAppPermissions(parsedEvent, *streamView.StreamId(), appPermissions); err != nil {
+		return false, nil, nil, err
+	}
+
 	settings := chainConfig.Get()
 
 	ru := &aeParams{
@@ -190,6 +197,85 @@ func CanAddEvent(
 	return builder.run()
 }
 
+// AppStreamTypeForStreamId returns the type of the stream as used in app permissions,
+// APP_STREAM_TYPE_UNSPECIFIED if app permissions don't restrict streams of this type.
+func AppStreamTypeForStreamId(streamId shared.StreamId) AppStreamType {
+	switch streamId.Type() {
+	case shared.STREAM_CHANNEL_BIN:
+		return AppStreamType_APP_STREAM_TYPE_CHANNEL
+	case shared.STREAM_DM_CHANNEL_BIN:
+		return AppStreamType_APP_STREAM_TYPE_DM
+	case shared.STREAM_GDM_CHANNEL_BIN:
+		return AppStreamType_APP_STREAM_TYPE_GDM
+	default:
+		return AppStreamType_APP_STREAM_TYPE_UNSPECIFIED
+	}
+}
+
+// checkAppPermissions returns an error if the event requires a scope or stream type that is not in
+// the permissions of the app that created it.
+func checkAppPermissions(
+	parsedEvent *events.ParsedEvent,
+	streamId shared.StreamId,
+	permissions *AppPermissions,
+) error {
+	if permissions == nil {
+		return nil
+	}
+
+	streamType := AppStreamTypeForStreamId(streamId)
+	if membership := parsedEvent.Event.GetUserPayload().GetUserMembership(); membership != nil {
+		target, err := shared.StreamIdFromBytes(membership.StreamId)
+		if err != nil {
+			return err
+		}
+		streamType = AppStreamTypeForStreamId(target)
+	}
+	if streamType != AppStreamType_APP_STREAM_TYPE_UNSPECIFIED && !permissions.AllowsStreamType(streamType) {
+		return RiverError(Err_PERMISSION_DENIED, "app is not allowed to add events to this stream type",
+			"streamId", streamId, "streamType", streamType)
+	}
+
+	scope := AppScope_APP_SCOPE_UNSPECIFIED
+	switch payload := parsedEvent.Event.Payload.(type) {
+	case *StreamEvent_ChannelPayload:
+		switch payload.ChannelPayload.Content.(type) {
+		case *ChannelPayload_Message, *ChannelPayload_Redaction_:
+			scope = messageScope(parsedEvent)
+		}
+	case *StreamEvent_DmChannelPayload:
+		if payload.DmChannelPayload.GetMessage() != nil {
+			scope = messageScope(parsedEvent)
+		}
+	case *StreamEvent_GdmChannelPayload:
+		if payload.GdmChannelPayload.GetMessage() != nil {
+			scope = messageScope(parsedEvent)
+		}
+	case *StreamEvent_MemberPayload:
+		switch payload.MemberPayload.Content.(type) {
+		case *MemberPayload_Pin_, *MemberPayload_Unpin_:
+			scope = AppScope_APP_SCOPE_MANAGE_PINS
+		case *MemberPayload_KeySolicitation_:
+			scope = AppScope_APP_SCOPE_READ_MESSAGES
+		}
+	}
+
+	if scope != AppScope_APP_SCOPE_UNSPECIFIED && !permissions.HasScope(scope) {
+		return RiverError(Err_PERMISSION_DENIED, "app is missing the scope required for the event",
+			"streamId", streamId, "scope", scope)
+	}
+	return nil
+}
+
+// messageScope returns the scope required to add a message event.
+func messageScope(parsedEvent *events.ParsedEvent) AppScope {
+	if parsedEvent.Event.GetTags().GetMessageInteractionType() ==
+		MessageInteractionType_MESSAGE_INTERACTION_TYPE_REACTION {
+		return AppScope_APP_SCOPE_REACT
+	}
+	return AppScope_APP_SCOPE_POST_MESSAGES
+}
+
 func (params *aeParams) canAddEvent() ruleBuilderAE {
 	// run checks per payload type
 	switch payload := params.parsedEvent.Event.Payload.(type) {
@@ -0,0 +1,37 @@
+package search
+
+import (
+	"encoding/binary"
+	"time"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+const pageTokenLength = 8 + 8 + STREAM_ID_BYTES_LENGTH
+
+// EncodePageToken encodes the position in search results into a page token.
+func EncodePageToken(cursor *storage.EventIndexCursor) []byte {
+	token := make([]byte, 0, pageTokenLength)
+	token = binary.BigEndian.AppendUint64(token, uint64(cursor.CreatedAt.UnixNano()))
+	token = binary.BigEndian.AppendUint64(token, uint64(cursor.EventNum))
+	return append(token, cursor.StreamId[:]...)
+}
+
+// DecodePageToken decodes the page token created by EncodePageToken.
+func DecodePageToken(token []byte) (*storage.EventIndexCursor, error) {
+	if len(token) != pageTokenLength {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid page token").Func("DecodePageToken")
+	}
+	streamId, err := StreamIdFromBytes(token[16:])
+	if err != nil {
+		return nil, AsRiverError(err, Err_INVALID_ARGUMENT).Message("Invalid page token").Func("DecodePageToken")
+	}
+	return &storage.EventIndexCursor{
+		CreatedAt: time.Unix(0, int64(binary.BigEndian.Uint64(token[:8]))),
+		EventNum:  int64(binary.BigEndian.Uint64(token[8:16])),
+		StreamId:  streamId,
+	}, nil
+}
@@ -0,0 +1,127 @@
+package search
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+var encryptedDataName = (&EncryptedData{}).ProtoReflect().Descriptor().FullName()
+
+// opaqueFields are fields with encrypted content that are not of the EncryptedData type.
+var opaqueFields = map[protoreflect.FullName]bool{
+	"river.UserInboxPayload.GroupEncryptionSessions.ciphertexts": true,
+	"river.MediaPayload.Chunk.data":                              true,
+}
+
+// IndexedEventsFromMiniblock returns the searchable part of the events in the given miniblock.
+func IndexedEventsFromMiniblock(streamId StreamId, mb *events.MiniblockInfo) ([]*storage.IndexedEvent, error) {
+	header := mb.Header()
+	createdAt := header.GetTimestamp().AsTime()
+
+	indexed := make([]*storage.IndexedEvent, 0, len(mb.Events()))
+	for i, e := range mb.Events() {
+		eventType, payload := eventPayload(e.Event)
+		payloadJson := []byte("{}")
+		if payload != nil {
+			var err error
+			payloadJson, err = protojson.Marshal(withoutEncryptedData(payload))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		indexed = append(indexed, &storage.IndexedEvent{
+			StreamId:     streamId,
+			MiniblockNum: mb.Ref.Num,
+			EventNum:     header.EventNumOffset + int64(i),
+			EventHash:    e.Hash,
+			Creator:      common.BytesToAddress(e.Event.CreatorAddress),
+			User:         eventUser(e.Event),
+			EventType:    eventType,
+			CreatedAt:    createdAt,
+			Payload:      payloadJson,
+		})
+	}
+	return indexed, nil
+}
+
+// eventPayload returns the event type in the form "<payload>.<content>", for example
+// "member_payload.membership", and the payload message.
+func eventPayload(event *StreamEvent) (string, protoreflect.Message) {
+	msg := event.ProtoReflect()
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("payload"))
+	if field == nil || field.Message() == nil {
+		return "unknown", nil
+	}
+	payload := msg.Get(field).Message()
+
+	eventType := string(field.Name())
+	if content := payload.Descriptor().Oneofs().ByName("content"); content != nil {
+		if contentField := payload.WhichOneof(content); contentField != nil {
+			eventType += "." + string(contentField.Name())
+		}
+	}
+	return eventType, payload
+}
+
+// eventUser returns the user the event is about if it is not the creator of the event.
+func eventUser(event *StreamEvent) common.Address {
+	member := event.GetMemberPayload()
+	switch {
+	case member.GetMembership() != nil:
+		return common.BytesToAddress(member.GetMembership().GetUserAddress())
+	case member.GetKeyFulfillment() != nil:
+		return common.BytesToAddress(member.GetKeyFulfillment().GetUserAddress())
+	default:
+		return common.Address{}
+	}
+}
+
+// withoutEncryptedData returns a copy of the message with all encrypted data fields cleared.
+func withoutEncryptedData(msg protoreflect.Message) proto.Message {
+	clone := proto.Clone(msg.Interface())
+	clearEncryptedData(clone.ProtoReflect())
+	return clone
+}
+
+func clearEncryptedData(msg protoreflect.Message) {
+	var cleared []protoreflect.FieldDescriptor
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case opaqueFields[fd.FullName()]:
+			cleared = append(cleared, fd)
+		case fd.IsMap():
+			if valueType := fd.MapValue().Message(); valueType != nil {
+				if valueType.FullName() == encryptedDataName {
+					cleared = append(cleared, fd)
+				} else {
+					v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+						clearEncryptedData(mv.Message())
+						return true
+					})
+				}
+			}
+		case fd.Message() == nil:
+		case fd.Message().FullName() == encryptedDataName:
+			cleared = append(cleared, fd)
+		case fd.IsList():
+			list := v.List()
+			for i := range list.Len() {
+				clearEncryptedData(list.Get(i).Message())
+			}
+		default:
+			clearEncryptedData(v.Message())
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		msg.Clear(fd)
+	}
+}
@@ -0,0 +1,85 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestIndexedEventsFromMiniblock(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	user, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	prev := &MiniblockRef{Num: 2}
+
+	membership, err := events.MakeParsedEventWithPayload(
+		wallet,
+		events.Make_MemberPayload_Membership(MembershipOp_SO_JOIN, user.Address[:], wallet.Address[:], nil),
+		prev,
+	)
+	require.NoError(err)
+	message, err := events.MakeParsedEventWithPayload(wallet, events.Make_ChannelPayload_Message("secret"), prev)
+	require.NoError(err)
+	pin, err := events.MakeParsedEventWithPayload(
+		wallet,
+		&StreamEvent_MemberPayload{
+			MemberPayload: &MemberPayload{
+				Content: &MemberPayload_Pin_{
+					Pin: &MemberPayload_Pin{
+						EventId: message.Hash[:],
+						Event:   message.Event,
+					},
+				},
+			},
+		},
+		prev,
+	)
+	require.NoError(err)
+
+	timestamp := time.Now().Truncate(time.Millisecond)
+	mb, err := events.NewMiniblockInfoFromHeaderAndParsed(wallet, &MiniblockHeader{
+		MiniblockNum:   3,
+		Timestamp:      timestamppb.New(timestamp),
+		EventNumOffset: 10,
+	}, []*events.ParsedEvent{membership, message, pin})
+	require.NoError(err)
+
+	indexed, err := IndexedEventsFromMiniblock(streamId, mb)
+	require.NoError(err)
+	require.Len(indexed, 3)
+
+	for i, e := range indexed {
+		require.Equal(streamId, e.StreamId)
+		require.EqualValues(3, e.MiniblockNum)
+		require.EqualValues(10+i, e.EventNum)
+		require.Equal(wallet.Address, e.Creator)
+		require.True(timestamp.Equal(e.CreatedAt))
+		require.NotContains(string(e.Payload), "secret")
+	}
+
+	require.Equal("member_payload.membership", indexed[0].EventType)
+	require.Equal(membership.Hash, indexed[0].EventHash)
+	require.Equal(user.Address, indexed[0].User)
+	require.Contains(string(indexed[0].Payload), "SO_JOIN")
+
+	require.Equal("channel_payload.message", indexed[1].EventType)
+	require.Zero(indexed[1].User)
+
+	require.Equal("member_payload.pin", indexed[2].EventType)
+	require.Contains(string(indexed[2].Payload), "eventId")
+}
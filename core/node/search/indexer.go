@@ -0,0 +1,107 @@
+package search
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+// Indexer adds the events of miniblocks applied to local streams to the event index.
+// Miniblocks are queued and indexed in the background, so indexing doesn't slow down
+// miniblock application. If the queue is full miniblocks are dropped and are not indexed.
+type Indexer struct {
+	store storage.EventIndexStore
+	queue chan *indexTask
+
+	indexedEvents     prometheus.Counter
+	droppedMiniblocks prometheus.Counter
+	failedMiniblocks  prometheus.Counter
+}
+
+type indexTask struct {
+	streamId   StreamId
+	miniblocks []*events.MiniblockInfo
+}
+
+var _ events.EventIndexer = (*Indexer)(nil)
+
+// NewIndexer creates an indexer that writes to the given store and starts it.
+// The indexer stops when the given context is cancelled.
+func NewIndexer(
+	ctx context.Context,
+	store storage.EventIndexStore,
+	cfg *config.EventIndexConfig,
+	metrics infra.MetricsFactory,
+) *Indexer {
+	i := &Indexer{
+		store: store,
+		queue: make(chan *indexTask, cfg.GetQueueSize()),
+		indexedEvents: metrics.NewCounterEx(
+			"event_index_indexed_events",
+			"Number of events added to the event search index",
+		),
+		droppedMiniblocks: metrics.NewCounterEx(
+			"event_index_dropped_miniblocks",
+			"Number of miniblocks not indexed because the indexing queue was full",
+		),
+		failedMiniblocks: metrics.NewCounterEx(
+			"event_index_failed_miniblocks",
+			"Number of miniblocks not indexed because of an error",
+		),
+	}
+	go i.run(ctx)
+	return i
+}
+
+// IndexMiniblocks queues the given miniblocks for indexing.
+func (i *Indexer) IndexMiniblocks(streamId StreamId, miniblocks []*events.MiniblockInfo) {
+	select {
+	case i.queue <- &indexTask{streamId: streamId, miniblocks: miniblocks}:
+	default:
+		i.droppedMiniblocks.Add(float64(len(miniblocks)))
+	}
+}
+
+func (i *Indexer) run(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-i.queue:
+			if err := i.index(ctx, task); err != nil {
+				i.failedMiniblocks.Add(float64(len(task.miniblocks)))
+				log.Errorw("Failed to index miniblocks",
+					"streamId", task.streamId,
+					"fromMiniblockNum", task.miniblocks[0].Ref.Num,
+					"numMiniblocks", len(task.miniblocks),
+					"err", err,
+				)
+			}
+		}
+	}
+}
+
+func (i *Indexer) index(ctx context.Context, task *indexTask) error {
+	var indexed []*storage.IndexedEvent
+	for _, mb := range task.miniblocks {
+		mbEvents, err := IndexedEventsFromMiniblock(task.streamId, mb)
+		if err != nil {
+			return err
+		}
+		indexed = append(indexed, mbEvents...)
+	}
+
+	if err := i.store.IndexEvents(ctx, indexed); err != nil {
+		return err
+	}
+	i.indexedEvents.Add(float64(len(indexed)))
+	return nil
+}
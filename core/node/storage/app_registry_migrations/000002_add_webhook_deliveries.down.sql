@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS app_webhook_delivery_log;
+DROP TABLE IF EXISTS app_webhook_queue;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS app_webhook_queue (
+    id           BIGSERIAL PRIMARY KEY,
+    app_id       CHAR(40)  NOT NULL,
+    channel_id   VARCHAR   NOT NULL,
+    event_hash   CHAR(64)  NOT NULL,
+    payload      BYTEA     NOT NULL,
+    attempts     INT       NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMP NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- events are delivered in order per app and channel
+CREATE INDEX app_webhook_queue_channel_idx ON app_webhook_queue (app_id, channel_id, id);
+
+CREATE TABLE IF NOT EXISTS app_webhook_delivery_log (
+    id          BIGSERIAL PRIMARY KEY,
+    app_id      CHAR(40)  NOT NULL,
+    channel_id  VARCHAR   NOT NULL,
+    event_hash  CHAR(64)  NOT NULL,
+    attempt     INT       NOT NULL,
+    status      SMALLINT  NOT NULL,
+    http_status INT       NOT NULL,
+    error       VARCHAR   NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX app_webhook_delivery_log_app_idx ON app_webhook_delivery_log (app_id, id);
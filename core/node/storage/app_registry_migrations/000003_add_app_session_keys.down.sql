@@ -0,0 +1,2 @@
+ALTER TABLE app_webhook_queue DROP COLUMN IF EXISTS session_id;
+DROP TABLE IF EXISTS app_session_keys;
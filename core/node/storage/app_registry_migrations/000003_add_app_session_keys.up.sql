@@ -0,0 +1,10 @@
+-- group encryption sessions that were sent to the inbox of an app
+CREATE TABLE IF NOT EXISTS app_session_keys (
+    app_id     CHAR(40)  NOT NULL,
+    session_id VARCHAR   NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (app_id, session_id)
+);
+
+-- session the event is encrypted with, events are held until the app received the session
+ALTER TABLE app_webhook_queue ADD COLUMN IF NOT EXISTS session_id VARCHAR;
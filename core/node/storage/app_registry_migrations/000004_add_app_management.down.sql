@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS app_audit_log;
+ALTER TABLE app_registry DROP COLUMN IF EXISTS disabled;
+ALTER TABLE app_registry DROP COLUMN IF EXISTS prev_secret_expires_at;
+ALTER TABLE app_registry DROP COLUMN IF EXISTS prev_encrypted_shared_secret;
@@ -0,0 +1,16 @@
+-- after a secret rotation the previous secret is used to sign requests until it expires
+ALTER TABLE app_registry ADD COLUMN IF NOT EXISTS prev_encrypted_shared_secret CHAR(64);
+ALTER TABLE app_registry ADD COLUMN IF NOT EXISTS prev_secret_expires_at TIMESTAMP;
+ALTER TABLE app_registry ADD COLUMN IF NOT EXISTS disabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- app_audit_log records the calls of app owners that read or change an app
+CREATE TABLE IF NOT EXISTS app_audit_log (
+    id         BIGSERIAL PRIMARY KEY,
+    app_id     CHAR(40)  NOT NULL,
+    actor_id   CHAR(40)  NOT NULL,
+    action     VARCHAR   NOT NULL,
+    details    VARCHAR   NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX app_audit_log_app_idx ON app_audit_log (app_id, id);
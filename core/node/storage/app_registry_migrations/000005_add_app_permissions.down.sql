@@ -0,0 +1,2 @@
+ALTER TABLE app_registry DROP COLUMN IF EXISTS stream_types;
+ALTER TABLE app_registry DROP COLUMN IF EXISTS scopes;
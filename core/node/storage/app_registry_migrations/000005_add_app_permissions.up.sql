@@ -0,0 +1,4 @@
+-- permissions declared by the app, apps without scopes are not restricted
+ALTER TABLE app_registry ADD COLUMN IF NOT EXISTS scopes INTEGER[];
+-- stream types the app can add events to, all stream types if empty
+ALTER TABLE app_registry ADD COLUMN IF NOT EXISTS stream_types INTEGER[];
@@ -6,7 +6,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/puzpuzpuz/xsync/v3"
 
 	. "github.com/towns-protocol/towns/core/node/base"
@@ -15,6 +14,17 @@ import (
 	. "github.com/towns-protocol/towns/core/node/shared"
 )
 
+// ephemeralStreamStorage is implemented by stream storage backends that support ephemeral streams
+// so the dead stream cleanup procedure can be shared between them.
+type ephemeralStreamStorage interface {
+	// readEphemeralStreamIds returns IDs of all ephemeral streams in storage.
+	readEphemeralStreamIds(ctx context.Context) ([]StreamId, error)
+
+	// deleteEphemeralStream deletes the given ephemeral stream with all its miniblocks.
+	// Returns Err_NOT_FOUND if the stream does not exist or is not ephemeral.
+	deleteEphemeralStream(ctx context.Context, streamId StreamId) error
+}
+
 // ephemeralStreamMonitor is a monitor that keeps track of ephemeral streams and cleans up dead ones.
 type ephemeralStreamMonitor struct {
 	// streams is a map of ephemeral stream IDs to the creation time.
@@ -23,7 +33,7 @@ type ephemeralStreamMonitor struct {
 	// ttl is the duration of time an ephemeral stream can exist
 	// before either being sealed/normalized or deleted.
 	ttl      time.Duration
-	storage  *PostgresStreamStore
+	storage  ephemeralStreamStorage
 	stopOnce sync.Once
 	stop     chan struct{}
 }
@@ -32,7 +42,7 @@ type ephemeralStreamMonitor struct {
 func newEphemeralStreamMonitor(
 	ctx context.Context,
 	ttl time.Duration,
-	storage *PostgresStreamStore,
+	storage ephemeralStreamStorage,
 ) (*ephemeralStreamMonitor, error) {
 	if ttl == 0 {
 		ttl = time.Minute * 10
@@ -108,30 +118,7 @@ func (m *ephemeralStreamMonitor) handleStream(ctx context.Context, streamId Stre
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	if err := m.storage.txRunner(
-		ctx,
-		"ephemeralStreamMonitor.handleStream",
-		pgx.ReadWrite,
-		func(ctx context.Context, tx pgx.Tx) error {
-			if _, err := m.storage.lockEphemeralStream(ctx, tx, streamId, true); err != nil {
-				return err
-			}
-
-			_, err := tx.Exec(
-				ctx,
-				m.storage.sqlForStream(
-					`DELETE from {{miniblocks}} WHERE stream_id = $1;
-									 DELETE from {{minipools}} WHERE stream_id = $1;
-									 DELETE FROM es WHERE stream_id = $1`,
-					streamId,
-				),
-				streamId,
-			)
-			return err
-		},
-		nil,
-		"streamId", streamId,
-	); err != nil {
+	if err := m.storage.deleteEphemeralStream(ctx, streamId); err != nil {
 		if !IsRiverErrorCode(err, Err_NOT_FOUND) {
 			logging.FromCtx(ctx).Error("failed to delete dead ephemeral stream", "err", err, "streamId", streamId)
 		}
@@ -140,33 +127,18 @@ func (m *ephemeralStreamMonitor) handleStream(ctx context.Context, streamId Stre
 	return true
 }
 
-// loadEphemeralStreams loads all ephemeral streams from the database.
+// loadEphemeralStreams loads all ephemeral streams from the storage.
 func (m *ephemeralStreamMonitor) loadEphemeralStreams(ctx context.Context) error {
-	return m.storage.txRunner(
-		ctx,
-		"ephemeralStreamMonitor.loadEphemeralStreams",
-		pgx.ReadWrite,
-		func(ctx context.Context, tx pgx.Tx) error {
-			rows, err := tx.Query(ctx, "SELECT stream_id FROM es WHERE ephemeral = true")
-			if err != nil {
-				return err
-			}
-
-			var stream string
-			_, err = pgx.ForEachRow(rows, []any{&stream}, func() error {
-				streamId, err := StreamIdFromString(stream)
-				if err != nil {
-					return err
-				}
+	streamIds, err := m.storage.readEphemeralStreamIds(ctx)
+	if err != nil {
+		return err
+	}
 
-				// This is fine to assume that the last update timestamp is now since this function
-				// called only once on startup.
-				m.streams.Store(streamId, time.Now())
+	// This is fine to assume that the last update timestamp is now since this function
+	// called only once on startup.
+	for _, streamId := range streamIds {
+		m.streams.Store(streamId, time.Now())
+	}
 
-				return nil
-			})
-			return err
-		},
-		nil,
-	)
+	return nil
 }
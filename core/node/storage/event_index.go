@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// IndexedEvent is the searchable part of a stream event: envelope fields and the plaintext payload.
+type IndexedEvent struct {
+	StreamId     StreamId
+	MiniblockNum int64
+	// EventNum is the number of the event in the stream.
+	EventNum  int64
+	EventHash common.Hash
+	Creator   common.Address
+	// User is the user the event is about, for example the user that joined or left the stream.
+	// Zero address if the event is not about a specific user.
+	User common.Address
+	// EventType is the payload and content case of the event, for example "member_payload.membership".
+	EventType string
+	// CreatedAt is the timestamp of the miniblock the event is included in.
+	CreatedAt time.Time
+	// Payload is the JSON encoding of the event payload with encrypted data removed.
+	Payload []byte
+}
+
+// Cursor returns the position of the event in search results.
+func (e *IndexedEvent) Cursor() *EventIndexCursor {
+	return &EventIndexCursor{CreatedAt: e.CreatedAt, StreamId: e.StreamId, EventNum: e.EventNum}
+}
+
+// EventIndexCursor is the position in search results. Search results are ordered
+// by creation time, stream id and event number.
+type EventIndexCursor struct {
+	CreatedAt time.Time
+	StreamId  StreamId
+	EventNum  int64
+}
+
+// Less returns true if the event at position c is returned before the event at position o.
+func (c *EventIndexCursor) Less(o *EventIndexCursor) bool {
+	if !c.CreatedAt.Equal(o.CreatedAt) {
+		return c.CreatedAt.Before(o.CreatedAt)
+	}
+	if c.StreamId != o.StreamId {
+		return c.StreamId.Compare(o.StreamId) < 0
+	}
+	return c.EventNum < o.EventNum
+}
+
+// EventSearchQuery selects indexed events. Empty fields match all events.
+type EventSearchQuery struct {
+	StreamId *StreamId
+	// User matches events created by or about the user.
+	User       *common.Address
+	EventTypes []string
+	// From and To limit the creation time of the events to [From, To).
+	From time.Time
+	To   time.Time
+	// After continues the search after the given position.
+	After *EventIndexCursor
+	Limit int
+}
+
+// EventIndexStore stores the search index over stream events.
+type EventIndexStore interface {
+	// IndexEvents adds the given events to the index. Events that are already indexed are ignored.
+	IndexEvents(ctx context.Context, events []*IndexedEvent) error
+
+	// SearchEvents returns at most query.Limit indexed events that match the query ordered
+	// by creation time, stream id and event number.
+	SearchEvents(ctx context.Context, query *EventSearchQuery) ([]*IndexedEvent, error)
+}
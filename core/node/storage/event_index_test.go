@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestEventIndex(t *testing.T) {
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
+		index := store.(EventIndexStore)
+
+		alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+		bob := common.HexToAddress("0x2222222222222222222222222222222222222222")
+		channel := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		space := testutils.FakeStreamId(STREAM_SPACE_BIN)
+		start := time.Now().Truncate(time.Millisecond).UTC()
+
+		makeEvent := func(streamId StreamId, eventNum int64, creator, user common.Address, eventType string) *IndexedEvent {
+			return &IndexedEvent{
+				StreamId:     streamId,
+				MiniblockNum: eventNum / 2,
+				EventNum:     eventNum,
+				EventHash:    common.BytesToHash([]byte{byte(eventNum), streamId[1]}),
+				Creator:      creator,
+				User:         user,
+				EventType:    eventType,
+				CreatedAt:    start.Add(time.Duration(eventNum) * time.Second),
+				Payload:      []byte(`{"op":"SO_JOIN"}`),
+			}
+		}
+		all := []*IndexedEvent{
+			makeEvent(space, 0, alice, common.Address{}, "space_payload.inception"),
+			makeEvent(space, 1, alice, bob, "member_payload.membership"),
+			makeEvent(channel, 2, bob, common.Address{}, "channel_payload.message"),
+			makeEvent(channel, 3, alice, bob, "member_payload.pin"),
+			makeEvent(channel, 4, alice, common.Address{}, "channel_payload.message"),
+		}
+		require.NoError(index.IndexEvents(ctx, all[:3]))
+		// Indexing events again is a no-op.
+		require.NoError(index.IndexEvents(ctx, all))
+
+		search := func(query *EventSearchQuery) []*IndexedEvent {
+			if query.Limit == 0 {
+				query.Limit = 100
+			}
+			found, err := index.SearchEvents(ctx, query)
+			require.NoError(err)
+			for _, e := range found {
+				require.JSONEq(`{"op":"SO_JOIN"}`, string(e.Payload))
+				e.Payload = nil
+			}
+			return found
+		}
+		expected := func(events ...*IndexedEvent) []*IndexedEvent {
+			var ret []*IndexedEvent
+			for _, e := range events {
+				copied := *e
+				copied.Payload = nil
+				ret = append(ret, &copied)
+			}
+			return ret
+		}
+		requireEvents := func(expected []*IndexedEvent, found []*IndexedEvent) {
+			require.Len(found, len(expected))
+			for i := range expected {
+				require.True(expected[i].CreatedAt.Equal(found[i].CreatedAt))
+				found[i].CreatedAt = expected[i].CreatedAt
+			}
+			require.Equal(expected, found)
+		}
+
+		requireEvents(expected(all...), search(&EventSearchQuery{}))
+		requireEvents(expected(all[2:]...), search(&EventSearchQuery{StreamId: &channel}))
+		requireEvents(expected(all[1], all[2], all[3]), search(&EventSearchQuery{User: &bob}))
+		requireEvents(
+			expected(all[2], all[4]),
+			search(&EventSearchQuery{EventTypes: []string{"channel_payload.message"}}),
+		)
+		requireEvents(
+			expected(all[3]),
+			search(&EventSearchQuery{User: &bob, StreamId: &channel, EventTypes: []string{"member_payload.pin"}}),
+		)
+		requireEvents(
+			expected(all[1], all[2]),
+			search(&EventSearchQuery{From: all[1].CreatedAt, To: all[3].CreatedAt}),
+		)
+
+		// Pagination.
+		page := search(&EventSearchQuery{Limit: 2})
+		requireEvents(expected(all[0], all[1]), page)
+		page = search(&EventSearchQuery{Limit: 2, After: page[1].Cursor()})
+		requireEvents(expected(all[2], all[3]), page)
+		page = search(&EventSearchQuery{Limit: 2, After: page[1].Cursor(), StreamId: &channel})
+		requireEvents(expected(all[4]), page)
+	})
+}
+
+func TestEventIndexTrimAndDeleteStream(t *testing.T) {
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
+		index := store.(EventIndexStore)
+
+		alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+		bob := common.HexToAddress("0x2222222222222222222222222222222222222222")
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		start := time.Now().Truncate(time.Millisecond).UTC()
+		dataMaker := newDataMaker()
+
+		genMB, _ := dataMaker.mb()
+		require.NoError(store.CreateStreamStorage(ctx, streamId, genMB))
+		mbs := dataMaker.mbs(1, 5)
+		mbs[2].Snapshot = true
+		require.NoError(store.WriteMiniblocks(ctx, streamId, mbs, 6, dataMaker.events(3), 1, 0))
+
+		var events []*IndexedEvent
+		for i := range int64(6) {
+			events = append(events, &IndexedEvent{
+				StreamId:     streamId,
+				MiniblockNum: i,
+				EventNum:     i,
+				EventHash:    common.BytesToHash([]byte{byte(i)}),
+				Creator:      alice,
+				User:         bob,
+				EventType:    "channel_payload.message",
+				CreatedAt:    start.Add(time.Duration(i) * time.Second),
+				Payload:      []byte(`{}`),
+			})
+		}
+		require.NoError(index.IndexEvents(ctx, events))
+
+		eventNums := func(query *EventSearchQuery) []int64 {
+			query.Limit = 100
+			found, err := index.SearchEvents(ctx, query)
+			require.NoError(err)
+			var nums []int64
+			for _, e := range found {
+				nums = append(nums, e.EventNum)
+			}
+			return nums
+		}
+
+		// Trimming removes the events of trimmed miniblocks from all indexes.
+		require.NoError(store.TrimStream(ctx, streamId, 3))
+		require.Equal([]int64{3, 4, 5}, eventNums(&EventSearchQuery{StreamId: &streamId}))
+		require.Equal([]int64{3, 4, 5}, eventNums(&EventSearchQuery{}))
+		require.Equal([]int64{3, 4, 5}, eventNums(&EventSearchQuery{User: &bob}))
+
+		deleter := store.(interface {
+			DeleteStream(ctx context.Context, streamId StreamId) error
+		})
+		require.NoError(deleter.DeleteStream(ctx, streamId))
+		require.Empty(eventNums(&EventSearchQuery{StreamId: &streamId}))
+		require.Empty(eventNums(&EventSearchQuery{}))
+		require.Empty(eventNums(&EventSearchQuery{User: &alice}))
+	})
+}
@@ -0,0 +1,9 @@
+-- Index of miniblock ranges offloaded by archive nodes into immutable segment files.
+-- Offloaded miniblocks are removed from the miniblocks table.
+CREATE TABLE IF NOT EXISTS miniblock_segments (
+  stream_id CHAR(64) NOT NULL,
+  from_seq_num BIGINT NOT NULL,
+  to_seq_num BIGINT NOT NULL,
+  content_hash CHAR(64) NOT NULL,
+  PRIMARY KEY (stream_id, from_seq_num));
+ALTER TABLE miniblock_segments ALTER COLUMN stream_id SET STORAGE PLAIN;
@@ -0,0 +1,20 @@
+-- Search index over envelope and plaintext payload fields of stream events.
+-- Encrypted event content is not stored.
+CREATE TABLE IF NOT EXISTS event_index (
+  stream_id CHAR(64) NOT NULL,
+  event_num BIGINT NOT NULL,
+  miniblock_num BIGINT NOT NULL,
+  event_hash CHAR(64) NOT NULL,
+  creator CHAR(40) NOT NULL,
+  user_address CHAR(40) NOT NULL,
+  event_type VARCHAR NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  payload JSONB NOT NULL,
+  PRIMARY KEY (stream_id, event_num));
+ALTER TABLE event_index ALTER COLUMN stream_id SET STORAGE PLAIN;
+
+CREATE INDEX IF NOT EXISTS event_index_stream_idx ON event_index (stream_id, created_at);
+CREATE INDEX IF NOT EXISTS event_index_creator_idx ON event_index (creator, created_at);
+CREATE INDEX IF NOT EXISTS event_index_user_idx ON event_index (user_address, created_at);
+CREATE INDEX IF NOT EXISTS event_index_type_idx ON event_index (event_type, created_at);
+CREATE INDEX IF NOT EXISTS event_index_created_at_idx ON event_index (created_at);
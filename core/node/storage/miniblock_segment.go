@@ -0,0 +1,283 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// Miniblock segments are immutable files that contain a sealed range of miniblocks of a single stream.
+// Segments are content-addressed: segment is stored under the hex encoded sha256 hash of its content.
+//
+// Segment layout, all integers are big endian:
+//
+//	magic "RSEG" | version uint16 | stream id length uint8 | stream id | first miniblock num int64 | count uint32
+//	index: count * (offset uint64 | length uint32 | miniblock header hash [32]byte)
+//	miniblock data
+var miniblockSegmentMagic = []byte("RSEG")
+
+const (
+	miniblockSegmentVersion        = 1
+	miniblockSegmentIndexEntrySize = 8 + 4 + common.HashLength
+)
+
+// SegmentBackend stores immutable miniblock segment files.
+type SegmentBackend interface {
+	// PutSegment stores the segment under the given name. Storing an already existing segment is a no-op.
+	PutSegment(ctx context.Context, name string, data []byte) error
+
+	// GetSegment returns the segment with the given name.
+	// Returns Err_NOT_FOUND if there is no segment with the given name.
+	GetSegment(ctx context.Context, name string) ([]byte, error)
+
+	// DeleteSegment removes the segment with the given name. Deleting a missing segment is a no-op.
+	DeleteSegment(ctx context.Context, name string) error
+}
+
+// ColdTierStorage is implemented by stream storage that can offload sealed ranges of miniblocks
+// into immutable segment files. Offloaded miniblocks are still returned by ReadMiniblocks.
+type ColdTierStorage interface {
+	// OffloadMiniblocks moves full segments of segmentSize miniblocks from the database into segment files.
+	// The last miniblock of the stream is always kept in the database.
+	// Returns the number of offloaded miniblocks.
+	OffloadMiniblocks(ctx context.Context, streamId StreamId, segmentSize int64) (int64, error)
+}
+
+// LocalSegmentBackend stores segments as files in a directory.
+// The directory can be a mounted S3-compatible bucket.
+type LocalSegmentBackend struct {
+	dir string
+}
+
+var _ SegmentBackend = (*LocalSegmentBackend)(nil)
+
+func NewLocalSegmentBackend(dir string) (*LocalSegmentBackend, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).
+			Message("Failed to create segment directory").
+			Tag("dir", dir).
+			Func("NewLocalSegmentBackend")
+	}
+	return &LocalSegmentBackend{dir: dir}, nil
+}
+
+func (b *LocalSegmentBackend) PutSegment(_ context.Context, name string, data []byte) error {
+	path := filepath.Join(b.dir, name)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Write into a temporary file first, so partially written segments are never visible.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (b *LocalSegmentBackend) GetSegment(_ context.Context, name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(b.dir, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, RiverError(Err_NOT_FOUND, "Segment not found", "name", name)
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+func (b *LocalSegmentBackend) DeleteSegment(_ context.Context, name string) error {
+	if err := os.Remove(filepath.Join(b.dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// miniblockSegmentName returns the name under which the segment with the given content hash is stored.
+func miniblockSegmentName(streamId StreamId, contentHash string) string {
+	return streamId.String() + "/" + contentHash + ".seg"
+}
+
+type miniblockSegment struct {
+	streamId      StreamId
+	fromInclusive int64
+	miniblocks    [][]byte
+}
+
+// verifySegmentMiniblock checks that the given miniblock has the expected number and the header hash
+// matches the header event. Returns the header hash.
+func verifySegmentMiniblock(data []byte, expectedNum int64) (common.Hash, error) {
+	var mb Miniblock
+	if err := proto.Unmarshal(data, &mb); err != nil {
+		return common.Hash{}, AsRiverError(err, Err_BAD_BLOCK).
+			Message("Failed to decode miniblock").
+			Tag("miniblockNum", expectedNum)
+	}
+
+	header := mb.GetHeader()
+	hash := common.BytesToHash(header.GetHash())
+	if crypto.RiverHash(header.GetEvent()) != hash {
+		return common.Hash{}, RiverError(Err_BAD_BLOCK, "Miniblock header hash mismatch", "miniblockNum", expectedNum)
+	}
+
+	var event StreamEvent
+	if err := proto.Unmarshal(header.GetEvent(), &event); err != nil {
+		return common.Hash{}, AsRiverError(err, Err_BAD_BLOCK).
+			Message("Failed to decode miniblock header").
+			Tag("miniblockNum", expectedNum)
+	}
+	mbHeader := event.GetMiniblockHeader()
+	if mbHeader == nil {
+		return common.Hash{}, RiverError(Err_BAD_BLOCK, "Miniblock header is missing", "miniblockNum", expectedNum)
+	}
+	if mbHeader.GetMiniblockNum() != expectedNum {
+		return common.Hash{}, RiverError(Err_BAD_BLOCK, "Miniblock number mismatch").
+			Tag("miniblockNum", mbHeader.GetMiniblockNum()).
+			Tag("expectedMiniblockNum", expectedNum)
+	}
+
+	return hash, nil
+}
+
+// encodeMiniblockSegment verifies the given miniblocks and encodes them into a segment.
+// Returns the segment content and its content hash.
+func encodeMiniblockSegment(
+	streamId StreamId,
+	fromInclusive int64,
+	miniblocks [][]byte,
+) ([]byte, string, error) {
+	var buf bytes.Buffer
+	buf.Write(miniblockSegmentMagic)
+	_ = binary.Write(&buf, binary.BigEndian, uint16(miniblockSegmentVersion))
+	buf.WriteByte(byte(len(streamId)))
+	buf.Write(streamId[:])
+	_ = binary.Write(&buf, binary.BigEndian, fromInclusive)
+	_ = binary.Write(&buf, binary.BigEndian, uint32(len(miniblocks)))
+
+	offset := uint64(buf.Len() + len(miniblocks)*miniblockSegmentIndexEntrySize)
+	for i, mb := range miniblocks {
+		hash, err := verifySegmentMiniblock(mb, fromInclusive+int64(i))
+		if err != nil {
+			return nil, "", AsRiverError(err).Tag("streamId", streamId)
+		}
+		_ = binary.Write(&buf, binary.BigEndian, offset)
+		_ = binary.Write(&buf, binary.BigEndian, uint32(len(mb)))
+		buf.Write(hash[:])
+		offset += uint64(len(mb))
+	}
+	for _, mb := range miniblocks {
+		buf.Write(mb)
+	}
+
+	data := buf.Bytes()
+	contentHash := sha256.Sum256(data)
+	return data, hex.EncodeToString(contentHash[:]), nil
+}
+
+// decodeMiniblockSegment checks the content hash of the segment, decodes it and verifies
+// the miniblocks against the header hashes from the segment index.
+func decodeMiniblockSegment(data []byte, contentHash string) (*miniblockSegment, error) {
+	corrupted := func(msg string) error {
+		return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, msg, "contentHash", contentHash)
+	}
+
+	actualHash := sha256.Sum256(data)
+	if hex.EncodeToString(actualHash[:]) != contentHash {
+		return nil, corrupted("Segment content hash mismatch")
+	}
+
+	r := bytes.NewReader(data)
+	magic := make([]byte, len(miniblockSegmentMagic))
+	if _, err := r.Read(magic); err != nil || !bytes.Equal(magic, miniblockSegmentMagic) {
+		return nil, corrupted("Invalid segment magic")
+	}
+
+	var (
+		version uint16
+		idLen   uint8
+	)
+	if err := errors.Join(
+		binary.Read(r, binary.BigEndian, &version),
+		binary.Read(r, binary.BigEndian, &idLen),
+	); err != nil || version != miniblockSegmentVersion {
+		return nil, corrupted("Unsupported segment version")
+	}
+	streamIdBytes := make([]byte, idLen)
+	if _, err := r.Read(streamIdBytes); err != nil {
+		return nil, corrupted("Failed to read segment stream id")
+	}
+	streamId, err := StreamIdFromBytes(streamIdBytes)
+	if err != nil {
+		return nil, corrupted("Invalid segment stream id")
+	}
+
+	seg := &miniblockSegment{streamId: streamId}
+	var count uint32
+	if err := errors.Join(
+		binary.Read(r, binary.BigEndian, &seg.fromInclusive),
+		binary.Read(r, binary.BigEndian, &count),
+	); err != nil {
+		return nil, corrupted("Failed to read segment header")
+	}
+
+	seg.miniblocks = make([][]byte, 0, count)
+	for i := range int64(count) {
+		var (
+			offset uint64
+			length uint32
+			hash   common.Hash
+		)
+		if err := errors.Join(
+			binary.Read(r, binary.BigEndian, &offset),
+			binary.Read(r, binary.BigEndian, &length),
+			binary.Read(r, binary.BigEndian, &hash),
+		); err != nil {
+			return nil, corrupted("Failed to read segment index")
+		}
+		if offset+uint64(length) > uint64(len(data)) {
+			return nil, corrupted("Segment index entry is out of range")
+		}
+
+		mb := data[offset : offset+uint64(length)]
+		mbHash, err := verifySegmentMiniblock(mb, seg.fromInclusive+i)
+		if err != nil {
+			return nil, AsRiverError(err).Tag("contentHash", contentHash)
+		}
+		if mbHash != hash {
+			return nil, corrupted("Segment index hash mismatch")
+		}
+		seg.miniblocks = append(seg.miniblocks, mb)
+	}
+
+	return seg, nil
+}
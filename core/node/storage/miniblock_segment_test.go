@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func makeSegmentTestMiniblocks(t *testing.T, from int64, n int) [][]byte {
+	var ret [][]byte
+	for i := range int64(n) {
+		event, err := proto.Marshal(&StreamEvent{
+			Payload: &StreamEvent_MiniblockHeader{
+				MiniblockHeader: &MiniblockHeader{MiniblockNum: from + i},
+			},
+		})
+		require.NoError(t, err)
+		mb, err := proto.Marshal(&Miniblock{
+			Header: &Envelope{Event: event, Hash: crypto.RiverHash(event).Bytes()},
+		})
+		require.NoError(t, err)
+		ret = append(ret, mb)
+	}
+	return ret
+}
+
+func TestMiniblockSegment(t *testing.T) {
+	require := require.New(t)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	miniblocks := makeSegmentTestMiniblocks(t, 10, 5)
+
+	data, contentHash, err := encodeMiniblockSegment(streamId, 10, miniblocks)
+	require.NoError(err)
+
+	segment, err := decodeMiniblockSegment(data, contentHash)
+	require.NoError(err)
+	require.Equal(streamId, segment.streamId)
+	require.EqualValues(10, segment.fromInclusive)
+	require.Equal(miniblocks, segment.miniblocks)
+
+	// Segment content is verified against the content hash.
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	_, err = decodeMiniblockSegment(corrupted, contentHash)
+	require.Equal(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+
+	// Miniblocks must have expected numbers.
+	_, _, err = encodeMiniblockSegment(streamId, 11, miniblocks)
+	require.Equal(Err_BAD_BLOCK, AsRiverError(err).Code)
+}
+
+func TestLocalSegmentBackend(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	backend, err := NewLocalSegmentBackend(t.TempDir())
+	require.NoError(err)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	name := miniblockSegmentName(streamId, "abc")
+
+	_, err = backend.GetSegment(ctx, name)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	require.NoError(backend.PutSegment(ctx, name, []byte("segment")))
+	require.NoError(backend.PutSegment(ctx, name, []byte("segment")))
+
+	data, err := backend.GetSegment(ctx, name)
+	require.NoError(err)
+	require.Equal([]byte("segment"), data)
+
+	require.NoError(backend.DeleteSegment(ctx, name))
+	require.NoError(backend.DeleteSegment(ctx, name))
+	_, err = backend.GetSegment(ctx, name)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+}
+
+func TestOffloadMiniblocks(t *testing.T) {
+	params := setupStreamStorageTest(t)
+	require := require.New(t)
+	ctx := params.ctx
+	store := params.pgStreamStore
+	defer params.closer()
+
+	backend, err := NewLocalSegmentBackend(t.TempDir())
+	require.NoError(err)
+	store.EnableColdTier(backend)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	miniblocks := makeSegmentTestMiniblocks(t, 0, 12)
+	require.NoError(store.CreateStreamArchiveStorage(ctx, streamId))
+	require.NoError(store.WriteArchiveMiniblocks(ctx, streamId, 0, miniblocks))
+
+	offloaded, err := store.OffloadMiniblocks(ctx, streamId, 5)
+	require.NoError(err)
+	require.EqualValues(10, offloaded)
+
+	// Nothing is left to offload: the last segment is not full.
+	offloaded, err = store.OffloadMiniblocks(ctx, streamId, 5)
+	require.NoError(err)
+	require.EqualValues(0, offloaded)
+
+	maxArchived, err := store.GetMaxArchivedMiniblockNumber(ctx, streamId)
+	require.NoError(err)
+	require.EqualValues(11, maxArchived)
+
+	// Reads transparently span segments and the database.
+	read, err := store.ReadMiniblocks(ctx, streamId, 0, 12)
+	require.NoError(err)
+	require.Equal(miniblocks, read)
+
+	read, err = store.ReadMiniblocks(ctx, streamId, 3, 7)
+	require.NoError(err)
+	require.Equal(miniblocks[3:7], read)
+
+	read, err = store.ReadMiniblocks(ctx, streamId, 8, 11)
+	require.NoError(err)
+	require.Equal(miniblocks[8:11], read)
+
+	// Archiving continues after the offloaded range.
+	more := makeSegmentTestMiniblocks(t, 12, 3)
+	require.NoError(store.WriteArchiveMiniblocks(ctx, streamId, 12, more))
+
+	offloaded, err = store.OffloadMiniblocks(ctx, streamId, 5)
+	require.NoError(err)
+	require.EqualValues(0, offloaded)
+
+	read, err = store.ReadMiniblocks(ctx, streamId, 0, 15)
+	require.NoError(err)
+	all := append(miniblocks, more...)
+	require.Equal(all, read)
+
+	// Streaming reads include the offloaded miniblocks.
+	read = nil
+	require.NoError(store.ReadMiniblocksByStream(ctx, streamId, func(blockdata []byte, seqNum int64) error {
+		require.EqualValues(len(read), seqNum)
+		read = append(read, blockdata)
+		return nil
+	}))
+	require.Equal(all, read)
+
+	var seqNums []int64
+	require.NoError(store.ReadMiniblocksByIds(ctx, streamId, []int64{2, 9, 13}, func(blockdata []byte, seqNum int64) error {
+		require.Equal(all[seqNum], blockdata)
+		seqNums = append(seqNums, seqNum)
+		return nil
+	}))
+	require.Equal([]int64{2, 9, 13}, seqNums)
+
+	// Deleting the stream removes its segments.
+	require.NoError(store.DeleteStream(ctx, streamId))
+	var segments int
+	require.NoError(store.pool.QueryRow(
+		ctx, "SELECT COUNT(*) FROM miniblock_segments WHERE stream_id = $1", streamId,
+	).Scan(&segments))
+	require.Zero(segments)
+}
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS notification_outbox (
+    id               BIGSERIAL PRIMARY KEY,
+    provider         VARCHAR   NOT NULL,
+    user_id          CHAR(40)  NOT NULL,
+    event_hash       CHAR(64)  NOT NULL,
+    target           BYTEA     NOT NULL,
+    payload          BYTEA     NOT NULL,
+    attempts         INT       NOT NULL DEFAULT 0,
+    next_attempt     TIMESTAMP NOT NULL,
+    failure_reason   VARCHAR,
+    last_error       VARCHAR,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    dead_lettered_at TIMESTAMP
+);
+
+CREATE INDEX notification_outbox_due_idx ON notification_outbox (provider, next_attempt) WHERE dead_lettered_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS FCM_SUB_USER_ID_IDX;
+DROP TABLE IF EXISTS fcmpushsubscriptions;
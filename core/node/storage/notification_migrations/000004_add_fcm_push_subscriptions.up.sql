@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS fcmpushsubscriptions (
+    token        VARCHAR PRIMARY KEY NOT NULL,
+    user_id      CHAR(40)            NOT NULL,
+    last_seen    TIMESTAMP           NOT NULL,
+    push_version INT                 NOT NULL DEFAULT 1
+);
+
+CREATE INDEX FCM_SUB_USER_ID_IDX ON fcmpushsubscriptions USING hash (user_id);
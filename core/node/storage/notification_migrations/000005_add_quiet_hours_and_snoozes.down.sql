@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS snoozes;
+DROP TABLE IF EXISTS quiethours;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS quiethours (
+    user_id        CHAR(40) PRIMARY KEY NOT NULL,
+    timezone       VARCHAR              NOT NULL,
+    windows        BYTEA                NOT NULL,
+    allow_mentions BOOLEAN              NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS snoozes (
+    user_id        CHAR(40) NOT NULL,
+    -- empty for snoozes that apply to all streams
+    space_id       VARCHAR  NOT NULL,
+    until_ms       BIGINT   NOT NULL,
+    allow_mentions BOOLEAN  NOT NULL,
+    PRIMARY KEY (user_id, space_id)
+);
@@ -0,0 +1 @@
+ALTER TABLE notification_outbox ADD COLUMN IF NOT EXISTS collapse_id VARCHAR NOT NULL DEFAULT '';
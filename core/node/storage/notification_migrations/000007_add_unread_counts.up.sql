@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS unreadcounts (
+    user_id    CHAR(40) NOT NULL,
+    channel_id VARCHAR  NOT NULL,
+    unread     INT      NOT NULL,
+    mentions   INT      NOT NULL,
+    PRIMARY KEY (user_id, channel_id)
+);
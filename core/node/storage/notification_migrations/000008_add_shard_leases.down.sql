@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS notification_shard_leases;
+DROP TABLE IF EXISTS notification_instances;
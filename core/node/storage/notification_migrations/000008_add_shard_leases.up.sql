@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS notification_instances (
+    instance_id VARCHAR   PRIMARY KEY,
+    expires_at  TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS notification_shard_leases (
+    shard      INT       PRIMARY KEY,
+    owner      VARCHAR   NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
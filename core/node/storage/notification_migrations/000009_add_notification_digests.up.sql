@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS notification_digests (
+    user_id    CHAR(40)  NOT NULL,
+    channel_id VARCHAR   NOT NULL,
+    space_id   VARCHAR   NOT NULL,
+    event      BYTEA     NOT NULL,
+    kind       VARCHAR   NOT NULL,
+    members    VARCHAR[] NOT NULL,
+    count      INT       NOT NULL,
+    deliver_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, channel_id)
+);
+
+CREATE INDEX notification_digests_due_idx ON notification_digests (deliver_at);
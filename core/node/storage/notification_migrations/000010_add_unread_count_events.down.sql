@@ -0,0 +1 @@
+DROP TABLE IF EXISTS unreadcount_events;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS unreadcount_events (
+    user_id    CHAR(40)  NOT NULL,
+    channel_id VARCHAR   NOT NULL,
+    event_hash CHAR(64)  NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, channel_id, event_hash)
+);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS unreadcount_events_created_at_idx;
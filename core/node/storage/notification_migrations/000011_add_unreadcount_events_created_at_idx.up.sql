@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS unreadcount_events_created_at_idx ON unreadcount_events (created_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS notification_outbox_dedup_idx;
+DELETE FROM notification_outbox WHERE delivered_at IS NOT NULL;
+DROP INDEX IF EXISTS notification_outbox_due_idx;
+ALTER TABLE notification_outbox DROP COLUMN IF EXISTS delivered_at;
+CREATE INDEX notification_outbox_due_idx ON notification_outbox (provider, next_attempt) WHERE dead_lettered_at IS NULL;
@@ -0,0 +1,12 @@
+-- delivered notifications are kept for a while so that notifications for the same event and subscription that are
+-- enqueued again, for example by the new holder of a shard lease, are ignored
+ALTER TABLE notification_outbox ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMP;
+
+DELETE FROM notification_outbox a USING notification_outbox b
+WHERE a.id > b.id AND a.provider = b.provider AND a.event_hash = b.event_hash AND a.target = b.target;
+
+CREATE UNIQUE INDEX IF NOT EXISTS notification_outbox_dedup_idx ON notification_outbox (provider, event_hash, md5(target));
+
+DROP INDEX IF EXISTS notification_outbox_due_idx;
+CREATE INDEX notification_outbox_due_idx ON notification_outbox (provider, next_attempt)
+    WHERE dead_lettered_at IS NULL AND delivered_at IS NULL;
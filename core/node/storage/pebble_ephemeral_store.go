@@ -0,0 +1,282 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// CreateEphemeralStreamStorage creates a new ephemeral stream storage with the given stream ID and genesis miniblock.
+func (s *PebbleStreamStore) CreateEphemeralStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	genesisMiniblock []byte,
+) error {
+	if err := s.opRunner(
+		ctx,
+		"CreateEphemeralStreamStorage",
+		true,
+		func() error {
+			return s.createStream(
+				streamId,
+				&pebbleStreamRecord{latestSnapshotMiniblock: 0, ephemeral: true},
+				genesisMiniblock,
+				false,
+			)
+		},
+		"streamId", streamId,
+	); err != nil {
+		return err
+	}
+
+	// Add the ephemeral stream to the ephemeral stream monitor
+	s.esm.onCreated(streamId)
+
+	return nil
+}
+
+// ReadEphemeralMiniblockNums returns ephemeral miniblock numbers stream by the given stream ID.
+func (s *PebbleStreamStore) ReadEphemeralMiniblockNums(ctx context.Context, streamId StreamId) ([]int, error) {
+	var nums []int
+	err := s.opRunner(
+		ctx,
+		"ReadEphemeralMiniblockNums",
+		false,
+		func() error {
+			if _, err := s.readEphemeralStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			return s.forEach(
+				pebbleStreamTableBounds(pebbleMiniblockPrefix, streamId),
+				func(key []byte, _ []byte) error {
+					nums = append(nums, int(pebbleKeyNum(key, 0)))
+					return nil
+				},
+			)
+		},
+		"streamId", streamId,
+	)
+	return nums, err
+}
+
+// WriteEphemeralMiniblock adds a miniblock to the ephemeral miniblock store.
+func (s *PebbleStreamStore) WriteEphemeralMiniblock(
+	ctx context.Context,
+	streamId StreamId,
+	miniblock *WriteMiniblockData,
+) error {
+	return s.opRunner(
+		ctx,
+		"WriteEphemeralMiniblock",
+		true,
+		func() error {
+			batch := s.db.NewBatch()
+			defer batch.Close()
+
+			// If the given ephemeral stream does not exist, create one.
+			if _, err := s.readEphemeralStreamRecord(streamId); err != nil {
+				if !IsRiverErrorCode(err, Err_NOT_FOUND) {
+					return err
+				}
+				existing, err := s.get(pebbleStreamKey(streamId))
+				if err != nil {
+					return err
+				}
+				if existing != nil {
+					return RiverError(Err_ALREADY_EXISTS, "stream already exists and is not ephemeral")
+				}
+				record := &pebbleStreamRecord{latestSnapshotMiniblock: 0, ephemeral: true}
+				if err = batch.Set(pebbleStreamKey(streamId), record.marshal(), nil); err != nil {
+					return err
+				}
+			}
+
+			key := pebbleMiniblockKey(streamId, miniblock.Number)
+			existing, err := s.get(key)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return RiverError(Err_ALREADY_EXISTS, "Ephemeral miniblock already exists").
+					Tag("miniblockNum", miniblock.Number)
+			}
+			if err = batch.Set(key, miniblock.Data, nil); err != nil {
+				return err
+			}
+
+			return s.commit(batch)
+		},
+		"streamId", streamId,
+	)
+}
+
+func (s *PebbleStreamStore) NormalizeEphemeralStream(ctx context.Context, streamId StreamId) (common.Hash, error) {
+	var genesisMiniblockHash common.Hash
+	err := s.opRunner(
+		ctx,
+		"NormalizeEphemeralStream",
+		true,
+		func() error {
+			var err error
+			genesisMiniblockHash, err = s.normalizeEphemeralStream(streamId)
+			return err
+		},
+		"streamId", streamId,
+	)
+	return genesisMiniblockHash, err
+}
+
+func (s *PebbleStreamStore) normalizeEphemeralStream(streamId StreamId) (common.Hash, error) {
+	record, err := s.readEphemeralStreamRecord(streamId)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// Read the genesis miniblock for the given stream
+	genesisMbData, err := s.get(pebbleMiniblockKey(streamId, 0))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if genesisMbData == nil {
+		return common.Hash{}, RiverError(Err_NOT_FOUND, "Genesis miniblock of the given ephemeral stream not found",
+			"streamId", streamId)
+	}
+
+	var genesisMb Miniblock
+	if err := proto.Unmarshal(genesisMbData, &genesisMb); err != nil {
+		return common.Hash{}, RiverError(Err_INTERNAL, "Failed to decode genesis miniblock")
+	}
+	if len(genesisMb.GetEvents()) == 0 {
+		return common.Hash{}, RiverError(Err_INTERNAL, "Genesis miniblock has no events")
+	}
+
+	var mediaEvent StreamEvent
+	if err := proto.Unmarshal(genesisMb.GetEvents()[0].Event, &mediaEvent); err != nil {
+		return common.Hash{}, RiverError(Err_INTERNAL, "Failed to decode stream event from genesis miniblock")
+	}
+
+	// The miniblock with 0 number must be the genesis miniblock.
+	// The genesis miniblock must have the media inception event.
+	inception := mediaEvent.GetMediaPayload().GetInception()
+
+	// Check that there are no gaps in miniblock numbers.
+	var seqNum int64
+	if err = s.forEach(
+		pebbleStreamTableBounds(pebbleMiniblockPrefix, streamId),
+		func(key []byte, _ []byte) error {
+			num := pebbleKeyNum(key, 0)
+			if num != 0 && num != seqNum+1 {
+				// There is a gap in sequence numbers
+				return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+					Tag("ActualBlockNumber", num).
+					Tag("ExpectedBlockNumber", seqNum+1).
+					Tag("streamId", streamId)
+			}
+			seqNum = num
+			return nil
+		},
+	); err != nil {
+		return common.Hash{}, err
+	}
+
+	// Last miniblock number must be equal to the number of chunks + 1.
+	if seqNum != int64(inception.GetChunkCount()) {
+		return common.Hash{}, RiverError(Err_INTERNAL, "The ephemeral stream can not be normalized due to missing miniblocks")
+	}
+
+	// Remove ephemeral flag from the given stream and create the minipool.
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	record.ephemeral = false
+	if err = batch.Set(pebbleStreamKey(streamId), record.marshal(), nil); err != nil {
+		return common.Hash{}, err
+	}
+	if err = batch.Set(pebbleMinipoolKey(streamId, seqNum+1, -1), nil, nil); err != nil {
+		return common.Hash{}, err
+	}
+	if err = s.commit(batch); err != nil {
+		return common.Hash{}, err
+	}
+
+	// Delete the ephemeral stream from the ephemeral stream monitor
+	s.esm.onSealed(streamId)
+
+	return common.BytesToHash(genesisMb.Header.Hash), nil
+}
+
+// IsStreamEphemeral returns true if the stream is ephemeral, false otherwise.
+func (s *PebbleStreamStore) IsStreamEphemeral(ctx context.Context, streamId StreamId) (ephemeral bool, err error) {
+	err = s.opRunner(
+		ctx,
+		"IsStreamEphemeral",
+		false,
+		func() error {
+			record, err := s.readStreamRecord(streamId)
+			if err != nil {
+				return err
+			}
+			ephemeral = record.ephemeral
+			return nil
+		},
+		"streamId", streamId,
+	)
+	return
+}
+
+// readEphemeralStreamIds returns IDs of all ephemeral streams in the database.
+func (s *PebbleStreamStore) readEphemeralStreamIds(ctx context.Context) ([]StreamId, error) {
+	var streamIds []StreamId
+	err := s.opRunner(
+		ctx,
+		"ephemeralStreamMonitor.loadEphemeralStreams",
+		false,
+		func() error {
+			return s.forEach(
+				&pebble.IterOptions{
+					LowerBound: []byte{pebbleStreamPrefix},
+					UpperBound: []byte{pebbleStreamPrefix + 1},
+				},
+				func(key []byte, value []byte) error {
+					record, err := unmarshalPebbleStreamRecord(value)
+					if err != nil {
+						return err
+					}
+					if !record.ephemeral {
+						return nil
+					}
+					streamId, err := StreamIdFromBytes(key[1:])
+					if err != nil {
+						return err
+					}
+					streamIds = append(streamIds, streamId)
+					return nil
+				},
+			)
+		},
+	)
+	return streamIds, err
+}
+
+// deleteEphemeralStream deletes the given ephemeral stream with all its miniblocks.
+func (s *PebbleStreamStore) deleteEphemeralStream(ctx context.Context, streamId StreamId) error {
+	return s.opRunner(
+		ctx,
+		"ephemeralStreamMonitor.handleStream",
+		true,
+		func() error {
+			if _, err := s.readEphemeralStreamRecord(streamId); err != nil {
+				return err
+			}
+			return s.deleteStream(streamId)
+		},
+		"streamId", streamId,
+	)
+}
@@ -0,0 +1,294 @@
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+var _ EventIndexStore = (*PebbleStreamStore)(nil)
+
+// Event index key layout. Unlike the stream tables, secondary index keys are not prefixed with the stream id.
+//
+//	x<streamId><eventNum>                        -> indexed event
+//	w<createdAt><streamId><eventNum>             -> empty, index by time
+//	y<userAddress><createdAt><streamId><eventNum> -> empty, index by creator and user
+//
+// createdAt is encoded as big endian unix nanoseconds.
+const (
+	pebbleEventIndexPrefix     byte = 'x'
+	pebbleEventTimeIndexPrefix byte = 'w'
+	pebbleEventUserIndexPrefix byte = 'y'
+)
+
+// pebbleIndexedEvent is the value stored under the indexed event key.
+type pebbleIndexedEvent struct {
+	MiniblockNum int64           `json:"mb"`
+	EventHash    common.Hash     `json:"hash"`
+	Creator      common.Address  `json:"creator"`
+	User         common.Address  `json:"user"`
+	EventType    string          `json:"type"`
+	CreatedAt    int64           `json:"ts"`
+	Payload      json.RawMessage `json:"payload"`
+}
+
+func pebbleEventKey(streamId StreamId, eventNum int64) []byte {
+	return pebbleStreamTableKey(pebbleEventIndexPrefix, streamId, uint64(eventNum))
+}
+
+// pebbleEventPosition encodes the position of the event in search results.
+func pebbleEventPosition(createdAt time.Time, streamId StreamId, eventNum int64) []byte {
+	key := make([]byte, 0, 8+STREAM_ID_BYTES_LENGTH+8)
+	key = binary.BigEndian.AppendUint64(key, uint64(createdAt.UnixNano()))
+	key = append(key, streamId[:]...)
+	return binary.BigEndian.AppendUint64(key, uint64(eventNum))
+}
+
+func pebbleEventTimeIndexKey(position []byte) []byte {
+	return append([]byte{pebbleEventTimeIndexPrefix}, position...)
+}
+
+func pebbleEventUserIndexKey(user common.Address, position []byte) []byte {
+	key := append([]byte{pebbleEventUserIndexPrefix}, user[:]...)
+	return append(key, position...)
+}
+
+func pebbleTimeKey(prefix []byte, t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(slices.Clone(prefix), uint64(t.UnixNano()))
+}
+
+// IndexEvents adds the given events to the index.
+func (s *PebbleStreamStore) IndexEvents(ctx context.Context, events []*IndexedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return s.opRunner(
+		ctx,
+		"IndexEvents",
+		true,
+		func() error {
+			batch := s.db.NewBatch()
+			defer batch.Close()
+
+			for _, e := range events {
+				key := pebbleEventKey(e.StreamId, e.EventNum)
+				existing, err := s.get(key)
+				if err != nil {
+					return err
+				}
+				if existing != nil {
+					continue
+				}
+
+				value, err := json.Marshal(&pebbleIndexedEvent{
+					MiniblockNum: e.MiniblockNum,
+					EventHash:    e.EventHash,
+					Creator:      e.Creator,
+					User:         e.User,
+					EventType:    e.EventType,
+					CreatedAt:    e.CreatedAt.UnixNano(),
+					Payload:      e.Payload,
+				})
+				if err != nil {
+					return err
+				}
+				if err = batch.Set(key, value, nil); err != nil {
+					return err
+				}
+
+				position := pebbleEventPosition(e.CreatedAt, e.StreamId, e.EventNum)
+				if err = batch.Set(pebbleEventTimeIndexKey(position), nil, nil); err != nil {
+					return err
+				}
+				if err = batch.Set(pebbleEventUserIndexKey(e.Creator, position), nil, nil); err != nil {
+					return err
+				}
+				if e.User != (common.Address{}) && e.User != e.Creator {
+					if err = batch.Set(pebbleEventUserIndexKey(e.User, position), nil, nil); err != nil {
+						return err
+					}
+				}
+			}
+			return s.commit(batch)
+		},
+		"numEvents", len(events),
+	)
+}
+
+// deleteIndexedEvents adds the deletion of the indexed events of the stream in miniblocks before
+// beforeMiniblockNum and their time and user index entries to the batch.
+func (s *PebbleStreamStore) deleteIndexedEvents(
+	batch *pebble.Batch,
+	streamId StreamId,
+	beforeMiniblockNum int64,
+) error {
+	return s.forEach(
+		pebbleStreamTableBounds(pebbleEventIndexPrefix, streamId),
+		func(key []byte, value []byte) error {
+			e, err := unmarshalPebbleIndexedEvent(streamId, pebbleKeyNum(key, 0), value)
+			if err != nil {
+				return err
+			}
+			if e.MiniblockNum >= beforeMiniblockNum {
+				return nil
+			}
+
+			position := pebbleEventPosition(e.CreatedAt, streamId, e.EventNum)
+			for _, k := range [][]byte{
+				key,
+				pebbleEventTimeIndexKey(position),
+				pebbleEventUserIndexKey(e.Creator, position),
+				pebbleEventUserIndexKey(e.User, position),
+			} {
+				if err = batch.Delete(k, nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+func (s *PebbleStreamStore) readIndexedEvent(streamId StreamId, eventNum int64) (*IndexedEvent, error) {
+	value, err := s.get(pebbleEventKey(streamId, eventNum))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, RiverError(Err_NOT_FOUND, "Indexed event not found", "streamId", streamId, "eventNum", eventNum)
+	}
+	return unmarshalPebbleIndexedEvent(streamId, eventNum, value)
+}
+
+func unmarshalPebbleIndexedEvent(streamId StreamId, eventNum int64, value []byte) (*IndexedEvent, error) {
+	var record pebbleIndexedEvent
+	if err := json.Unmarshal(value, &record); err != nil {
+		return nil, err
+	}
+	return &IndexedEvent{
+		StreamId:     streamId,
+		MiniblockNum: record.MiniblockNum,
+		EventNum:     eventNum,
+		EventHash:    record.EventHash,
+		Creator:      record.Creator,
+		User:         record.User,
+		EventType:    record.EventType,
+		CreatedAt:    time.Unix(0, record.CreatedAt),
+		Payload:      record.Payload,
+	}, nil
+}
+
+// SearchEvents returns the indexed events that match the query.
+//
+// Events are looked up in the user index if the query has a user, in the stream events if the query
+// has a stream id and in the time index otherwise. Other conditions are checked on the found events.
+func (s *PebbleStreamStore) SearchEvents(ctx context.Context, query *EventSearchQuery) ([]*IndexedEvent, error) {
+	if query.Limit <= 0 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Limit must be positive", "limit", query.Limit).
+			Func("PebbleStreamStore.SearchEvents")
+	}
+
+	matches := func(e *IndexedEvent) bool {
+		if query.StreamId != nil && e.StreamId != *query.StreamId {
+			return false
+		}
+		if query.User != nil && e.Creator != *query.User && e.User != *query.User {
+			return false
+		}
+		if len(query.EventTypes) > 0 && !slices.Contains(query.EventTypes, e.EventType) {
+			return false
+		}
+		if !query.From.IsZero() && e.CreatedAt.Before(query.From) {
+			return false
+		}
+		if !query.To.IsZero() && !e.CreatedAt.Before(query.To) {
+			return false
+		}
+		return query.After == nil || query.After.Less(e.Cursor())
+	}
+
+	var events []*IndexedEvent
+	err := s.opRunner(
+		ctx,
+		"SearchEvents",
+		false,
+		func() error {
+			if query.User == nil && query.StreamId != nil {
+				// Stream events are ordered by event number, results are sorted after the scan.
+				return s.forEach(
+					pebbleStreamTableBounds(pebbleEventIndexPrefix, *query.StreamId),
+					func(key []byte, value []byte) error {
+						e, err := unmarshalPebbleIndexedEvent(*query.StreamId, pebbleKeyNum(key, 0), value)
+						if err != nil {
+							return err
+						}
+						if matches(e) {
+							events = append(events, e)
+						}
+						return nil
+					},
+				)
+			}
+
+			prefix := []byte{pebbleEventTimeIndexPrefix}
+			if query.User != nil {
+				prefix = append([]byte{pebbleEventUserIndexPrefix}, query.User[:]...)
+			}
+			opts := &pebble.IterOptions{LowerBound: prefix, UpperBound: pebbleUpperBound(prefix)}
+			if !query.From.IsZero() {
+				opts.LowerBound = pebbleTimeKey(prefix, query.From)
+			}
+			if query.After != nil && (query.From.IsZero() || query.After.CreatedAt.After(query.From)) {
+				opts.LowerBound = pebbleTimeKey(prefix, query.After.CreatedAt)
+			}
+			if !query.To.IsZero() {
+				opts.UpperBound = pebbleTimeKey(prefix, query.To)
+			}
+
+			iter, err := s.db.NewIter(opts)
+			if err != nil {
+				return err
+			}
+			defer iter.Close()
+			for iter.First(); iter.Valid() && len(events) < query.Limit; iter.Next() {
+				position := iter.Key()[len(prefix)+8:]
+				streamId, err := StreamIdFromBytes(position[:STREAM_ID_BYTES_LENGTH])
+				if err != nil {
+					return err
+				}
+				e, err := s.readIndexedEvent(streamId, int64(binary.BigEndian.Uint64(position[STREAM_ID_BYTES_LENGTH:])))
+				if err != nil {
+					return err
+				}
+				if matches(e) {
+					events = append(events, e)
+				}
+			}
+			return iter.Error()
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(events, func(a, b *IndexedEvent) int {
+		if a.Cursor().Less(b.Cursor()) {
+			return -1
+		}
+		return 1
+	})
+	if len(events) > query.Limit {
+		events = events[:query.Limit]
+	}
+	return events, nil
+}
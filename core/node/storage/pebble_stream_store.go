@@ -0,0 +1,1269 @@
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// PebbleStreamStore is a StreamStorage implementation backed by an embedded Pebble key-value store.
+// It is intended for single-node and development deployments where running Postgres is not practical.
+//
+// All writes are serialized by a store-wide lock and committed atomically as a single batch,
+// which gives the same consistency guarantees as the Postgres transactions at a lower write throughput.
+type PebbleStreamStore struct {
+	db *pebble.DB
+
+	// mu serializes writers and protects readers from observing partially applied batches.
+	mu sync.RWMutex
+
+	esm *ephemeralStreamMonitor
+}
+
+var _ StreamStorage = (*PebbleStreamStore)(nil)
+
+// Key layout. Every key starts with a single byte table prefix followed by the 32 byte stream id.
+//
+//	s<streamId>                       -> stream record
+//	m<streamId><seqNum>               -> miniblock data
+//	p<streamId><generation><slot+1>   -> minipool envelope, slot -1 is the generation marker
+//	c<streamId><seqNum><blockHash>    -> miniblock candidate data
+//
+// Numbers are encoded as big endian uint64 so lexicographic key order matches numeric order.
+const (
+	pebbleStreamPrefix    byte = 's'
+	pebbleMiniblockPrefix byte = 'm'
+	pebbleMinipoolPrefix  byte = 'p'
+	pebbleCandidatePrefix byte = 'c'
+)
+
+// pebbleStreamRecord is the value stored under the stream record key.
+// It plays the role of the es table in the Postgres schema.
+type pebbleStreamRecord struct {
+	latestSnapshotMiniblock int64
+	ephemeral               bool
+}
+
+func (r *pebbleStreamRecord) marshal() []byte {
+	b := make([]byte, 9)
+	binary.BigEndian.PutUint64(b, uint64(r.latestSnapshotMiniblock))
+	if r.ephemeral {
+		b[8] = 1
+	}
+	return b
+}
+
+func unmarshalPebbleStreamRecord(b []byte) (*pebbleStreamRecord, error) {
+	if len(b) != 9 {
+		return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Invalid stream record", "len", len(b))
+	}
+	return &pebbleStreamRecord{
+		latestSnapshotMiniblock: int64(binary.BigEndian.Uint64(b)),
+		ephemeral:               b[8] != 0,
+	}, nil
+}
+
+func pebbleStreamKey(streamId StreamId) []byte {
+	return pebbleStreamTableKey(pebbleStreamPrefix, streamId)
+}
+
+func pebbleStreamTableKey(prefix byte, streamId StreamId, nums ...uint64) []byte {
+	key := make([]byte, 0, 1+STREAM_ID_BYTES_LENGTH+8*len(nums)+common.HashLength)
+	key = append(key, prefix)
+	key = append(key, streamId[:]...)
+	for _, n := range nums {
+		key = binary.BigEndian.AppendUint64(key, n)
+	}
+	return key
+}
+
+func pebbleMiniblockKey(streamId StreamId, seqNum int64) []byte {
+	return pebbleStreamTableKey(pebbleMiniblockPrefix, streamId, uint64(seqNum))
+}
+
+func pebbleMinipoolKey(streamId StreamId, generation int64, slot int64) []byte {
+	return pebbleStreamTableKey(pebbleMinipoolPrefix, streamId, uint64(generation), uint64(slot+1))
+}
+
+func pebbleCandidateKey(streamId StreamId, seqNum int64, blockHash common.Hash) []byte {
+	return append(pebbleStreamTableKey(pebbleCandidatePrefix, streamId, uint64(seqNum)), blockHash.Bytes()...)
+}
+
+// pebbleUpperBound returns the smallest key that is greater than all keys with the given prefix.
+func pebbleUpperBound(prefix []byte) []byte {
+	end := slices.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// pebbleStreamTableBounds returns iterator bounds covering all keys of the given stream in the given table.
+func pebbleStreamTableBounds(prefix byte, streamId StreamId) *pebble.IterOptions {
+	lower := pebbleStreamTableKey(prefix, streamId)
+	return &pebble.IterOptions{LowerBound: lower, UpperBound: pebbleUpperBound(lower)}
+}
+
+func pebbleKeyNum(key []byte, idx int) int64 {
+	offset := 1 + STREAM_ID_BYTES_LENGTH + 8*idx
+	return int64(binary.BigEndian.Uint64(key[offset : offset+8]))
+}
+
+// NewPebbleStreamStore opens or creates a Pebble database in the given directory.
+func NewPebbleStreamStore(
+	ctx context.Context,
+	dataDir string,
+	ephemeralStreamTtl time.Duration,
+) (store *PebbleStreamStore, err error) {
+	if dataDir == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "Data directory must be set for pebble storage").
+			Func("NewPebbleStreamStore")
+	}
+
+	db, err := pebble.Open(dataDir, &pebble.Options{})
+	if err != nil {
+		return nil, AsRiverError(err, Err_DB_OPERATION_FAILURE).
+			Message("Unable to open pebble database").
+			Tag("dataDir", dataDir).
+			Func("NewPebbleStreamStore")
+	}
+
+	store = &PebbleStreamStore{db: db}
+
+	// Start the ephemeral stream monitor.
+	store.esm, err = newEphemeralStreamMonitor(ctx, ephemeralStreamTtl, store)
+	if err != nil {
+		_ = db.Close()
+		return nil, AsRiverError(err).Func("NewPebbleStreamStore")
+	}
+
+	return store, nil
+}
+
+// opRunner runs the given operation under the store lock. Errors are wrapped the same way
+// as the Postgres transaction runner does it so callers observe identical error codes.
+func (s *PebbleStreamStore) opRunner(
+	ctx context.Context,
+	name string,
+	write bool,
+	opFn func() error,
+	tags ...any,
+) error {
+	if write {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	} else {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+	}
+
+	if err := opFn(); err != nil {
+		logging.FromCtx(ctx).Debugw("pebble.opRunner: operation failed", append(tags, "name", name, "err", err)...)
+		return WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Func("pebble.opRunner").
+			Message("operation failed").
+			Tag("name", name).
+			Tags(tags...)
+	}
+	return nil
+}
+
+// get returns a copy of the value stored under the given key or nil if the key is not present.
+func (s *PebbleStreamStore) get(key []byte) ([]byte, error) {
+	value, closer, err := s.db.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer closer.Close()
+	return slices.Clone(value), nil
+}
+
+// forEach calls fn for each key/value pair in the given bounds. Key and value are only valid
+// for the duration of the call.
+func (s *PebbleStreamStore) forEach(opts *pebble.IterOptions, fn func(key []byte, value []byte) error) error {
+	iter, err := s.db.NewIter(opts)
+	if err != nil {
+		return err
+	}
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err = fn(iter.Key(), iter.Value()); err != nil {
+			_ = iter.Close()
+			return err
+		}
+	}
+	return errors.Join(iter.Error(), iter.Close())
+}
+
+func (s *PebbleStreamStore) commit(batch *pebble.Batch) error {
+	return batch.Commit(pebble.Sync)
+}
+
+func (s *PebbleStreamStore) readStreamRecord(streamId StreamId) (*pebbleStreamRecord, error) {
+	value, err := s.get(pebbleStreamKey(streamId))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, RiverError(Err_NOT_FOUND, "Stream not found", "streamId", streamId).
+			Func("PebbleStreamStore.readStreamRecord")
+	}
+	return unmarshalPebbleStreamRecord(value)
+}
+
+func (s *PebbleStreamStore) readEphemeralStreamRecord(streamId StreamId) (*pebbleStreamRecord, error) {
+	record, err := s.readStreamRecord(streamId)
+	if err != nil {
+		return nil, err
+	}
+	if !record.ephemeral {
+		return nil, RiverError(Err_NOT_FOUND, "Ephemeral stream not found", "streamId", streamId)
+	}
+	return record, nil
+}
+
+// lastMiniblockNum returns the number of the last miniblock of the stream or -1 if there are no miniblocks.
+func (s *PebbleStreamStore) lastMiniblockNum(streamId StreamId) (int64, error) {
+	iter, err := s.db.NewIter(pebbleStreamTableBounds(pebbleMiniblockPrefix, streamId))
+	if err != nil {
+		return 0, err
+	}
+	last := int64(-1)
+	if iter.Last() {
+		last = pebbleKeyNum(iter.Key(), 0)
+	}
+	return last, errors.Join(iter.Error(), iter.Close())
+}
+
+// firstMiniblockNum returns the number of the first miniblock of the stream or -1 if there are no miniblocks.
+func (s *PebbleStreamStore) firstMiniblockNum(streamId StreamId) (int64, error) {
+	iter, err := s.db.NewIter(pebbleStreamTableBounds(pebbleMiniblockPrefix, streamId))
+	if err != nil {
+		return 0, err
+	}
+	first := int64(-1)
+	if iter.First() {
+		first = pebbleKeyNum(iter.Key(), 0)
+	}
+	return first, errors.Join(iter.Error(), iter.Close())
+}
+
+// checkMiniblocksNotTrimmed returns Err_MINIBLOCKS_TRIMMED if the miniblock with the given number
+// was removed by TrimStream.
+func (s *PebbleStreamStore) checkMiniblocksNotTrimmed(streamId StreamId, miniblockNum int64) error {
+	firstAvailable, err := s.firstMiniblockNum(streamId)
+	if err != nil {
+		return err
+	}
+	if firstAvailable != -1 && miniblockNum < firstAvailable {
+		return RiverError(Err_MINIBLOCKS_TRIMMED, "Miniblocks trimmed, fetch from archive").
+			Tag("streamId", streamId).
+			Tag("miniblockNum", miniblockNum).
+			Tag("firstAvailableMiniblockNum", firstAvailable)
+	}
+	return nil
+}
+
+func (s *PebbleStreamStore) CreateStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	genesisMiniblock []byte,
+) error {
+	return s.opRunner(
+		ctx,
+		"CreateStreamStorage",
+		true,
+		func() error {
+			return s.createStream(
+				streamId,
+				&pebbleStreamRecord{latestSnapshotMiniblock: 0},
+				genesisMiniblock,
+				true,
+			)
+		},
+		"streamId", streamId,
+	)
+}
+
+// createStream writes the stream record, the genesis miniblock if given and the minipool
+// generation marker if requested.
+func (s *PebbleStreamStore) createStream(
+	streamId StreamId,
+	record *pebbleStreamRecord,
+	genesisMiniblock []byte,
+	withMinipool bool,
+) error {
+	existing, err := s.get(pebbleStreamKey(streamId))
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return RiverError(Err_ALREADY_EXISTS, "stream already exists")
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(pebbleStreamKey(streamId), record.marshal(), nil); err != nil {
+		return err
+	}
+	if genesisMiniblock != nil {
+		if err := batch.Set(pebbleMiniblockKey(streamId, 0), genesisMiniblock, nil); err != nil {
+			return err
+		}
+	}
+	if withMinipool {
+		if err := batch.Set(pebbleMinipoolKey(streamId, 1, -1), nil, nil); err != nil {
+			return err
+		}
+	}
+	return s.commit(batch)
+}
+
+func (s *PebbleStreamStore) CreateStreamArchiveStorage(ctx context.Context, streamId StreamId) error {
+	return s.opRunner(
+		ctx,
+		"CreateStreamArchiveStorage",
+		true,
+		func() error {
+			return s.createStream(streamId, &pebbleStreamRecord{latestSnapshotMiniblock: -1}, nil, false)
+		},
+		"streamId", streamId,
+	)
+}
+
+func (s *PebbleStreamStore) GetMaxArchivedMiniblockNumber(ctx context.Context, streamId StreamId) (int64, error) {
+	var maxArchivedMiniblockNumber int64
+	if err := s.opRunner(
+		ctx,
+		"GetMaxArchivedMiniblockNumber",
+		false,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+			var err error
+			maxArchivedMiniblockNumber, err = s.lastMiniblockNum(streamId)
+			return err
+		},
+		"streamId", streamId,
+	); err != nil {
+		return -1, err
+	}
+	return maxArchivedMiniblockNumber, nil
+}
+
+func (s *PebbleStreamStore) WriteArchiveMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks [][]byte,
+) error {
+	return s.opRunner(
+		ctx,
+		"WriteArchiveMiniblocks",
+		true,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			lastKnownMiniblockNum, err := s.lastMiniblockNum(streamId)
+			if err != nil {
+				return err
+			}
+
+			if lastKnownMiniblockNum+1 != startMiniblockNum {
+				return RiverError(
+					Err_DB_OPERATION_FAILURE,
+					"miniblock sequence number mismatch",
+					"lastKnownMiniblockNum", lastKnownMiniblockNum,
+					"startMiniblockNum", startMiniblockNum,
+					"streamId", streamId,
+				)
+			}
+
+			batch := s.db.NewBatch()
+			defer batch.Close()
+			for i, miniblock := range miniblocks {
+				if err := batch.Set(pebbleMiniblockKey(streamId, startMiniblockNum+int64(i)), miniblock, nil); err != nil {
+					return err
+				}
+			}
+			return s.commit(batch)
+		},
+		"streamId", streamId,
+		"startMiniblockNum", startMiniblockNum,
+		"numMiniblocks", len(miniblocks),
+	)
+}
+
+func (s *PebbleStreamStore) ReadStreamFromLastSnapshot(
+	ctx context.Context,
+	streamId StreamId,
+	numToRead int,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	var ret *ReadStreamFromLastSnapshotResult
+	if err := s.opRunner(
+		ctx,
+		"ReadStreamFromLastSnapshot",
+		false,
+		func() error {
+			var err error
+			ret, err = s.readStreamFromLastSnapshot(streamId, numToRead)
+			return err
+		},
+		"streamId", streamId,
+	); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *PebbleStreamStore) readStreamFromLastSnapshot(
+	streamId StreamId,
+	numToRead int,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	record, err := s.readStreamRecord(streamId)
+	if err != nil {
+		return nil, err
+	}
+	snapshotMiniblockIndex := record.latestSnapshotMiniblock
+
+	lastMiniblockIndex, err := s.lastMiniblockNum(streamId)
+	if err != nil {
+		return nil, err
+	}
+	if lastMiniblockIndex < 0 {
+		return nil, RiverError(Err_INTERNAL, "db inconsistency: failed to get last miniblock index")
+	}
+
+	numToRead = max(1, numToRead)
+	startSeqNum := max(0, lastMiniblockIndex-int64(numToRead-1))
+	startSeqNum = min(startSeqNum, snapshotMiniblockIndex)
+
+	var miniblocks [][]byte
+	var counter int64 = 0
+	var readFirstSeqNum int64
+	var readLastSeqNum int64
+	if err := s.forEach(
+		&pebble.IterOptions{
+			LowerBound: pebbleMiniblockKey(streamId, startSeqNum),
+			UpperBound: pebbleUpperBound(pebbleStreamTableKey(pebbleMiniblockPrefix, streamId)),
+		},
+		func(key []byte, value []byte) error {
+			readLastSeqNum = pebbleKeyNum(key, 0)
+			if counter == 0 {
+				readFirstSeqNum = readLastSeqNum
+			} else if readLastSeqNum != readFirstSeqNum+counter {
+				return RiverError(
+					Err_INTERNAL,
+					"Miniblocks consistency violation - miniblocks are not sequential in db",
+					"ActualSeqNum", readLastSeqNum,
+					"ExpectedSeqNum", readFirstSeqNum+counter)
+			}
+			miniblocks = append(miniblocks, slices.Clone(value))
+			counter++
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	if !(readFirstSeqNum <= snapshotMiniblockIndex && snapshotMiniblockIndex <= readLastSeqNum) {
+		return nil, RiverError(
+			Err_INTERNAL,
+			"Miniblocks consistency violation - snapshotMiniblockIndex is out of range",
+			"snapshotMiniblockIndex", snapshotMiniblockIndex,
+			"readFirstSeqNum", readFirstSeqNum,
+			"readLastSeqNum", readLastSeqNum)
+	}
+
+	var envelopes [][]byte
+	expectedGeneration := readLastSeqNum + 1
+	expectedSlot := int64(-1)
+	if err := s.forEach(
+		pebbleStreamTableBounds(pebbleMinipoolPrefix, streamId),
+		func(key []byte, value []byte) error {
+			generation := pebbleKeyNum(key, 0)
+			slotNum := pebbleKeyNum(key, 1) - 1
+			if generation != expectedGeneration {
+				return RiverError(
+					Err_MINIBLOCKS_STORAGE_FAILURE,
+					"Minipool consistency violation - minipool generation doesn't match last miniblock generation",
+				).
+					Tag("generation", generation).
+					Tag("expectedGeneration", expectedGeneration)
+			}
+			if slotNum != expectedSlot {
+				return RiverError(
+					Err_MINIBLOCKS_STORAGE_FAILURE,
+					"Minipool consistency violation - slotNums are not sequential",
+				).
+					Tag("slotNum", slotNum).
+					Tag("expectedSlot", expectedSlot)
+			}
+
+			if slotNum >= 0 {
+				envelopes = append(envelopes, slices.Clone(value))
+			}
+			expectedSlot++
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &ReadStreamFromLastSnapshotResult{
+		StartMiniblockNumber:    readFirstSeqNum,
+		SnapshotMiniblockOffset: int(snapshotMiniblockIndex - readFirstSeqNum),
+		Miniblocks:              miniblocks,
+		MinipoolEnvelopes:       envelopes,
+	}, nil
+}
+
+// WriteEvent adds event to the given minipool.
+// Current generation of minipool should match minipoolGeneration,
+// and there should be exactly minipoolSlot events in the minipool.
+func (s *PebbleStreamStore) WriteEvent(
+	ctx context.Context,
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	return s.opRunner(
+		ctx,
+		"WriteEvent",
+		true,
+		func() error {
+			return s.writeEvent(streamId, minipoolGeneration, minipoolSlot, envelope)
+		},
+		"streamId", streamId,
+		"minipoolGeneration", minipoolGeneration,
+		"minipoolSlot", minipoolSlot,
+	)
+}
+
+func (s *PebbleStreamStore) writeEvent(
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	if _, err := s.readStreamRecord(streamId); err != nil {
+		return err
+	}
+
+	counter := -1 // counter is set to -1 as we have service record in the first row of minipool
+	if err := s.forEach(
+		pebbleStreamTableBounds(pebbleMinipoolPrefix, streamId),
+		func(key []byte, _ []byte) error {
+			generation := pebbleKeyNum(key, 0)
+			slotNum := int(pebbleKeyNum(key, 1) - 1)
+			if generation != minipoolGeneration {
+				return RiverError(Err_DB_OPERATION_FAILURE, "Wrong event generation in minipool").
+					Tag("ExpectedGeneration", minipoolGeneration).Tag("ActualGeneration", generation)
+			}
+			if slotNum != counter {
+				return RiverError(Err_DB_OPERATION_FAILURE, "Wrong slot number in minipool").
+					Tag("ExpectedSlotNumber", counter).Tag("ActualSlotNumber", slotNum)
+			}
+			counter++
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	if counter != minipoolSlot {
+		maxSeqNum, mbErr := s.lastMiniblockNum(streamId)
+		return RiverError(Err_DB_OPERATION_FAILURE, "Wrong number of records in minipool").
+			Tag("ActualRecordsNumber", counter).Tag("ExpectedRecordsNumber", minipoolSlot).
+			Tag("maxSeqNum", maxSeqNum).Tag("mbErr", mbErr)
+	}
+
+	return s.db.Set(pebbleMinipoolKey(streamId, minipoolGeneration, int64(minipoolSlot)), envelope, pebble.Sync)
+}
+
+// ReadMiniblocks returns miniblocks with miniblockNum or "generation" from fromInclusive, to toExlusive.
+func (s *PebbleStreamStore) ReadMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	var miniblocks [][]byte
+	if err := s.opRunner(
+		ctx,
+		"ReadMiniblocks",
+		false,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			// toExclusive can be far beyond the stored range, don't size the result by it
+			miniblocks = [][]byte{}
+			if toExclusive <= fromInclusive || toExclusive <= 0 {
+				return nil
+			}
+
+			firstSeqNum := int64(-1)
+			prevSeqNum := int64(-1)
+			if err := s.forEach(
+				&pebble.IterOptions{
+					LowerBound: pebbleMiniblockKey(streamId, max(0, fromInclusive)),
+					UpperBound: pebbleMiniblockKey(streamId, max(0, toExclusive)),
+				},
+				func(key []byte, value []byte) error {
+					seqNum := pebbleKeyNum(key, 0)
+					if prevSeqNum != -1 && seqNum != prevSeqNum+1 {
+						// There is a gap in sequence numbers, tagged as int like the postgres store does
+						return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+							Tag("ActualBlockNumber", int(seqNum)).
+							Tag("ExpectedBlockNumber", int(prevSeqNum+1)).
+							Tag("streamId", streamId)
+					}
+					if prevSeqNum == -1 {
+						firstSeqNum = seqNum
+					}
+					prevSeqNum = seqNum
+					miniblocks = append(miniblocks, slices.Clone(value))
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+
+			// If the range doesn't start with the requested miniblock, check if the history was trimmed.
+			if firstSeqNum != fromInclusive {
+				return s.checkMiniblocksNotTrimmed(streamId, fromInclusive)
+			}
+			return nil
+		},
+		"streamId", streamId,
+		"fromInclusive", fromInclusive,
+		"toExclusive", toExclusive,
+	); err != nil {
+		return nil, err
+	}
+	return miniblocks, nil
+}
+
+// ReadMiniblocksByStream calls onEachMb for each miniblock of the given stream in order.
+func (s *PebbleStreamStore) ReadMiniblocksByStream(
+	ctx context.Context,
+	streamId StreamId,
+	onEachMb func(blockdata []byte, seqNum int64) error,
+) error {
+	return s.opRunner(
+		ctx,
+		"ReadMiniblocksByStream",
+		false,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			prevSeqNum := int64(-1)
+			return s.forEach(
+				pebbleStreamTableBounds(pebbleMiniblockPrefix, streamId),
+				func(key []byte, value []byte) error {
+					seqNum := pebbleKeyNum(key, 0)
+					if prevSeqNum != -1 && seqNum != prevSeqNum+1 {
+						// There is a gap in sequence numbers
+						return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+							Tag("ActualBlockNumber", seqNum).
+							Tag("ExpectedBlockNumber", prevSeqNum+1).
+							Tag("streamId", streamId)
+					}
+					prevSeqNum = seqNum
+					return onEachMb(slices.Clone(value), seqNum)
+				},
+			)
+		},
+		"streamId", streamId,
+	)
+}
+
+// ReadMiniblocksByIds calls onEachMb for each of the given miniblocks that exist in storage in order.
+func (s *PebbleStreamStore) ReadMiniblocksByIds(
+	ctx context.Context,
+	streamId StreamId,
+	mbs []int64,
+	onEachMb func(blockdata []byte, seqNum int64) error,
+) error {
+	return s.opRunner(
+		ctx,
+		"ReadMiniblocksByIds",
+		false,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			nums := slices.Clone(mbs)
+			slices.Sort(nums)
+			for _, num := range slices.Compact(nums) {
+				if num < 0 {
+					continue
+				}
+				blockdata, err := s.get(pebbleMiniblockKey(streamId, num))
+				if err != nil {
+					return err
+				}
+				if blockdata == nil {
+					continue
+				}
+				if err = onEachMb(blockdata, num); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		"streamId", streamId,
+		"mbs", mbs,
+	)
+}
+
+// WriteMiniblockCandidate adds a miniblock proposal candidate. When the miniblock is finalized, the node will promote the
+// candidate with the correct hash.
+func (s *PebbleStreamStore) WriteMiniblockCandidate(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+	miniblock []byte,
+) error {
+	return s.opRunner(
+		ctx,
+		"WriteMiniblockCandidate",
+		true,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			seqNum, err := s.lastMiniblockNum(streamId)
+			if err != nil {
+				return err
+			}
+			if seqNum < 0 {
+				return RiverError(Err_NOT_FOUND, "No blocks for the stream found in block storage")
+			}
+
+			// Candidate block number should be greater than the last block number in storage.
+			if blockNumber <= seqNum {
+				return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Candidate is too old").
+					Tag("LastBlockInStorage", seqNum).Tag("CandidateBlockNumber", blockNumber)
+			}
+
+			key := pebbleCandidateKey(streamId, blockNumber, blockHash)
+			existing, err := s.get(key)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return RiverError(Err_ALREADY_EXISTS, "Miniblock candidate already exists")
+			}
+
+			return s.db.Set(key, miniblock, pebble.Sync)
+		},
+		"streamId", streamId,
+		"blockHash", blockHash,
+		"blockNumber", blockNumber,
+	)
+}
+
+func (s *PebbleStreamStore) ReadMiniblockCandidate(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+) ([]byte, error) {
+	var miniblock []byte
+	if err := s.opRunner(
+		ctx,
+		"ReadMiniblockCandidate",
+		false,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			var err error
+			miniblock, err = s.get(pebbleCandidateKey(streamId, blockNumber, blockHash))
+			if err != nil {
+				return err
+			}
+			if miniblock == nil {
+				return RiverError(Err_NOT_FOUND, "Miniblock candidate not found")
+			}
+			return nil
+		},
+		"streamId", streamId,
+		"blockHash", blockHash,
+		"blockNumber", blockNumber,
+	); err != nil {
+		return nil, err
+	}
+	return miniblock, nil
+}
+
+func (s *PebbleStreamStore) WriteMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolGeneration int64,
+	newMinipoolEnvelopes [][]byte,
+	prevMinipoolGeneration int64,
+	prevMinipoolSize int,
+) error {
+	// Check redundant data in arguments is consistent.
+	if len(miniblocks) == 0 {
+		return RiverError(Err_INTERNAL, "No miniblocks to write").Func("pebble.WriteMiniblocks")
+	}
+	if prevMinipoolGeneration != miniblocks[0].Number {
+		return RiverError(Err_INTERNAL, "Previous minipool generation mismatch").Func("pebble.WriteMiniblocks")
+	}
+	if newMinipoolGeneration != miniblocks[len(miniblocks)-1].Number+1 {
+		return RiverError(Err_INTERNAL, "New minipool generation mismatch").Func("pebble.WriteMiniblocks")
+	}
+	firstMbNum := miniblocks[0].Number
+	for i, mb := range miniblocks {
+		if mb.Number != firstMbNum+int64(i) {
+			return RiverError(Err_INTERNAL, "Miniblock number mismatch").Func("pebble.WriteMiniblocks")
+		}
+	}
+
+	return s.opRunner(
+		ctx,
+		"WriteMiniblocks",
+		true,
+		func() error {
+			return s.writeMiniblocks(
+				streamId,
+				miniblocks,
+				newMinipoolGeneration,
+				newMinipoolEnvelopes,
+				prevMinipoolGeneration,
+				prevMinipoolSize,
+			)
+		},
+		"streamId", streamId,
+		"newMinipoolGeneration", newMinipoolGeneration,
+		"newMinipoolSize", len(newMinipoolEnvelopes),
+		"prevMinipoolGeneration", prevMinipoolGeneration,
+		"prevMinipoolSize", prevMinipoolSize,
+		"miniblockSize", len(miniblocks),
+		"firstMiniblockNumber", miniblocks[0].Number,
+		"lastMiniblockNumber", miniblocks[len(miniblocks)-1].Number,
+	)
+}
+
+func (s *PebbleStreamStore) writeMiniblocks(
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolGeneration int64,
+	newMinipoolEnvelopes [][]byte,
+	prevMinipoolGeneration int64,
+	prevMinipoolSize int,
+) error {
+	record, err := s.readStreamRecord(streamId)
+	if err != nil {
+		return err
+	}
+
+	lastMbNumInStorage, err := s.lastMiniblockNum(streamId)
+	if err != nil {
+		return err
+	}
+	if lastMbNumInStorage < 0 {
+		return RiverError(
+			Err_INTERNAL,
+			"DB data consistency check failed: No blocks for the stream found in block storage",
+		)
+	}
+	if lastMbNumInStorage+1 != prevMinipoolGeneration {
+		return RiverError(
+			Err_INTERNAL,
+			"DB data consistency check failed: Previous minipool generation mismatch",
+			"lastMbInStorage",
+			lastMbNumInStorage,
+		)
+	}
+
+	// Check old minipool for consistency before it is deleted.
+	expectedSlot := int64(-1)
+	if err := s.forEach(
+		pebbleStreamTableBounds(pebbleMinipoolPrefix, streamId),
+		func(key []byte, _ []byte) error {
+			generation := pebbleKeyNum(key, 0)
+			slot := pebbleKeyNum(key, 1) - 1
+			if generation != prevMinipoolGeneration {
+				return RiverError(
+					Err_INTERNAL,
+					"DB data consistency check failed: Minipool contains unexpected generation",
+					"generation",
+					generation,
+				)
+			}
+			if slot != expectedSlot {
+				return RiverError(
+					Err_INTERNAL,
+					"DB data consistency check failed: Minipool contains unexpected slot number",
+					"slot_num",
+					slot,
+					"expected_slot_num",
+					expectedSlot,
+				)
+			}
+			expectedSlot++
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+	if prevMinipoolSize != -1 && expectedSlot != int64(prevMinipoolSize) {
+		return RiverError(
+			Err_INTERNAL,
+			"DB data consistency check failed: Previous minipool size mismatch",
+			"actual_size",
+			expectedSlot,
+		)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	// Replace old minipool with the new one starting with the -1 marker.
+	minipoolBounds := pebbleStreamTableBounds(pebbleMinipoolPrefix, streamId)
+	if err := batch.DeleteRange(minipoolBounds.LowerBound, minipoolBounds.UpperBound, nil); err != nil {
+		return err
+	}
+	if err := batch.Set(pebbleMinipoolKey(streamId, newMinipoolGeneration, -1), nil, nil); err != nil {
+		return err
+	}
+	for i, envelope := range newMinipoolEnvelopes {
+		if err := batch.Set(pebbleMinipoolKey(streamId, newMinipoolGeneration, int64(i)), envelope, nil); err != nil {
+			return err
+		}
+	}
+
+	// Insert all miniblocks.
+	newLastSnapshotMiniblock := int64(-1)
+	for _, mb := range miniblocks {
+		if mb.Snapshot {
+			newLastSnapshotMiniblock = mb.Number
+		}
+		if err := batch.Set(pebbleMiniblockKey(streamId, mb.Number), mb.Data, nil); err != nil {
+			return err
+		}
+	}
+
+	// Update latest snapshot if needed.
+	if newLastSnapshotMiniblock > -1 {
+		record.latestSnapshotMiniblock = newLastSnapshotMiniblock
+		if err := batch.Set(pebbleStreamKey(streamId), record.marshal(), nil); err != nil {
+			return err
+		}
+	}
+
+	// Delete miniblock candidates up to the last miniblock number.
+	if err := batch.DeleteRange(
+		pebbleStreamTableKey(pebbleCandidatePrefix, streamId),
+		pebbleStreamTableKey(pebbleCandidatePrefix, streamId, uint64(newMinipoolGeneration)),
+		nil,
+	); err != nil {
+		return err
+	}
+
+	return s.commit(batch)
+}
+
+func (s *PebbleStreamStore) DebugReadStreamData(
+	ctx context.Context,
+	streamId StreamId,
+) (*DebugReadStreamDataResult, error) {
+	var result *DebugReadStreamDataResult
+	if err := s.opRunner(
+		ctx,
+		"DebugReadStreamData",
+		false,
+		func() error {
+			record, err := s.readStreamRecord(streamId)
+			if err != nil {
+				return err
+			}
+
+			result = &DebugReadStreamDataResult{
+				StreamId:                   streamId,
+				LatestSnapshotMiniblockNum: record.latestSnapshotMiniblock,
+			}
+
+			if err = s.forEach(
+				pebbleStreamTableBounds(pebbleMiniblockPrefix, streamId),
+				func(key []byte, value []byte) error {
+					result.Miniblocks = append(result.Miniblocks, MiniblockDescriptor{
+						MiniblockNumber: pebbleKeyNum(key, 0),
+						Data:            slices.Clone(value),
+					})
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+
+			if err = s.forEach(
+				pebbleStreamTableBounds(pebbleMinipoolPrefix, streamId),
+				func(key []byte, value []byte) error {
+					result.Events = append(result.Events, EventDescriptor{
+						Generation: pebbleKeyNum(key, 0),
+						Slot:       pebbleKeyNum(key, 1) - 1,
+						Data:       slices.Clone(value),
+					})
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+
+			return s.forEach(
+				pebbleStreamTableBounds(pebbleCandidatePrefix, streamId),
+				func(key []byte, value []byte) error {
+					result.MbCandidates = append(result.MbCandidates, MiniblockDescriptor{
+						MiniblockNumber: pebbleKeyNum(key, 0),
+						Data:            slices.Clone(value),
+						Hash:            common.BytesToHash(key[len(key)-common.HashLength:]),
+					})
+					return nil
+				},
+			)
+		},
+		"streamId", streamId,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *PebbleStreamStore) DebugReadStreamStatistics(
+	ctx context.Context,
+	streamId StreamId,
+) (*DebugReadStreamStatisticsResult, error) {
+	var result *DebugReadStreamStatisticsResult
+	if err := s.opRunner(
+		ctx,
+		"DebugReadStreamStatistics",
+		false,
+		func() error {
+			record, err := s.readStreamRecord(streamId)
+			if err != nil {
+				return err
+			}
+
+			result = &DebugReadStreamStatisticsResult{
+				StreamId:                   streamId.String(),
+				LatestSnapshotMiniblockNum: record.latestSnapshotMiniblock,
+			}
+
+			if result.LatestMiniblockNum, err = s.lastMiniblockNum(streamId); err != nil {
+				return err
+			}
+
+			if err = s.forEach(
+				pebbleStreamTableBounds(pebbleMinipoolPrefix, streamId),
+				func(key []byte, _ []byte) error {
+					if pebbleKeyNum(key, 1) != 0 {
+						result.NumMinipoolEvents++
+					}
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+
+			return s.forEach(
+				pebbleStreamTableBounds(pebbleCandidatePrefix, streamId),
+				func(key []byte, _ []byte) error {
+					result.CurrentMiniblockCandidates = append(
+						result.CurrentMiniblockCandidates,
+						MiniblockCandidateStatisticsResult{
+							Hash:     common.Bytes2Hex(key[len(key)-common.HashLength:]),
+							BlockNum: pebbleKeyNum(key, 0),
+						},
+					)
+					return nil
+				},
+			)
+		},
+		"streamId", streamId,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *PebbleStreamStore) GetLastMiniblockNumber(ctx context.Context, streamID StreamId) (int64, error) {
+	var ret int64
+	if err := s.opRunner(
+		ctx,
+		"GetLastMiniblockNumber",
+		false,
+		func() error {
+			if _, err := s.readStreamRecord(streamID); err != nil {
+				return err
+			}
+
+			var err error
+			if ret, err = s.lastMiniblockNum(streamID); err != nil {
+				return err
+			}
+			if ret < 0 {
+				return RiverError(Err_INTERNAL, "Stream exists in es table, but no miniblocks in DB")
+			}
+			return nil
+		},
+		"streamId", streamID,
+	); err != nil {
+		return 0, err
+	}
+	return ret, nil
+}
+
+// GetStreamsNumber returns the number of streams in storage.
+func (s *PebbleStreamStore) GetStreamsNumber(ctx context.Context) (int, error) {
+	streams, err := s.GetStreams(ctx)
+	return len(streams), err
+}
+
+// GetStreams returns a list of all event streams
+func (s *PebbleStreamStore) GetStreams(ctx context.Context) ([]StreamId, error) {
+	var streams []StreamId
+	if err := s.opRunner(
+		ctx,
+		"GetStreams",
+		false,
+		func() error {
+			return s.forEach(
+				&pebble.IterOptions{
+					LowerBound: []byte{pebbleStreamPrefix},
+					UpperBound: []byte{pebbleStreamPrefix + 1},
+				},
+				func(key []byte, _ []byte) error {
+					streamId, err := StreamIdFromBytes(key[1:])
+					if err != nil {
+						return err
+					}
+					streams = append(streams, streamId)
+					return nil
+				},
+			)
+		},
+	); err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+// TrimStream deletes miniblocks with numbers lower than trimBeforeMiniblockNum.
+func (s *PebbleStreamStore) TrimStream(ctx context.Context, streamId StreamId, trimBeforeMiniblockNum int64) error {
+	return s.opRunner(
+		ctx,
+		"TrimStream",
+		true,
+		func() error {
+			record, err := s.readStreamRecord(streamId)
+			if err != nil {
+				return err
+			}
+
+			if trimBeforeMiniblockNum > record.latestSnapshotMiniblock {
+				return RiverError(Err_INVALID_ARGUMENT, "Can't trim miniblocks after the latest snapshot").
+					Tag("lastSnapshotMiniblock", record.latestSnapshotMiniblock)
+			}
+			if trimBeforeMiniblockNum <= 0 {
+				return nil
+			}
+
+			batch := s.db.NewBatch()
+			defer batch.Close()
+
+			if err = batch.DeleteRange(
+				pebbleMiniblockKey(streamId, 0),
+				pebbleMiniblockKey(streamId, trimBeforeMiniblockNum),
+				nil,
+			); err != nil {
+				return err
+			}
+			if err = s.deleteIndexedEvents(batch, streamId, trimBeforeMiniblockNum); err != nil {
+				return err
+			}
+			return s.commit(batch)
+		},
+		"streamId", streamId,
+		"trimBeforeMiniblockNum", trimBeforeMiniblockNum,
+	)
+}
+
+// DeleteStream deletes the given stream with all its miniblocks, minipool and candidates.
+func (s *PebbleStreamStore) DeleteStream(ctx context.Context, streamId StreamId) error {
+	return s.opRunner(
+		ctx,
+		"DeleteStream",
+		true,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+			return s.deleteStream(streamId)
+		},
+		"streamId", streamId,
+	)
+}
+
+func (s *PebbleStreamStore) deleteStream(streamId StreamId) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, prefix := range []byte{pebbleMiniblockPrefix, pebbleMinipoolPrefix, pebbleCandidatePrefix} {
+		bounds := pebbleStreamTableBounds(prefix, streamId)
+		if err := batch.DeleteRange(bounds.LowerBound, bounds.UpperBound, nil); err != nil {
+			return err
+		}
+	}
+	if err := s.deleteIndexedEvents(batch, streamId, math.MaxInt64); err != nil {
+		return err
+	}
+	if err := batch.Delete(pebbleStreamKey(streamId), nil); err != nil {
+		return err
+	}
+	return s.commit(batch)
+}
+
+// Close stops the ephemeral stream monitor and closes the database.
+func (s *PebbleStreamStore) Close(ctx context.Context) {
+	s.esm.close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.db.Close(); err != nil {
+		logging.FromCtx(ctx).Errorw("Error closing pebble database", "error", err)
+	}
+}
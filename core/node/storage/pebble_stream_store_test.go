@@ -0,0 +1,252 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+type testPebbleStreamStoreParams struct {
+	ctx     context.Context
+	store   *PebbleStreamStore
+	dataDir string
+	closer  func()
+}
+
+// setupPebbleStreamStorageTest opens a Pebble store in a temporary directory.
+// Pebble is embedded, tests fail rather than skip when it can't be opened.
+func setupPebbleStreamStorageTest(t *testing.T) *testPebbleStreamStoreParams {
+	t.Helper()
+	ctx, ctxCloser := test.NewTestContext()
+	t.Cleanup(ctxCloser)
+
+	dataDir := t.TempDir()
+	store, err := NewPebbleStreamStore(ctx, dataDir, time.Minute*10)
+	require.NoError(t, err, "Error creating new pebble stream store")
+
+	return &testPebbleStreamStoreParams{
+		ctx:     ctx,
+		store:   store,
+		dataDir: dataDir,
+		closer: sync.OnceFunc(func() {
+			store.Close(ctx)
+			ctxCloser()
+		}),
+	}
+}
+
+// forEachStreamStorage runs the given test against every StreamStorage implementation.
+func forEachStreamStorage(t *testing.T, testFn func(t *testing.T, ctx context.Context, store StreamStorage)) {
+	t.Run("postgres", func(t *testing.T) {
+		params := setupStreamStorageTest(t)
+		defer params.closer()
+		testFn(t, params.ctx, params.pgStreamStore)
+	})
+	t.Run("pebble", func(t *testing.T) {
+		params := setupPebbleStreamStorageTest(t)
+		defer params.closer()
+		testFn(t, params.ctx, params.store)
+	})
+}
+
+func TestPebbleStreamStore(t *testing.T) {
+	params := setupPebbleStreamStorageTest(t)
+	require := require.New(t)
+
+	store := params.store
+	ctx := params.ctx
+	defer params.closer()
+
+	streamsNumber, err := store.GetStreamsNumber(ctx)
+	require.NoError(err)
+	require.Equal(0, streamsNumber)
+
+	streamId1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	streamId2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	genesisMiniblock := []byte("genesisMiniblock")
+	require.NoError(store.CreateStreamStorage(ctx, streamId1, genesisMiniblock))
+	require.NoError(store.CreateStreamStorage(ctx, streamId2, []byte("genesisMiniblock2")))
+
+	streams, err := store.GetStreams(ctx)
+	require.NoError(err)
+	require.ElementsMatch([]StreamId{streamId1, streamId2}, streams)
+
+	require.NoError(store.DeleteStream(ctx, streamId2))
+	streams, err = store.GetStreams(ctx)
+	require.NoError(err)
+	require.ElementsMatch([]StreamId{streamId1}, streams)
+
+	require.NoError(store.WriteEvent(ctx, streamId1, 1, 0, []byte("event1")))
+	require.NoError(store.WriteEvent(ctx, streamId1, 1, 1, []byte("event2")))
+
+	// Wrong slot and wrong generation are rejected.
+	err = store.WriteEvent(ctx, streamId1, 1, 1, []byte("event3"))
+	require.Error(err)
+	require.Contains(err.Error(), "Wrong number of records in minipool")
+	err = store.WriteEvent(ctx, streamId1, 2, 2, []byte("event3"))
+	require.Error(err)
+	require.Contains(err.Error(), "Wrong event generation in minipool")
+
+	blockHash := common.BytesToHash([]byte("block_hash"))
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId1, blockHash, 1, []byte("block1")))
+
+	stats, err := store.DebugReadStreamStatistics(ctx, streamId1)
+	require.NoError(err)
+	require.EqualValues(0, stats.LatestMiniblockNum)
+	require.EqualValues(2, stats.NumMinipoolEvents)
+	require.Len(stats.CurrentMiniblockCandidates, 1)
+	require.EqualValues(1, stats.CurrentMiniblockCandidates[0].BlockNum)
+
+	// Promotion fails if the previous minipool size doesn't match.
+	err = store.WriteMiniblocks(
+		ctx,
+		streamId1,
+		[]*WriteMiniblockData{{Number: 1, Hash: blockHash, Snapshot: true, Data: []byte("block1")}},
+		2,
+		[][]byte{[]byte("event3")},
+		1,
+		3,
+	)
+	require.Error(err)
+	require.Contains(err.Error(), "Previous minipool size mismatch")
+
+	require.NoError(promoteMiniblockCandidate(ctx, store, streamId1, 1, blockHash, true, [][]byte{[]byte("event3")}))
+
+	data, err := store.DebugReadStreamData(ctx, streamId1)
+	require.NoError(err)
+	require.EqualValues(1, data.LatestSnapshotMiniblockNum)
+	require.Len(data.Miniblocks, 2)
+	require.Empty(data.MbCandidates)
+	require.Equal(
+		[]EventDescriptor{
+			{Generation: 2, Slot: -1, Data: []byte{}},
+			{Generation: 2, Slot: 0, Data: []byte("event3")},
+		},
+		data.Events,
+	)
+
+	lastMiniblockNumber, err := store.GetLastMiniblockNumber(ctx, streamId1)
+	require.NoError(err)
+	require.EqualValues(1, lastMiniblockNumber)
+
+	var seqNums []int64
+	require.NoError(store.ReadMiniblocksByIds(ctx, streamId1, []int64{1, 0, 7}, func(_ []byte, seqNum int64) error {
+		seqNums = append(seqNums, seqNum)
+		return nil
+	}))
+	require.Equal([]int64{0, 1}, seqNums)
+
+	_, err = store.GetLastMiniblockNumber(ctx, streamId2)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+}
+
+func TestPebbleStreamStoreReopen(t *testing.T) {
+	params := setupPebbleStreamStorageTest(t)
+	require := require.New(t)
+	ctx := params.ctx
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	dataMaker := newDataMaker()
+
+	genMB, _ := dataMaker.mb()
+	require.NoError(params.store.CreateStreamStorage(ctx, streamId, genMB))
+	mbs := dataMaker.mbs(1, 3)
+	mbs[1].Snapshot = true
+	events := dataMaker.events(2)
+	require.NoError(params.store.WriteMiniblocks(ctx, streamId, mbs, 4, events, 1, 0))
+	params.closer()
+
+	store, err := NewPebbleStreamStore(ctx, params.dataDir, time.Minute*10)
+	require.NoError(err)
+	defer store.Close(ctx)
+
+	result, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 1)
+	require.NoError(err)
+	requireSnapshotResult(t, result, 2, 0, [][]byte{mbs[1].Data, mbs[2].Data}, events)
+}
+
+func TestPebbleEphemeralStream(t *testing.T) {
+	params := setupPebbleStreamStorageTest(t)
+	require := require.New(t)
+	store := params.store
+	ctx := params.ctx
+	defer params.closer()
+
+	streamId := testutils.FakeStreamId(STREAM_MEDIA_BIN)
+
+	const chunks = 2
+	inception, err := proto.Marshal(&StreamEvent{
+		Payload: &StreamEvent_MediaPayload{
+			MediaPayload: &MediaPayload{
+				Content: &MediaPayload_Inception_{
+					Inception: &MediaPayload_Inception{StreamId: streamId[:], ChunkCount: chunks},
+				},
+			},
+		},
+	})
+	require.NoError(err)
+	genesisHash := common.BytesToHash([]byte("genesis"))
+	genesisMb, err := proto.Marshal(&Miniblock{
+		Events: []*Envelope{{Event: inception}},
+		Header: &Envelope{Hash: genesisHash.Bytes()},
+	})
+	require.NoError(err)
+
+	require.NoError(store.CreateEphemeralStreamStorage(ctx, streamId, genesisMb))
+
+	ephemeral, err := store.IsStreamEphemeral(ctx, streamId)
+	require.NoError(err)
+	require.True(ephemeral)
+
+	ids, err := store.readEphemeralStreamIds(ctx)
+	require.NoError(err)
+	require.Equal([]StreamId{streamId}, ids)
+
+	require.NoError(store.WriteEphemeralMiniblock(ctx, streamId, &WriteMiniblockData{Number: 1, Data: []byte("c1")}))
+
+	// Stream can't be normalized while chunks are missing.
+	_, err = store.NormalizeEphemeralStream(ctx, streamId)
+	require.Error(err)
+
+	require.NoError(store.WriteEphemeralMiniblock(ctx, streamId, &WriteMiniblockData{Number: 2, Data: []byte("c2")}))
+
+	nums, err := store.ReadEphemeralMiniblockNums(ctx, streamId)
+	require.NoError(err)
+	require.Equal([]int{0, 1, 2}, nums)
+
+	hash, err := store.NormalizeEphemeralStream(ctx, streamId)
+	require.NoError(err)
+	require.Equal(genesisHash, hash)
+
+	ephemeral, err = store.IsStreamEphemeral(ctx, streamId)
+	require.NoError(err)
+	require.False(ephemeral)
+
+	result, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 10)
+	require.NoError(err)
+	require.Len(result.Miniblocks, chunks+1)
+	require.Empty(result.MinipoolEnvelopes)
+
+	// Sealed stream is no longer ephemeral and can't be deleted by the monitor.
+	err = store.deleteEphemeralStream(ctx, streamId)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	// Dead ephemeral streams are deleted.
+	deadStreamId := testutils.FakeStreamId(STREAM_MEDIA_BIN)
+	require.NoError(store.WriteEphemeralMiniblock(ctx, deadStreamId, &WriteMiniblockData{Number: 1, Data: []byte("c1")}))
+	require.NoError(store.deleteEphemeralStream(ctx, deadStreamId))
+	_, err = store.IsStreamEphemeral(ctx, deadStreamId)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+}
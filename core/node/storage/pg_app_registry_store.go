@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgerrcode"
@@ -29,14 +30,37 @@ type (
 		Owner           common.Address
 		EncryptedSecret [32]byte
 		WebhookUrl      string
+		Disabled        bool
+		// PreviousEncryptedSecret is the secret before the last rotation. It is only set until
+		// PreviousSecretExpiresAt, requests are signed with both secrets during that time.
+		PreviousEncryptedSecret *[32]byte
+		PreviousSecretExpiresAt time.Time
+		// Permissions are the scopes the app declared, nil if the app is not restricted.
+		Permissions *protocol.AppPermissions
+	}
+
+	// AppAuditAction describes the owner call that is recorded in the audit log of an app.
+	AppAuditAction string
+
+	// AppAuditEntry is a call of an app owner that read or changed the app.
+	AppAuditEntry struct {
+		App       common.Address
+		Actor     common.Address
+		Action    AppAuditAction
+		Details   string
+		CreatedAt time.Time
 	}
 
 	AppRegistryStore interface {
+		AppWebhookDeliveryStore
+
+		// CreateApp registers the app. Apps created without permissions are not restricted.
 		CreateApp(
 			ctx context.Context,
 			owner common.Address,
 			app common.Address,
 			sharedSecret [32]byte,
+			permissions *protocol.AppPermissions,
 		) error
 
 		RegisterWebhook(
@@ -49,9 +73,81 @@ type (
 			ctx context.Context,
 			app common.Address,
 		) (*AppInfo, error)
+
+		// GetApps returns the addresses of all registered apps.
+		GetApps(ctx context.Context) ([]common.Address, error)
+
+		// RotateSecret replaces the shared secret of the app. The previous secret remains valid for
+		// the grace period, it returns when the previous secret expires.
+		RotateSecret(
+			ctx context.Context,
+			app common.Address,
+			actor common.Address,
+			encryptedSharedSecret [32]byte,
+			gracePeriod time.Duration,
+		) (time.Time, error)
+
+		// UpdateWebhook sets the webhook of the app on behalf of actor.
+		UpdateWebhook(
+			ctx context.Context,
+			app common.Address,
+			actor common.Address,
+			webhook string,
+		) error
+
+		// SetAppDisabled disables or enables the app. Disabled apps don't receive events.
+		SetAppDisabled(
+			ctx context.Context,
+			app common.Address,
+			actor common.Address,
+			disabled bool,
+		) error
+
+		// SetAppPermissions sets the permissions of the app on behalf of actor, nil permissions
+		// remove all restrictions.
+		SetAppPermissions(
+			ctx context.Context,
+			app common.Address,
+			actor common.Address,
+			permissions *protocol.AppPermissions,
+		) error
+
+		// DeleteApp removes the app with its queued events, received sessions and delivery log.
+		// The audit log of the app is kept.
+		DeleteApp(
+			ctx context.Context,
+			app common.Address,
+			actor common.Address,
+		) error
+
+		// AddAppAuditEntry records a call of actor that read the app.
+		AddAppAuditEntry(
+			ctx context.Context,
+			app common.Address,
+			actor common.Address,
+			action AppAuditAction,
+			details string,
+		) error
+
+		// GetAppAuditLog returns the most recent audit log entries of the app, the latest first.
+		GetAppAuditLog(
+			ctx context.Context,
+			app common.Address,
+			limit int,
+		) ([]*AppAuditEntry, error)
 	}
 )
 
+const (
+	AppAuditActionGetInfo        AppAuditAction = "get_info"
+	AppAuditActionRotateSecret   AppAuditAction = "rotate_secret"
+	AppAuditActionUpdateWebhook  AppAuditAction = "update_webhook"
+	AppAuditActionDisable        AppAuditAction = "disable"
+	AppAuditActionEnable         AppAuditAction = "enable"
+	AppAuditActionDelete         AppAuditAction = "delete"
+	AppAuditActionSetPermissions AppAuditAction = "set_permissions"
+)
+
 // PGAddress is a type alias for addresses that automatically serializes and deserializes
 // 20-byte addresses into and out of pg fixed-length character sequences.
 type PGAddress common.Address
@@ -142,13 +238,14 @@ func (s *PostgresAppRegistryStore) CreateApp(
 	owner common.Address,
 	app common.Address,
 	encryptedSharedSecret [32]byte,
+	permissions *protocol.AppPermissions,
 ) error {
 	return s.txRunner(
 		ctx,
 		"CreateApp",
 		pgx.ReadWrite,
 		func(ctx context.Context, tx pgx.Tx) error {
-			return s.createApp(ctx, owner, app, encryptedSharedSecret, tx)
+			return s.createApp(ctx, owner, app, encryptedSharedSecret, permissions, tx)
 		},
 		nil,
 		"appAddress", app,
@@ -161,14 +258,19 @@ func (s *PostgresAppRegistryStore) createApp(
 	owner common.Address,
 	app common.Address,
 	encryptedSharedSecret [32]byte,
+	permissions *protocol.AppPermissions,
 	txn pgx.Tx,
 ) error {
+	scopes, streamTypes := pgAppPermissions(permissions)
 	if _, err := txn.Exec(
 		ctx,
-		"insert into app_registry (app_id, app_owner_id, encrypted_shared_secret) values ($1, $2, $3);",
+		`insert into app_registry (app_id, app_owner_id, encrypted_shared_secret, scopes, stream_types)
+		values ($1, $2, $3, $4, $5);`,
 		PGAddress(app),
 		PGAddress(owner),
 		PGSecret(encryptedSharedSecret),
+		scopes,
+		streamTypes,
 	); err != nil {
 		if isPgError(err, pgerrcode.UniqueViolation) {
 			return WrapRiverError(protocol.Err_ALREADY_EXISTS, err).Message("App already exists")
@@ -254,11 +356,31 @@ func (s *PostgresAppRegistryStore) getAppInfo(
 	error,
 ) {
 	var owner, app PGAddress
-	var encryptedSecret PGSecret
+	var encryptedSecret, prevEncryptedSecret PGSecret
+	var prevSecretExpiresAt *time.Time
+	var restricted bool
+	var scopes, streamTypes []int32
 	app = PGAddress(appAddr)
 	var appInfo AppInfo
-	if err := tx.QueryRow(ctx, "select app_id, app_owner_id, encrypted_shared_secret, COALESCE(webhook, '') from app_registry where app_id = $1", app).
-		Scan(&app, &owner, &encryptedSecret, &appInfo.WebhookUrl); err != nil {
+	if err := tx.QueryRow(
+		ctx,
+		`select app_id, app_owner_id, encrypted_shared_secret, COALESCE(webhook, ''), disabled,
+			prev_encrypted_shared_secret, CASE WHEN prev_secret_expires_at > NOW() THEN prev_secret_expires_at END,
+			scopes IS NOT NULL, COALESCE(scopes, '{}'), COALESCE(stream_types, '{}')
+		from app_registry where app_id = $1`,
+		app,
+	).Scan(
+		&app,
+		&owner,
+		&encryptedSecret,
+		&appInfo.WebhookUrl,
+		&appInfo.Disabled,
+		&prevEncryptedSecret,
+		&prevSecretExpiresAt,
+		&restricted,
+		&scopes,
+		&streamTypes,
+	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, RiverError(protocol.Err_NOT_FOUND, "app does not exist")
 		} else {
@@ -269,10 +391,363 @@ func (s *PostgresAppRegistryStore) getAppInfo(
 		appInfo.App = common.BytesToAddress(app[:])
 		appInfo.Owner = common.BytesToAddress(owner[:])
 		appInfo.EncryptedSecret = encryptedSecret
+		if prevSecretExpiresAt != nil {
+			prev := [32]byte(prevEncryptedSecret)
+			appInfo.PreviousEncryptedSecret = &prev
+			appInfo.PreviousSecretExpiresAt = *prevSecretExpiresAt
+		}
+		if restricted {
+			appInfo.Permissions = &protocol.AppPermissions{}
+			for _, scope := range scopes {
+				appInfo.Permissions.Scopes = append(appInfo.Permissions.Scopes, protocol.AppScope(scope))
+			}
+			for _, streamType := range streamTypes {
+				appInfo.Permissions.StreamTypes = append(
+					appInfo.Permissions.StreamTypes,
+					protocol.AppStreamType(streamType),
+				)
+			}
+		}
 	}
 	return &appInfo, nil
 }
 
+func (s *PostgresAppRegistryStore) GetApps(ctx context.Context) ([]common.Address, error) {
+	var apps []common.Address
+	if err := s.txRunner(
+		ctx,
+		"GetApps",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			apps, err = s.getApps(ctx, tx)
+			return err
+		},
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+func (s *PostgresAppRegistryStore) getApps(ctx context.Context, tx pgx.Tx) ([]common.Address, error) {
+	rows, err := tx.Query(ctx, `select app_id from app_registry`)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		apps []common.Address
+		app  PGAddress
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&app}, func() error {
+		apps = append(apps, common.Address(app))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+// pgAppPermissions returns the scopes and stream types columns for the permissions, both are NULL
+// if the app is not restricted.
+func pgAppPermissions(permissions *protocol.AppPermissions) ([]int32, []int32) {
+	if permissions == nil {
+		return nil, nil
+	}
+	scopes := make([]int32, len(permissions.Scopes))
+	for i, scope := range permissions.Scopes {
+		scopes[i] = int32(scope)
+	}
+	streamTypes := make([]int32, len(permissions.StreamTypes))
+	for i, streamType := range permissions.StreamTypes {
+		streamTypes[i] = int32(streamType)
+	}
+	return scopes, streamTypes
+}
+
+func (s *PostgresAppRegistryStore) RotateSecret(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+	encryptedSharedSecret [32]byte,
+	gracePeriod time.Duration,
+) (expiresAt time.Time, err error) {
+	err = s.txRunner(
+		ctx,
+		"RotateSecret",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			expiresAt, err = s.rotateSecret(ctx, app, actor, encryptedSharedSecret, gracePeriod, tx)
+			return err
+		},
+		nil,
+		"appAddress", app,
+		"actor", actor,
+	)
+	return expiresAt, err
+}
+
+func (s *PostgresAppRegistryStore) rotateSecret(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+	encryptedSharedSecret [32]byte,
+	gracePeriod time.Duration,
+	txn pgx.Tx,
+) (time.Time, error) {
+	var expiresAt time.Time
+	if err := txn.QueryRow(
+		ctx,
+		`UPDATE app_registry SET
+			prev_encrypted_shared_secret = encrypted_shared_secret,
+			prev_secret_expires_at = NOW() + make_interval(secs => $3),
+			encrypted_shared_secret = $2
+		WHERE app_id = $1
+		RETURNING prev_secret_expires_at`,
+		PGAddress(app),
+		PGSecret(encryptedSharedSecret),
+		gracePeriod.Seconds(),
+	).Scan(&expiresAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, RiverError(protocol.Err_NOT_FOUND, "app does not exist")
+		}
+		return time.Time{}, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to rotate secret")
+	}
+
+	if err := s.addAppAuditEntry(ctx, app, actor, AppAuditActionRotateSecret, "", txn); err != nil {
+		return time.Time{}, err
+	}
+
+	return expiresAt, nil
+}
+
+func (s *PostgresAppRegistryStore) UpdateWebhook(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+	webhook string,
+) error {
+	return s.txRunner(
+		ctx,
+		"UpdateWebhook",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if err := s.registerWebhook(ctx, app, webhook, tx); err != nil {
+				return err
+			}
+			return s.addAppAuditEntry(ctx, app, actor, AppAuditActionUpdateWebhook, webhook, tx)
+		},
+		nil,
+		"appAddress", app,
+		"actor", actor,
+		"webhook", webhook,
+	)
+}
+
+func (s *PostgresAppRegistryStore) SetAppDisabled(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+	disabled bool,
+) error {
+	action := AppAuditActionEnable
+	if disabled {
+		action = AppAuditActionDisable
+	}
+
+	return s.txRunner(
+		ctx,
+		"SetAppDisabled",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			tag, err := tx.Exec(
+				ctx,
+				`UPDATE app_registry SET disabled = $2 WHERE app_id = $1`,
+				PGAddress(app),
+				disabled,
+			)
+			if err != nil {
+				return WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to update app")
+			}
+			if tag.RowsAffected() < 1 {
+				return RiverError(protocol.Err_NOT_FOUND, "app was not found in registry")
+			}
+			return s.addAppAuditEntry(ctx, app, actor, action, "", tx)
+		},
+		nil,
+		"appAddress", app,
+		"actor", actor,
+		"disabled", disabled,
+	)
+}
+
+func (s *PostgresAppRegistryStore) SetAppPermissions(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+	permissions *protocol.AppPermissions,
+) error {
+	scopes, streamTypes := pgAppPermissions(permissions)
+	details := "unrestricted"
+	if permissions != nil {
+		details = permissions.String()
+	}
+	return s.txRunner(
+		ctx,
+		"SetAppPermissions",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			tag, err := tx.Exec(
+				ctx,
+				`UPDATE app_registry SET scopes = $2, stream_types = $3 WHERE app_id = $1`,
+				PGAddress(app),
+				scopes,
+				streamTypes,
+			)
+			if err != nil {
+				return WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to update app")
+			}
+			if tag.RowsAffected() < 1 {
+				return RiverError(protocol.Err_NOT_FOUND, "app was not found in registry")
+			}
+			return s.addAppAuditEntry(ctx, app, actor, AppAuditActionSetPermissions, details, tx)
+		},
+		nil,
+		"appAddress", app,
+		"actor", actor,
+	)
+}
+
+func (s *PostgresAppRegistryStore) DeleteApp(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+) error {
+	return s.txRunner(
+		ctx,
+		"DeleteApp",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.deleteApp(ctx, app, actor, tx)
+		},
+		nil,
+		"appAddress", app,
+		"actor", actor,
+	)
+}
+
+func (s *PostgresAppRegistryStore) deleteApp(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+	txn pgx.Tx,
+) error {
+	tag, err := txn.Exec(ctx, `DELETE FROM app_registry WHERE app_id = $1`, PGAddress(app))
+	if err != nil {
+		return WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to delete app")
+	}
+	if tag.RowsAffected() < 1 {
+		return RiverError(protocol.Err_NOT_FOUND, "app was not found in registry")
+	}
+
+	for _, table := range []string{"app_webhook_queue", "app_session_keys", "app_webhook_delivery_log"} {
+		if _, err := txn.Exec(ctx, "DELETE FROM "+table+" WHERE app_id = $1", PGAddress(app)); err != nil {
+			return WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to delete app")
+		}
+	}
+
+	return s.addAppAuditEntry(ctx, app, actor, AppAuditActionDelete, "", txn)
+}
+
+func (s *PostgresAppRegistryStore) AddAppAuditEntry(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+	action AppAuditAction,
+	details string,
+) error {
+	return s.txRunner(
+		ctx,
+		"AddAppAuditEntry",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.addAppAuditEntry(ctx, app, actor, action, details, tx)
+		},
+		nil,
+		"appAddress", app,
+		"actor", actor,
+		"action", action,
+	)
+}
+
+func (s *PostgresAppRegistryStore) addAppAuditEntry(
+	ctx context.Context,
+	app common.Address,
+	actor common.Address,
+	action AppAuditAction,
+	details string,
+	txn pgx.Tx,
+) error {
+	if _, err := txn.Exec(
+		ctx,
+		`INSERT INTO app_audit_log (app_id, actor_id, action, details) VALUES ($1, $2, $3, $4)`,
+		PGAddress(app),
+		PGAddress(actor),
+		string(action),
+		details,
+	); err != nil {
+		return WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to write audit log")
+	}
+	return nil
+}
+
+func (s *PostgresAppRegistryStore) GetAppAuditLog(
+	ctx context.Context,
+	app common.Address,
+	limit int,
+) (entries []*AppAuditEntry, err error) {
+	err = s.txRunner(
+		ctx,
+		"GetAppAuditLog",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			rows, err := tx.Query(
+				ctx,
+				`SELECT actor_id, action, details, created_at FROM app_audit_log
+				WHERE app_id = $1 ORDER BY id DESC LIMIT $2`,
+				PGAddress(app),
+				limit,
+			)
+			if err != nil {
+				return WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to read audit log")
+			}
+
+			var (
+				actor  PGAddress
+				action string
+				entry  AppAuditEntry
+			)
+			_, err = pgx.ForEachRow(rows, []any{&actor, &action, &entry.Details, &entry.CreatedAt}, func() error {
+				e := entry
+				e.App = app
+				e.Actor = common.Address(actor)
+				e.Action = AppAuditAction(action)
+				entries = append(entries, &e)
+				return nil
+			})
+			return err
+		},
+		nil,
+		"appAddress", app,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
 // Close closes the postgres connection pool
 func (s *PostgresAppRegistryStore) Close(ctx context.Context) {
 	s.PostgresEventStore.Close(ctx)
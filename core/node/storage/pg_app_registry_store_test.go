@@ -17,6 +17,8 @@ import (
 	"github.com/towns-protocol/towns/core/node/base/test"
 	"github.com/towns-protocol/towns/core/node/infra"
 	"github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
 	"github.com/towns-protocol/towns/core/node/testutils/dbtestutils"
 )
 
@@ -104,7 +106,7 @@ func TestAppRegistryStorage_RegisterWebhook(t *testing.T) {
 	require.NoError(err)
 	secret := [32]byte(secretBytes)
 
-	err = store.CreateApp(params.ctx, owner, app, secret)
+	err = store.CreateApp(params.ctx, owner, app, secret, nil)
 	require.NoError(err)
 
 	info, err := store.GetAppInfo(params.ctx, app)
@@ -168,15 +170,15 @@ func TestAppRegistryStorage(t *testing.T) {
 	require.NoError(err)
 	secret2 := [32]byte(secretBytes2)
 
-	err = store.CreateApp(params.ctx, owner, app, secret)
+	err = store.CreateApp(params.ctx, owner, app, secret, nil)
 	require.NoError(err)
 
-	err = store.CreateApp(params.ctx, owner2, app, secret)
+	err = store.CreateApp(params.ctx, owner2, app, secret, nil)
 	require.ErrorContains(err, "App already exists")
 	require.True(base.IsRiverErrorCode(err, protocol.Err_ALREADY_EXISTS))
 
 	// Fine to have multiple apps per owner
-	err = store.CreateApp(params.ctx, owner, app2, secret2)
+	err = store.CreateApp(params.ctx, owner, app2, secret2, nil)
 	require.NoError(err)
 
 	info, err := store.GetAppInfo(params.ctx, app)
@@ -195,4 +197,492 @@ func TestAppRegistryStorage(t *testing.T) {
 	require.Nil(info)
 	require.ErrorContains(err, "app does not exist")
 	require.True(base.IsRiverErrorCode(err, protocol.Err_NOT_FOUND))
+
+	apps, err := store.GetApps(params.ctx)
+	require.NoError(err)
+	require.ElementsMatch([]common.Address{app, app2}, apps)
+}
+
+func TestAppRegistryStorage_WebhookDeliveries(t *testing.T) {
+	params := setupAppRegistryStorageTest(t)
+	t.Cleanup(params.closer)
+
+	require := require.New(t)
+	store := params.pgAppRegistryStore
+	ctx := params.ctx
+
+	var owner, app, appWithoutWebhook, user common.Address
+	for _, addr := range []*common.Address{&owner, &app, &appWithoutWebhook, &user} {
+		_, err := rand.Read(addr[:])
+		require.NoError(err)
+	}
+
+	secretBytes, err := hex.DecodeString(testSecretHexString)
+	require.NoError(err)
+	require.NoError(store.CreateApp(ctx, owner, app, [32]byte(secretBytes), nil))
+	require.NoError(store.CreateApp(ctx, owner, appWithoutWebhook, [32]byte(secretBytes), nil))
+	require.NoError(store.RegisterWebhook(ctx, app, "https://webhook.com/callme"))
+
+	channel1 := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	channel2 := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	members := []common.Address{app, appWithoutWebhook, user}
+
+	// only apps with a webhook receive events
+	apps, err := store.EnqueueWebhookEvent(
+		ctx,
+		channel1,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{1},
+		user,
+		members,
+		"",
+		[]byte("event1"),
+	)
+	require.NoError(err)
+	require.Equal([]common.Address{app}, apps)
+
+	// apps don't receive their own events
+	apps, err = store.EnqueueWebhookEvent(
+		ctx,
+		channel1,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{2},
+		app,
+		members,
+		"",
+		[]byte("event2"),
+	)
+	require.NoError(err)
+	require.Empty(apps)
+
+	_, err = store.EnqueueWebhookEvent(
+		ctx,
+		channel1,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{3},
+		user,
+		members,
+		"",
+		[]byte("event3"),
+	)
+	require.NoError(err)
+	_, err = store.EnqueueWebhookEvent(
+		ctx,
+		channel2,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{4},
+		user,
+		members,
+		"",
+		[]byte("event4"),
+	)
+	require.NoError(err)
+
+	// only the oldest event of each channel is claimed
+	deliveries, err := store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Len(deliveries, 2)
+	claimed := map[shared.StreamId]*AppWebhookDelivery{}
+	for _, d := range deliveries {
+		require.Equal(app, d.App)
+		require.Equal(1, d.Attempts)
+		claimed[d.ChannelID] = d
+	}
+	require.Equal(common.Hash{1}, claimed[channel1].EventHash)
+	require.Equal([]byte("event1"), claimed[channel1].Payload)
+	require.Equal(common.Hash{4}, claimed[channel2].EventHash)
+
+	// claimed deliveries are not returned again while the lease is held
+	deliveries, err = store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Empty(deliveries)
+
+	// a failed delivery blocks the channel until it is retried
+	require.NoError(store.RetryWebhookDelivery(ctx, claimed[channel1], 0, 500, "internal error"))
+	require.NoError(store.CompleteWebhookDelivery(ctx, claimed[channel2], 200))
+
+	deliveries, err = store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Len(deliveries, 1)
+	require.Equal(common.Hash{1}, deliveries[0].EventHash)
+	require.Equal(2, deliveries[0].Attempts)
+
+	// after the event is dropped the next event of the channel is delivered
+	require.NoError(store.DeadLetterWebhookDelivery(ctx, deliveries[0], 500, "internal error"))
+	deliveries, err = store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Len(deliveries, 1)
+	require.Equal(common.Hash{3}, deliveries[0].EventHash)
+	require.NoError(store.CompleteWebhookDelivery(ctx, deliveries[0], 204))
+
+	log, err := store.GetWebhookDeliveryLog(ctx, app, 10)
+	require.NoError(err)
+	require.Len(log, 4)
+	require.Equal(common.Hash{3}, log[0].EventHash)
+	require.Equal(protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED, log[0].Status)
+	require.Equal(204, log[0].HttpStatus)
+	require.Equal(common.Hash{1}, log[1].EventHash)
+	require.Equal(protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD_LETTERED, log[1].Status)
+	require.Equal(2, log[1].Attempt)
+	require.Equal(channel1, log[1].ChannelID)
+	require.Equal(protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED, log[2].Status)
+	require.Equal(channel2, log[2].ChannelID)
+	require.Equal(protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED, log[3].Status)
+	require.Equal("internal error", log[3].Error)
+
+	log, err = store.GetWebhookDeliveryLog(ctx, app, 1)
+	require.NoError(err)
+	require.Len(log, 1)
+
+	require.NoError(store.PruneWebhookDeliveryLog(ctx, 0))
+	log, err = store.GetWebhookDeliveryLog(ctx, app, 10)
+	require.NoError(err)
+	require.Empty(log)
+}
+
+func TestAppRegistryStorage_HeldWebhookDeliveries(t *testing.T) {
+	params := setupAppRegistryStorageTest(t)
+	t.Cleanup(params.closer)
+
+	require := require.New(t)
+	store := params.pgAppRegistryStore
+	ctx := params.ctx
+
+	var owner, app, user common.Address
+	for _, addr := range []*common.Address{&owner, &app, &user} {
+		_, err := rand.Read(addr[:])
+		require.NoError(err)
+	}
+
+	secretBytes, err := hex.DecodeString(testSecretHexString)
+	require.NoError(err)
+	require.NoError(store.CreateApp(ctx, owner, app, [32]byte(secretBytes), nil))
+	require.NoError(store.RegisterWebhook(ctx, app, "https://webhook.com/callme"))
+
+	channel := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	members := []common.Address{app, user}
+
+	// the encrypted event is held and blocks the event after it
+	_, err = store.EnqueueWebhookEvent(
+		ctx,
+		channel,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{1},
+		user,
+		members,
+		"session1",
+		[]byte("event1"),
+	)
+	require.NoError(err)
+	_, err = store.EnqueueWebhookEvent(
+		ctx,
+		channel,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{2},
+		user,
+		members,
+		"",
+		[]byte("event2"),
+	)
+	require.NoError(err)
+
+	deliveries, err := store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Empty(deliveries)
+
+	// sessions for users that are not apps are ignored
+	held, err := store.AddAppSessionKeys(ctx, user, []string{"session1"})
+	require.NoError(err)
+	require.False(held)
+
+	held, err = store.AddAppSessionKeys(ctx, app, []string{"session0"})
+	require.NoError(err)
+	require.False(held)
+
+	held, err = store.AddAppSessionKeys(ctx, app, []string{"session1", "session0"})
+	require.NoError(err)
+	require.True(held)
+
+	deliveries, err = store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Len(deliveries, 1)
+	require.Equal(common.Hash{1}, deliveries[0].EventHash)
+	require.NoError(store.CompleteWebhookDelivery(ctx, deliveries[0], 200))
+
+	deliveries, err = store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Len(deliveries, 1)
+	require.Equal(common.Hash{2}, deliveries[0].EventHash)
+	require.NoError(store.CompleteWebhookDelivery(ctx, deliveries[0], 200))
+
+	// events for which the session is not received in time are dropped
+	_, err = store.EnqueueWebhookEvent(
+		ctx,
+		channel,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{3},
+		user,
+		members,
+		"session2",
+		[]byte("event3"),
+	)
+	require.NoError(err)
+
+	expired, err := store.ExpireHeldWebhookDeliveries(ctx, time.Hour)
+	require.NoError(err)
+	require.Zero(expired)
+
+	expired, err = store.ExpireHeldWebhookDeliveries(ctx, 0)
+	require.NoError(err)
+	require.Equal(1, expired)
+
+	log, err := store.GetWebhookDeliveryLog(ctx, app, 1)
+	require.NoError(err)
+	require.Len(log, 1)
+	require.Equal(common.Hash{3}, log[0].EventHash)
+	require.Equal(protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD_LETTERED, log[0].Status)
+
+	deliveries, err = store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Empty(deliveries)
+
+	// received sessions are pruned after the retention
+	require.NoError(store.PruneAppSessionKeys(ctx, 0))
+	_, err = store.EnqueueWebhookEvent(
+		ctx,
+		channel,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{4},
+		user,
+		members,
+		"session1",
+		[]byte("event4"),
+	)
+	require.NoError(err)
+	deliveries, err = store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Empty(deliveries)
+}
+
+func TestAppRegistryStorage_ManageApp(t *testing.T) {
+	params := setupAppRegistryStorageTest(t)
+	t.Cleanup(params.closer)
+
+	require := require.New(t)
+	store := params.pgAppRegistryStore
+	ctx := params.ctx
+
+	var owner, app, user common.Address
+	for _, addr := range []*common.Address{&owner, &app, &user} {
+		_, err := rand.Read(addr[:])
+		require.NoError(err)
+	}
+
+	secretBytes, err := hex.DecodeString(testSecretHexString)
+	require.NoError(err)
+	secret2Bytes, err := hex.DecodeString(testSecretHexString2)
+	require.NoError(err)
+
+	require.NoError(store.CreateApp(ctx, owner, app, [32]byte(secretBytes), nil))
+
+	info, err := store.GetAppInfo(ctx, app)
+	require.NoError(err)
+	require.False(info.Disabled)
+	require.Nil(info.PreviousEncryptedSecret)
+
+	// the previous secret is returned during the grace period
+	expiresAt, err := store.RotateSecret(ctx, app, owner, [32]byte(secret2Bytes), time.Hour)
+	require.NoError(err)
+	info, err = store.GetAppInfo(ctx, app)
+	require.NoError(err)
+	require.Equal([32]byte(secret2Bytes), info.EncryptedSecret)
+	require.NotNil(info.PreviousEncryptedSecret)
+	require.Equal([32]byte(secretBytes), *info.PreviousEncryptedSecret)
+	require.WithinDuration(expiresAt, info.PreviousSecretExpiresAt, time.Millisecond)
+
+	_, err = store.RotateSecret(ctx, app, owner, [32]byte(secretBytes), 0)
+	require.NoError(err)
+	info, err = store.GetAppInfo(ctx, app)
+	require.NoError(err)
+	require.Equal([32]byte(secretBytes), info.EncryptedSecret)
+	require.Nil(info.PreviousEncryptedSecret)
+
+	webhook := "https://webhook.com/callme"
+	require.NoError(store.UpdateWebhook(ctx, app, owner, webhook))
+	require.NoError(store.AddAppAuditEntry(ctx, app, owner, AppAuditActionGetInfo, ""))
+
+	// disabled apps don't receive events
+	require.NoError(store.SetAppDisabled(ctx, app, owner, true))
+	info, err = store.GetAppInfo(ctx, app)
+	require.NoError(err)
+	require.True(info.Disabled)
+
+	channel := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	members := []common.Address{app, user}
+	apps, err := store.EnqueueWebhookEvent(
+		ctx,
+		channel,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{1},
+		user,
+		members,
+		"",
+		[]byte("event1"),
+	)
+	require.NoError(err)
+	require.Empty(apps)
+
+	require.NoError(store.SetAppDisabled(ctx, app, owner, false))
+	apps, err = store.EnqueueWebhookEvent(
+		ctx,
+		channel,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{2},
+		user,
+		members,
+		"",
+		[]byte("event2"),
+	)
+	require.NoError(err)
+	require.Equal([]common.Address{app}, apps)
+
+	// deleting the app removes its queued events
+	require.NoError(store.DeleteApp(ctx, app, owner))
+	_, err = store.GetAppInfo(ctx, app)
+	require.True(base.IsRiverErrorCode(err, protocol.Err_NOT_FOUND))
+
+	deliveries, err := store.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(err)
+	require.Empty(deliveries)
+
+	err = store.DeleteApp(ctx, app, owner)
+	require.True(base.IsRiverErrorCode(err, protocol.Err_NOT_FOUND))
+	err = store.SetAppDisabled(ctx, app, owner, true)
+	require.True(base.IsRiverErrorCode(err, protocol.Err_NOT_FOUND))
+	_, err = store.RotateSecret(ctx, app, owner, [32]byte(secretBytes), time.Hour)
+	require.True(base.IsRiverErrorCode(err, protocol.Err_NOT_FOUND))
+
+	// the audit log is kept after the app was deleted
+	entries, err := store.GetAppAuditLog(ctx, app, 10)
+	require.NoError(err)
+	actions := make([]AppAuditAction, len(entries))
+	for i, entry := range entries {
+		require.Equal(app, entry.App)
+		require.Equal(owner, entry.Actor)
+		actions[i] = entry.Action
+	}
+	require.Equal([]AppAuditAction{
+		AppAuditActionDelete,
+		AppAuditActionEnable,
+		AppAuditActionDisable,
+		AppAuditActionGetInfo,
+		AppAuditActionUpdateWebhook,
+		AppAuditActionRotateSecret,
+		AppAuditActionRotateSecret,
+	}, actions)
+	require.Equal(webhook, entries[4].Details)
+}
+
+func TestAppRegistryStorage_AppPermissions(t *testing.T) {
+	params := setupAppRegistryStorageTest(t)
+	t.Cleanup(params.closer)
+
+	require := require.New(t)
+	store := params.pgAppRegistryStore
+	ctx := params.ctx
+
+	var owner, reader, poster, unrestricted, user common.Address
+	for _, addr := range []*common.Address{&owner, &reader, &poster, &unrestricted, &user} {
+		_, err := rand.Read(addr[:])
+		require.NoError(err)
+	}
+
+	secretBytes, err := hex.DecodeString(testSecretHexString)
+	require.NoError(err)
+
+	readChannels := &protocol.AppPermissions{
+		Scopes:      []protocol.AppScope{protocol.AppScope_APP_SCOPE_READ_MESSAGES},
+		StreamTypes: []protocol.AppStreamType{protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL},
+	}
+	postOnly := &protocol.AppPermissions{
+		Scopes: []protocol.AppScope{protocol.AppScope_APP_SCOPE_POST_MESSAGES},
+	}
+	require.NoError(store.CreateApp(ctx, owner, reader, [32]byte(secretBytes), readChannels))
+	require.NoError(store.CreateApp(ctx, owner, poster, [32]byte(secretBytes), postOnly))
+	require.NoError(store.CreateApp(ctx, owner, unrestricted, [32]byte(secretBytes), nil))
+
+	info, err := store.GetAppInfo(ctx, reader)
+	require.NoError(err)
+	require.Equal(readChannels.Scopes, info.Permissions.Scopes)
+	require.Equal(readChannels.StreamTypes, info.Permissions.StreamTypes)
+
+	info, err = store.GetAppInfo(ctx, poster)
+	require.NoError(err)
+	require.Equal(postOnly.Scopes, info.Permissions.Scopes)
+	require.Empty(info.Permissions.StreamTypes)
+
+	info, err = store.GetAppInfo(ctx, unrestricted)
+	require.NoError(err)
+	require.Nil(info.Permissions)
+
+	for _, app := range []common.Address{reader, poster, unrestricted} {
+		require.NoError(store.RegisterWebhook(ctx, app, "https://webhook.com/"+app.Hex()))
+	}
+
+	// only apps that can read messages of the stream type receive events
+	members := []common.Address{reader, poster, unrestricted, user}
+	channel := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	apps, err := store.EnqueueWebhookEvent(
+		ctx,
+		channel,
+		protocol.AppStreamType_APP_STREAM_TYPE_CHANNEL,
+		common.Hash{1},
+		user,
+		members,
+		"",
+		[]byte("event1"),
+	)
+	require.NoError(err)
+	require.ElementsMatch([]common.Address{reader, unrestricted}, apps)
+
+	gdm := testutils.FakeStreamId(shared.STREAM_GDM_CHANNEL_BIN)
+	apps, err = store.EnqueueWebhookEvent(
+		ctx,
+		gdm,
+		protocol.AppStreamType_APP_STREAM_TYPE_GDM,
+		common.Hash{2},
+		user,
+		members,
+		"",
+		[]byte("event2"),
+	)
+	require.NoError(err)
+	require.Equal([]common.Address{unrestricted}, apps)
+
+	// removing the restrictions of an app
+	require.NoError(store.SetAppPermissions(ctx, poster, owner, nil))
+	info, err = store.GetAppInfo(ctx, poster)
+	require.NoError(err)
+	require.Nil(info.Permissions)
+
+	apps, err = store.EnqueueWebhookEvent(
+		ctx,
+		gdm,
+		protocol.AppStreamType_APP_STREAM_TYPE_GDM,
+		common.Hash{3},
+		user,
+		members,
+		"",
+		[]byte("event3"),
+	)
+	require.NoError(err)
+	require.ElementsMatch([]common.Address{poster, unrestricted}, apps)
+
+	entries, err := store.GetAppAuditLog(ctx, poster, 10)
+	require.NoError(err)
+	require.Len(entries, 1)
+	require.Equal(AppAuditActionSetPermissions, entries[0].Action)
+
+	err = store.SetAppPermissions(ctx, user, owner, postOnly)
+	require.True(base.IsRiverErrorCode(err, protocol.Err_NOT_FOUND))
 }
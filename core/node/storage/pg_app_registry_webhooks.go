@@ -0,0 +1,568 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+type (
+	// AppWebhookDelivery is an event that is waiting to be delivered to the webhook of an app.
+	AppWebhookDelivery struct {
+		ID        int64
+		App       common.Address
+		ChannelID shared.StreamId
+		EventHash common.Hash
+		// Payload is the request body that is sent to the webhook.
+		Payload []byte
+		// Attempts is the number of delivery attempts, including the attempt the delivery is claimed for.
+		Attempts  int
+		CreatedAt time.Time
+	}
+
+	// AppWebhookDeliveryLogEntry records the outcome of a delivery attempt.
+	AppWebhookDeliveryLogEntry struct {
+		App        common.Address
+		ChannelID  shared.StreamId
+		EventHash  common.Hash
+		Attempt    int
+		Status     protocol.WebhookDeliveryStatus
+		HttpStatus int
+		Error      string
+		CreatedAt  time.Time
+	}
+
+	// AppWebhookDeliveryStore keeps events until they are delivered to app webhooks. Events are delivered
+	// in order per app and channel, the next event of a channel is only claimed after the previous event
+	// was delivered or dead lettered. Each delivery attempt is recorded in the delivery log of the app.
+	AppWebhookDeliveryStore interface {
+		// EnqueueWebhookEvent adds the event for each enabled app in members that has a webhook registered
+		// and is allowed to read messages of streams of streamType, except for the app that created the event.
+		// It returns the apps the event was added for. If sessionID is set the event is held for an app until
+		// the app received the session, see AddAppSessionKeys.
+		EnqueueWebhookEvent(
+			ctx context.Context,
+			channelID shared.StreamId,
+			streamType protocol.AppStreamType,
+			eventHash common.Hash,
+			creator common.Address,
+			members []common.Address,
+			sessionID string,
+			payload []byte,
+		) ([]common.Address, error)
+
+		// ClaimWebhookDeliveries returns at most limit due deliveries that are not held for a session.
+		// Claimed deliveries are not returned by other calls until the lease expires, the attempts counter
+		// is incremented.
+		ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*AppWebhookDelivery, error)
+
+		// AddAppSessionKeys records that the group encryption sessions were sent to the inbox of the app. It
+		// returns true if events were held for any of the sessions. It is a no-op if app is not registered.
+		AddAppSessionKeys(ctx context.Context, app common.Address, sessionIDs []string) (bool, error)
+
+		// ExpireHeldWebhookDeliveries dead letters events that are held for longer than maxWait because the
+		// app didn't receive the session they are encrypted with. It returns the number of expired events.
+		ExpireHeldWebhookDeliveries(ctx context.Context, maxWait time.Duration) (int, error)
+
+		// PruneAppSessionKeys removes session records that are older than the given age.
+		PruneAppSessionKeys(ctx context.Context, age time.Duration) error
+
+		// CompleteWebhookDelivery removes a delivered event from the queue and logs the attempt.
+		CompleteWebhookDelivery(ctx context.Context, delivery *AppWebhookDelivery, httpStatus int) error
+
+		// RetryWebhookDelivery schedules the next delivery attempt after the given delay and logs the attempt.
+		RetryWebhookDelivery(
+			ctx context.Context,
+			delivery *AppWebhookDelivery,
+			delay time.Duration,
+			httpStatus int,
+			lastErr string,
+		) error
+
+		// DeadLetterWebhookDelivery removes an event that can't be delivered from the queue and logs the attempt.
+		DeadLetterWebhookDelivery(
+			ctx context.Context,
+			delivery *AppWebhookDelivery,
+			httpStatus int,
+			lastErr string,
+		) error
+
+		// GetWebhookDeliveryLog returns the most recent delivery attempts for the app, the latest first.
+		GetWebhookDeliveryLog(
+			ctx context.Context,
+			app common.Address,
+			limit int,
+		) ([]*AppWebhookDeliveryLogEntry, error)
+
+		// PruneWebhookDeliveryLog removes delivery log entries that are older than the given age.
+		PruneWebhookDeliveryLog(ctx context.Context, age time.Duration) error
+	}
+)
+
+func (s *PostgresAppRegistryStore) EnqueueWebhookEvent(
+	ctx context.Context,
+	channelID shared.StreamId,
+	streamType protocol.AppStreamType,
+	eventHash common.Hash,
+	creator common.Address,
+	members []common.Address,
+	sessionID string,
+	payload []byte,
+) (apps []common.Address, err error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	err = s.txRunner(
+		ctx,
+		"EnqueueWebhookEvent",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			apps, err = s.enqueueWebhookEvent(
+				ctx, channelID, streamType, eventHash, creator, members, sessionID, payload, tx)
+			return err
+		},
+		nil,
+		"channelId", channelID,
+		"eventHash", eventHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+func (s *PostgresAppRegistryStore) enqueueWebhookEvent(
+	ctx context.Context,
+	channelID shared.StreamId,
+	streamType protocol.AppStreamType,
+	eventHash common.Hash,
+	creator common.Address,
+	members []common.Address,
+	sessionID string,
+	payload []byte,
+	txn pgx.Tx,
+) ([]common.Address, error) {
+	memberIds := make([]string, len(members))
+	for i, member := range members {
+		memberIds[i] = hex.EncodeToString(member[:])
+	}
+
+	rows, err := txn.Query(
+		ctx,
+		`INSERT INTO app_webhook_queue (app_id, channel_id, event_hash, payload, session_id, next_attempt)
+		SELECT app_id, $1, $2, $3, NULLIF($6, ''), NOW() FROM app_registry
+		WHERE app_id = ANY($4) AND app_id != $5 AND COALESCE(webhook, '') != '' AND NOT disabled
+			AND (scopes IS NULL OR (
+				$7 = ANY(scopes) AND (cardinality(stream_types) = 0 OR $8 = ANY(stream_types))))
+		RETURNING app_id`,
+		channelID.String(),
+		hex.EncodeToString(eventHash[:]),
+		payload,
+		memberIds,
+		PGAddress(creator),
+		sessionID,
+		int32(protocol.AppScope_APP_SCOPE_READ_MESSAGES),
+		int32(streamType),
+	)
+	if err != nil {
+		return nil, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to enqueue webhook event")
+	}
+
+	var (
+		apps []common.Address
+		app  PGAddress
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&app}, func() error {
+		apps = append(apps, common.Address(app))
+		return nil
+	}); err != nil {
+		return nil, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to enqueue webhook event")
+	}
+
+	return apps, nil
+}
+
+func (s *PostgresAppRegistryStore) ClaimWebhookDeliveries(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) (deliveries []*AppWebhookDelivery, err error) {
+	err = s.txRunner(
+		ctx,
+		"ClaimWebhookDeliveries",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			deliveries, err = s.claimWebhookDeliveries(ctx, limit, lease, tx)
+			return err
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (s *PostgresAppRegistryStore) claimWebhookDeliveries(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+	txn pgx.Tx,
+) ([]*AppWebhookDelivery, error) {
+	// Only the oldest event of each app and channel is claimed to deliver events in order. A claimed
+	// event stays in the queue until it is delivered, which blocks the events after it. The same holds
+	// for events that are held until the app received the session they are encrypted with.
+	rows, err := txn.Query(
+		ctx,
+		`UPDATE app_webhook_queue SET next_attempt = NOW() + make_interval(secs => $2), attempts = attempts + 1
+		WHERE id IN (
+			SELECT q.id FROM app_webhook_queue q
+			WHERE q.next_attempt <= NOW() AND NOT EXISTS (
+				SELECT 1 FROM app_webhook_queue p
+				WHERE p.app_id = q.app_id AND p.channel_id = q.channel_id AND p.id < q.id
+			) AND (q.session_id IS NULL OR EXISTS (
+				SELECT 1 FROM app_session_keys k WHERE k.app_id = q.app_id AND k.session_id = q.session_id
+			))
+			ORDER BY q.next_attempt LIMIT $1 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, app_id, channel_id, event_hash, payload, attempts, created_at`,
+		limit,
+		lease.Seconds(),
+	)
+	if err != nil {
+		return nil, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to claim webhook deliveries")
+	}
+	defer rows.Close()
+
+	var deliveries []*AppWebhookDelivery
+	for rows.Next() {
+		var (
+			d         AppWebhookDelivery
+			app       PGAddress
+			channelID string
+			eventHash string
+		)
+		if err := rows.Scan(&d.ID, &app, &channelID, &eventHash, &d.Payload, &d.Attempts, &d.CreatedAt); err != nil {
+			return nil, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).
+				Message("Unable to claim webhook deliveries")
+		}
+		if d.ChannelID, err = shared.StreamIdFromString(channelID); err != nil {
+			return nil, err
+		}
+		d.App = common.Address(app)
+		d.EventHash = common.HexToHash(eventHash)
+		deliveries = append(deliveries, &d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to claim webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+func (s *PostgresAppRegistryStore) CompleteWebhookDelivery(
+	ctx context.Context,
+	delivery *AppWebhookDelivery,
+	httpStatus int,
+) error {
+	return s.txRunner(
+		ctx,
+		"CompleteWebhookDelivery",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, `DELETE FROM app_webhook_queue WHERE id = $1`, delivery.ID); err != nil {
+				return err
+			}
+			return s.logWebhookDelivery(
+				ctx, delivery, protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED, httpStatus, "", tx)
+		},
+		nil,
+		"appAddress", delivery.App,
+		"id", delivery.ID,
+	)
+}
+
+func (s *PostgresAppRegistryStore) RetryWebhookDelivery(
+	ctx context.Context,
+	delivery *AppWebhookDelivery,
+	delay time.Duration,
+	httpStatus int,
+	lastErr string,
+) error {
+	return s.txRunner(
+		ctx,
+		"RetryWebhookDelivery",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if _, err := tx.Exec(
+				ctx,
+				`UPDATE app_webhook_queue SET next_attempt = NOW() + make_interval(secs => $2) WHERE id = $1`,
+				delivery.ID,
+				delay.Seconds(),
+			); err != nil {
+				return err
+			}
+			return s.logWebhookDelivery(
+				ctx, delivery, protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED, httpStatus, lastErr, tx)
+		},
+		nil,
+		"appAddress", delivery.App,
+		"id", delivery.ID,
+	)
+}
+
+func (s *PostgresAppRegistryStore) DeadLetterWebhookDelivery(
+	ctx context.Context,
+	delivery *AppWebhookDelivery,
+	httpStatus int,
+	lastErr string,
+) error {
+	return s.txRunner(
+		ctx,
+		"DeadLetterWebhookDelivery",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, `DELETE FROM app_webhook_queue WHERE id = $1`, delivery.ID); err != nil {
+				return err
+			}
+			return s.logWebhookDelivery(
+				ctx,
+				delivery,
+				protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD_LETTERED,
+				httpStatus,
+				lastErr,
+				tx,
+			)
+		},
+		nil,
+		"appAddress", delivery.App,
+		"id", delivery.ID,
+	)
+}
+
+func (s *PostgresAppRegistryStore) logWebhookDelivery(
+	ctx context.Context,
+	delivery *AppWebhookDelivery,
+	status protocol.WebhookDeliveryStatus,
+	httpStatus int,
+	lastErr string,
+	txn pgx.Tx,
+) error {
+	_, err := txn.Exec(
+		ctx,
+		`INSERT INTO app_webhook_delivery_log (app_id, channel_id, event_hash, attempt, status, http_status, error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		PGAddress(delivery.App),
+		delivery.ChannelID.String(),
+		hex.EncodeToString(delivery.EventHash[:]),
+		delivery.Attempts,
+		int16(status),
+		httpStatus,
+		lastErr,
+	)
+	return err
+}
+
+func (s *PostgresAppRegistryStore) GetWebhookDeliveryLog(
+	ctx context.Context,
+	app common.Address,
+	limit int,
+) (entries []*AppWebhookDeliveryLogEntry, err error) {
+	err = s.txRunner(
+		ctx,
+		"GetWebhookDeliveryLog",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			entries, err = s.getWebhookDeliveryLog(ctx, app, limit, tx)
+			return err
+		},
+		nil,
+		"appAddress", app,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *PostgresAppRegistryStore) getWebhookDeliveryLog(
+	ctx context.Context,
+	app common.Address,
+	limit int,
+	txn pgx.Tx,
+) ([]*AppWebhookDeliveryLogEntry, error) {
+	rows, err := txn.Query(
+		ctx,
+		`SELECT channel_id, event_hash, attempt, status, http_status, error, created_at
+		FROM app_webhook_delivery_log WHERE app_id = $1 ORDER BY id DESC LIMIT $2`,
+		PGAddress(app),
+		limit,
+	)
+	if err != nil {
+		return nil, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to read delivery log")
+	}
+
+	var (
+		entries   []*AppWebhookDeliveryLogEntry
+		channelID string
+		eventHash string
+		status    int16
+		entry     AppWebhookDeliveryLogEntry
+	)
+	if _, err := pgx.ForEachRow(
+		rows,
+		[]any{&channelID, &eventHash, &entry.Attempt, &status, &entry.HttpStatus, &entry.Error, &entry.CreatedAt},
+		func() error {
+			streamID, err := shared.StreamIdFromString(channelID)
+			if err != nil {
+				return err
+			}
+			e := entry
+			e.App = app
+			e.ChannelID = streamID
+			e.EventHash = common.HexToHash(eventHash)
+			e.Status = protocol.WebhookDeliveryStatus(status)
+			entries = append(entries, &e)
+			return nil
+		},
+	); err != nil {
+		return nil, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to read delivery log")
+	}
+
+	return entries, nil
+}
+
+func (s *PostgresAppRegistryStore) PruneWebhookDeliveryLog(ctx context.Context, age time.Duration) error {
+	return s.txRunner(
+		ctx,
+		"PruneWebhookDeliveryLog",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				`DELETE FROM app_webhook_delivery_log WHERE created_at < NOW() - make_interval(secs => $1)`,
+				age.Seconds(),
+			)
+			return err
+		},
+		nil,
+	)
+}
+
+func (s *PostgresAppRegistryStore) AddAppSessionKeys(
+	ctx context.Context,
+	app common.Address,
+	sessionIDs []string,
+) (held bool, err error) {
+	if len(sessionIDs) == 0 {
+		return false, nil
+	}
+
+	err = s.txRunner(
+		ctx,
+		"AddAppSessionKeys",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			held, err = s.addAppSessionKeys(ctx, app, sessionIDs, tx)
+			return err
+		},
+		nil,
+		"appAddress", app,
+	)
+	return held, err
+}
+
+func (s *PostgresAppRegistryStore) addAppSessionKeys(
+	ctx context.Context,
+	app common.Address,
+	sessionIDs []string,
+	txn pgx.Tx,
+) (bool, error) {
+	if _, err := txn.Exec(
+		ctx,
+		`INSERT INTO app_session_keys (app_id, session_id)
+		SELECT app_id, UNNEST($2::VARCHAR[]) FROM app_registry WHERE app_id = $1
+		ON CONFLICT DO NOTHING`,
+		PGAddress(app),
+		sessionIDs,
+	); err != nil {
+		return false, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to add app session keys")
+	}
+
+	var held bool
+	if err := txn.QueryRow(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM app_webhook_queue WHERE app_id = $1 AND session_id = ANY($2))`,
+		PGAddress(app),
+		sessionIDs,
+	).Scan(&held); err != nil {
+		return false, WrapRiverError(protocol.Err_DB_OPERATION_FAILURE, err).Message("Unable to add app session keys")
+	}
+
+	return held, nil
+}
+
+func (s *PostgresAppRegistryStore) ExpireHeldWebhookDeliveries(
+	ctx context.Context,
+	maxWait time.Duration,
+) (expired int, err error) {
+	err = s.txRunner(
+		ctx,
+		"ExpireHeldWebhookDeliveries",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			tag, err := tx.Exec(
+				ctx,
+				`WITH expired AS (
+					DELETE FROM app_webhook_queue q
+					WHERE q.session_id IS NOT NULL AND q.created_at < NOW() - make_interval(secs => $1)
+					AND NOT EXISTS (
+						SELECT 1 FROM app_session_keys k WHERE k.app_id = q.app_id AND k.session_id = q.session_id
+					)
+					RETURNING app_id, channel_id, event_hash, attempts
+				)
+				INSERT INTO app_webhook_delivery_log (app_id, channel_id, event_hash, attempt, status, http_status, error)
+				SELECT app_id, channel_id, event_hash, attempts, $2, 0, $3 FROM expired`,
+				maxWait.Seconds(),
+				int16(protocol.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD_LETTERED),
+				"session keys were not received in time",
+			)
+			if err != nil {
+				return err
+			}
+			expired = int(tag.RowsAffected())
+			return nil
+		},
+		nil,
+	)
+	return expired, err
+}
+
+func (s *PostgresAppRegistryStore) PruneAppSessionKeys(ctx context.Context, age time.Duration) error {
+	return s.txRunner(
+		ctx,
+		"PruneAppSessionKeys",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				`DELETE FROM app_session_keys WHERE created_at < NOW() - make_interval(secs => $1)`,
+				age.Seconds(),
+			)
+			return err
+		},
+		nil,
+	)
+}
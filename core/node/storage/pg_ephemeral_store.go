@@ -337,3 +337,60 @@ func (s *PostgresStreamStore) IsStreamEphemeral(ctx context.Context, streamId St
 	)
 	return
 }
+
+// readEphemeralStreamIds returns IDs of all ephemeral streams in the database.
+func (s *PostgresStreamStore) readEphemeralStreamIds(ctx context.Context) ([]StreamId, error) {
+	var streamIds []StreamId
+	err := s.txRunner(
+		ctx,
+		"ephemeralStreamMonitor.loadEphemeralStreams",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			rows, err := tx.Query(ctx, "SELECT stream_id FROM es WHERE ephemeral = true")
+			if err != nil {
+				return err
+			}
+
+			var stream string
+			_, err = pgx.ForEachRow(rows, []any{&stream}, func() error {
+				streamId, err := StreamIdFromString(stream)
+				if err != nil {
+					return err
+				}
+				streamIds = append(streamIds, streamId)
+				return nil
+			})
+			return err
+		},
+		nil,
+	)
+	return streamIds, err
+}
+
+// deleteEphemeralStream deletes the given ephemeral stream with all its miniblocks.
+func (s *PostgresStreamStore) deleteEphemeralStream(ctx context.Context, streamId StreamId) error {
+	return s.txRunner(
+		ctx,
+		"ephemeralStreamMonitor.handleStream",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if _, err := s.lockEphemeralStream(ctx, tx, streamId, true); err != nil {
+				return err
+			}
+
+			_, err := tx.Exec(
+				ctx,
+				s.sqlForStream(
+					`DELETE from {{miniblocks}} WHERE stream_id = $1;
+					 DELETE from {{minipools}} WHERE stream_id = $1;
+					 DELETE FROM es WHERE stream_id = $1`,
+					streamId,
+				),
+				streamId,
+			)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+}
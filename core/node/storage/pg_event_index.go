@@ -0,0 +1,166 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+var _ EventIndexStore = (*PostgresStreamStore)(nil)
+
+// IndexEvents adds the given events to the event_index table.
+func (s *PostgresStreamStore) IndexEvents(ctx context.Context, events []*IndexedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return s.txRunner(
+		ctx,
+		"IndexEvents",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.indexEventsTx(ctx, tx, events)
+		},
+		nil,
+		"numEvents", len(events),
+	)
+}
+
+func (s *PostgresStreamStore) indexEventsTx(ctx context.Context, tx pgx.Tx, events []*IndexedEvent) error {
+	batch := &pgx.Batch{}
+	for _, e := range events {
+		batch.Queue(
+			`INSERT INTO event_index
+				(stream_id, event_num, miniblock_num, event_hash, creator, user_address, event_type, created_at, payload)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (stream_id, event_num) DO NOTHING`,
+			e.StreamId,
+			e.EventNum,
+			e.MiniblockNum,
+			hex.EncodeToString(e.EventHash[:]),
+			PGAddress(e.Creator),
+			PGAddress(e.User),
+			e.EventType,
+			e.CreatedAt,
+			string(e.Payload),
+		)
+	}
+
+	br := tx.SendBatch(ctx, batch)
+	_, _ = br.Exec()
+	return br.Close() // returns the cause why br.Exec failed
+}
+
+// SearchEvents returns the events from the event_index table that match the query.
+func (s *PostgresStreamStore) SearchEvents(ctx context.Context, query *EventSearchQuery) ([]*IndexedEvent, error) {
+	var events []*IndexedEvent
+	if err := s.txRunner(
+		ctx,
+		"SearchEvents",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			events, err = s.searchEventsTx(ctx, tx, query)
+			return err
+		},
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (s *PostgresStreamStore) searchEventsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	query *EventSearchQuery,
+) ([]*IndexedEvent, error) {
+	if query.Limit <= 0 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Limit must be positive", "limit", query.Limit).
+			Func("PostgresStreamStore.SearchEvents")
+	}
+
+	var conditions []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if query.StreamId != nil {
+		conditions = append(conditions, "stream_id = "+arg(*query.StreamId))
+	}
+	if query.User != nil {
+		user := arg(PGAddress(*query.User))
+		conditions = append(conditions, fmt.Sprintf("(creator = %s OR user_address = %s)", user, user))
+	}
+	if len(query.EventTypes) > 0 {
+		conditions = append(conditions, "event_type = ANY("+arg(query.EventTypes)+")")
+	}
+	if !query.From.IsZero() {
+		conditions = append(conditions, "created_at >= "+arg(query.From))
+	}
+	if !query.To.IsZero() {
+		conditions = append(conditions, "created_at < "+arg(query.To))
+	}
+	if query.After != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"(created_at, stream_id, event_num) > (%s, %s, %s)",
+			arg(query.After.CreatedAt),
+			arg(query.After.StreamId),
+			arg(query.After.EventNum),
+		))
+	}
+
+	sql := `SELECT stream_id, event_num, miniblock_num, event_hash, creator, user_address, event_type, created_at, payload
+		FROM event_index`
+	if len(conditions) > 0 {
+		sql += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	sql += " ORDER BY created_at, stream_id, event_num LIMIT " + arg(query.Limit)
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*IndexedEvent
+	for rows.Next() {
+		var (
+			e             IndexedEvent
+			eventHash     string
+			creator, user PGAddress
+			payload       string
+		)
+		if err := rows.Scan(
+			&e.StreamId,
+			&e.EventNum,
+			&e.MiniblockNum,
+			&eventHash,
+			&creator,
+			&user,
+			&e.EventType,
+			&e.CreatedAt,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		e.EventHash = common.HexToHash(eventHash)
+		e.Creator = common.Address(creator)
+		e.User = common.Address(user)
+		e.Payload = []byte(payload)
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
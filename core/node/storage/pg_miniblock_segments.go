@@ -0,0 +1,321 @@
+package storage
+
+import (
+	"context"
+	"math"
+
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// EnableColdTier enables offloading of miniblocks into segments stored in the given backend.
+// ReadMiniblocks reads offloaded miniblocks from the segments transparently.
+// EnableColdTier must be called before the store is used.
+func (s *PostgresStreamStore) EnableColdTier(backend SegmentBackend) {
+	s.segments = backend
+}
+
+// OffloadMiniblocks moves full segments of segmentSize miniblocks from the database into segment files.
+// The last miniblock of the stream is always kept in the database so GetMaxArchivedMiniblockNumber
+// and WriteArchiveMiniblocks keep working. Returns the number of offloaded miniblocks.
+func (s *PostgresStreamStore) OffloadMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	segmentSize int64,
+) (int64, error) {
+	if s.segments == nil {
+		return 0, RiverError(Err_FAILED_PRECONDITION, "Cold tier is not enabled").
+			Func("PostgresStreamStore.OffloadMiniblocks")
+	}
+	if segmentSize <= 0 {
+		return 0, RiverError(Err_INVALID_ARGUMENT, "Segment size must be positive", "segmentSize", segmentSize).
+			Func("PostgresStreamStore.OffloadMiniblocks")
+	}
+
+	var total int64
+	for {
+		var offloaded int64
+		if err := s.txRunner(
+			ctx,
+			"OffloadMiniblocks",
+			pgx.ReadWrite,
+			func(ctx context.Context, tx pgx.Tx) error {
+				var err error
+				offloaded, err = s.offloadSegmentTx(ctx, tx, streamId, segmentSize)
+				return err
+			},
+			nil,
+			"streamId", streamId,
+			"segmentSize", segmentSize,
+		); err != nil {
+			return total, err
+		}
+
+		if offloaded == 0 {
+			return total, nil
+		}
+		total += offloaded
+	}
+}
+
+// offloadSegmentTx offloads the oldest segmentSize miniblocks of the stream that are still in the database
+// if there are enough of them. Returns the number of offloaded miniblocks.
+func (s *PostgresStreamStore) offloadSegmentTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	segmentSize int64,
+) (int64, error) {
+	if _, err := s.lockStream(ctx, tx, streamId, true); err != nil {
+		return 0, err
+	}
+
+	var firstSeqNum, lastSeqNum int64
+	if err := tx.QueryRow(
+		ctx,
+		s.sqlForStream(
+			"SELECT COALESCE(MIN(seq_num), -1), COALESCE(MAX(seq_num), -1) FROM {{miniblocks}} WHERE stream_id = $1",
+			streamId,
+		),
+		streamId,
+	).Scan(&firstSeqNum, &lastSeqNum); err != nil {
+		return 0, err
+	}
+
+	// Only full segments are offloaded and the last miniblock stays in the database.
+	if firstSeqNum == -1 || firstSeqNum+segmentSize > lastSeqNum {
+		return 0, nil
+	}
+	toExclusive := firstSeqNum + segmentSize
+
+	rows, err := tx.Query(
+		ctx,
+		s.sqlForStream(
+			"SELECT blockdata FROM {{miniblocks}} WHERE seq_num >= $1 AND seq_num < $2 AND stream_id = $3 ORDER BY seq_num",
+			streamId,
+		),
+		firstSeqNum,
+		toExclusive,
+		streamId,
+	)
+	if err != nil {
+		return 0, err
+	}
+	miniblocks, err := pgx.CollectRows(rows, pgx.RowTo[[]byte])
+	if err != nil {
+		return 0, err
+	}
+	if int64(len(miniblocks)) != segmentSize {
+		return 0, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+			Tag("expectedMiniblocks", segmentSize).
+			Tag("actualMiniblocks", len(miniblocks))
+	}
+
+	data, contentHash, err := encodeMiniblockSegment(streamId, firstSeqNum, miniblocks)
+	if err != nil {
+		return 0, err
+	}
+
+	// Segments are content-addressed, so storing the same segment again if the transaction
+	// is retried is a no-op.
+	if err = s.segments.PutSegment(ctx, miniblockSegmentName(streamId, contentHash), data); err != nil {
+		return 0, WrapRiverError(Err_MINIBLOCKS_STORAGE_FAILURE, err).Message("Failed to store segment")
+	}
+
+	if _, err = tx.Exec(
+		ctx,
+		"INSERT INTO miniblock_segments (stream_id, from_seq_num, to_seq_num, content_hash) VALUES ($1, $2, $3, $4)",
+		streamId,
+		firstSeqNum,
+		toExclusive,
+		contentHash,
+	); err != nil {
+		return 0, err
+	}
+
+	if _, err = tx.Exec(
+		ctx,
+		s.sqlForStream(
+			"DELETE FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num < $2",
+			streamId,
+		),
+		streamId,
+		toExclusive,
+	); err != nil {
+		return 0, err
+	}
+
+	return segmentSize, nil
+}
+
+// segmentRecord is an entry of the miniblock_segments index.
+type segmentRecord struct {
+	from        int64
+	to          int64
+	contentHash string
+}
+
+// querySegmentsTx returns the index entries of the segments of the stream that overlap with the given range,
+// ordered by miniblock number.
+func (s *PostgresStreamStore) querySegmentsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([]segmentRecord, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT from_seq_num, to_seq_num, content_hash FROM miniblock_segments
+			WHERE stream_id = $1 AND to_seq_num > $2 AND from_seq_num < $3 ORDER BY from_seq_num`,
+		streamId,
+		fromInclusive,
+		toExclusive,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []segmentRecord
+	var record segmentRecord
+	if _, err = pgx.ForEachRow(rows, []any{&record.from, &record.to, &record.contentHash}, func() error {
+		records = append(records, record)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// loadSegment reads the segment of the given index entry and verifies that it matches the entry.
+func (s *PostgresStreamStore) loadSegment(
+	ctx context.Context,
+	streamId StreamId,
+	record segmentRecord,
+) (*miniblockSegment, error) {
+	data, err := s.segments.GetSegment(ctx, miniblockSegmentName(streamId, record.contentHash))
+	if err != nil {
+		return nil, AsRiverError(err, Err_MINIBLOCKS_STORAGE_FAILURE).Tag("streamId", streamId)
+	}
+	segment, err := decodeMiniblockSegment(data, record.contentHash)
+	if err != nil {
+		return nil, AsRiverError(err).Tag("streamId", streamId)
+	}
+	if segment.streamId != streamId ||
+		segment.fromInclusive != record.from ||
+		segment.fromInclusive+int64(len(segment.miniblocks)) != record.to {
+		return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Segment doesn't match segment index").
+			Tag("streamId", streamId).
+			Tag("contentHash", record.contentHash)
+	}
+	return segment, nil
+}
+
+// readSegmentMiniblocksTx reads the miniblocks in the given range from segments.
+// Miniblocks are returned from fromInclusive up to the first miniblock that is not offloaded.
+func (s *PostgresStreamStore) readSegmentMiniblocksTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	records, err := s.querySegmentsTx(ctx, tx, streamId, fromInclusive, toExclusive)
+	if err != nil {
+		return nil, err
+	}
+
+	var miniblocks [][]byte
+	next := fromInclusive
+	for _, record := range records {
+		if record.from > next {
+			break
+		}
+
+		segment, err := s.loadSegment(ctx, streamId, record)
+		if err != nil {
+			return nil, err
+		}
+
+		end := min(record.to, toExclusive)
+		miniblocks = append(miniblocks, segment.miniblocks[next-record.from:end-record.from]...)
+		next = end
+		if next >= toExclusive {
+			break
+		}
+	}
+
+	return miniblocks, nil
+}
+
+// forEachSegmentMiniblockTx calls onEachMb for the offloaded miniblocks of the stream in order.
+// If include is not nil only the miniblocks for which it returns true are passed to onEachMb.
+// Segments are loaded one at a time. It is a no-op if the cold tier is disabled.
+func (s *PostgresStreamStore) forEachSegmentMiniblockTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	include func(seqNum int64) bool,
+	onEachMb func(blockdata []byte, seqNum int64) error,
+) error {
+	if s.segments == nil {
+		return nil
+	}
+
+	records, err := s.querySegmentsTx(ctx, tx, streamId, 0, math.MaxInt64)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if include != nil {
+			included := false
+			for seqNum := record.from; seqNum < record.to && !included; seqNum++ {
+				included = include(seqNum)
+			}
+			if !included {
+				continue
+			}
+		}
+
+		segment, err := s.loadSegment(ctx, streamId, record)
+		if err != nil {
+			return err
+		}
+		for i, mb := range segment.miniblocks {
+			seqNum := record.from + int64(i)
+			if include != nil && !include(seqNum) {
+				continue
+			}
+			if err := onEachMb(mb, seqNum); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteSegmentsTx removes the segment index entries of the stream and returns the names of the segment files.
+func (s *PostgresStreamStore) deleteSegmentsTx(ctx context.Context, tx pgx.Tx, streamId StreamId) ([]string, error) {
+	rows, err := tx.Query(
+		ctx,
+		"DELETE FROM miniblock_segments WHERE stream_id = $1 RETURNING content_hash",
+		streamId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	contentHashes, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(contentHashes))
+	for i, contentHash := range contentHashes {
+		names[i] = miniblockSegmentName(streamId, contentHash)
+	}
+	return names, nil
+}
@@ -0,0 +1,211 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+type (
+	// NotificationDigest collects the messages in a space channel for which a user wants a single notification.
+	NotificationDigest struct {
+		UserID    common.Address
+		SpaceID   shared.StreamId
+		ChannelID shared.StreamId
+		// Event is the serialized envelope of the latest message of the digest.
+		Event   []byte
+		Kind    string
+		Members []string
+		// Count is the number of messages in the digest.
+		Count int
+	}
+
+	// NotificationDigestStore keeps digests until their notification is sent.
+	NotificationDigestStore interface {
+		// AddToDigest adds the message in digest to the pending digest of the user for the channel and returns
+		// the number of messages in the digest. The first message opens the digest, it is due after window.
+		// If lease is not nil the message is only added when the shard lease is held.
+		AddToDigest(
+			ctx context.Context,
+			lease *ShardLease,
+			digest *NotificationDigest,
+			window time.Duration,
+		) (int, error)
+
+		// ClaimDigests returns at most limit due digests. Claimed digests are not returned by other calls
+		// until the lease expires.
+		ClaimDigests(ctx context.Context, limit int, lease time.Duration) ([]*NotificationDigest, error)
+
+		// CompleteDigest removes the messages of a claimed digest after its notification was sent. Messages that
+		// were added after the digest was claimed are kept in a new digest that is due after window.
+		CompleteDigest(ctx context.Context, digest *NotificationDigest, window time.Duration) error
+	}
+)
+
+var _ NotificationDigestStore = (*PostgresNotificationStore)(nil)
+
+func (s *PostgresNotificationStore) AddToDigest(
+	ctx context.Context,
+	lease *ShardLease,
+	digest *NotificationDigest,
+	window time.Duration,
+) (int, error) {
+	var count int
+	if err := s.txRunner(
+		ctx,
+		"AddToDigest",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if lease != nil {
+				if err := checkShardLeaseTx(ctx, tx, lease); err != nil {
+					return err
+				}
+			}
+			return tx.QueryRow(
+				ctx,
+				`INSERT INTO notification_digests
+				(user_id, channel_id, space_id, event, kind, members, count, deliver_at)
+				VALUES ($1, $2, $3, $4, $5, $6, 1, NOW() + make_interval(secs => $7))
+				ON CONFLICT (user_id, channel_id) DO UPDATE SET event = EXCLUDED.event, kind = EXCLUDED.kind,
+				members = EXCLUDED.members, count = notification_digests.count + 1
+				RETURNING count`,
+				hex.EncodeToString(digest.UserID[:]),
+				digest.ChannelID.String(),
+				digest.SpaceID.String(),
+				digest.Event,
+				digest.Kind,
+				digest.Members,
+				window.Seconds(),
+			).Scan(&count)
+		},
+		nil,
+		"userID", digest.UserID,
+		"channelID", digest.ChannelID,
+	); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *PostgresNotificationStore) ClaimDigests(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*NotificationDigest, error) {
+	var digests []*NotificationDigest
+	if err := s.txRunner(
+		ctx,
+		"ClaimDigests",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			digests, err = s.claimDigestsTx(ctx, tx, limit, lease)
+			return err
+		},
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	return digests, nil
+}
+
+func (s *PostgresNotificationStore) claimDigestsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	limit int,
+	lease time.Duration,
+) ([]*NotificationDigest, error) {
+	rows, err := tx.Query(
+		ctx,
+		`UPDATE notification_digests SET deliver_at = NOW() + make_interval(secs => $2)
+		WHERE (user_id, channel_id) IN (
+			SELECT user_id, channel_id FROM notification_digests WHERE deliver_at <= NOW()
+			ORDER BY deliver_at LIMIT $1 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING user_id, channel_id, space_id, event, kind, members, count`,
+		limit,
+		lease.Seconds(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		digests   []*NotificationDigest
+		userID    string
+		channelID string
+		spaceID   string
+		event     []byte
+		kind      string
+		members   []string
+		count     int
+	)
+	if _, err := pgx.ForEachRow(
+		rows,
+		[]any{&userID, &channelID, &spaceID, &event, &kind, &members, &count},
+		func() error {
+			digest := &NotificationDigest{
+				UserID:  common.HexToAddress(userID),
+				Event:   event,
+				Kind:    kind,
+				Members: members,
+				Count:   count,
+			}
+			if digest.ChannelID, err = shared.StreamIdFromString(channelID); err != nil {
+				return err
+			}
+			if digest.SpaceID, err = shared.StreamIdFromString(spaceID); err != nil {
+				return err
+			}
+			digests = append(digests, digest)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return digests, nil
+}
+
+func (s *PostgresNotificationStore) CompleteDigest(
+	ctx context.Context,
+	digest *NotificationDigest,
+	window time.Duration,
+) error {
+	return s.txRunner(
+		ctx,
+		"CompleteDigest",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			userID := hex.EncodeToString(digest.UserID[:])
+			tag, err := tx.Exec(
+				ctx,
+				`DELETE FROM notification_digests WHERE user_id = $1 AND channel_id = $2 AND count <= $3`,
+				userID,
+				digest.ChannelID.String(),
+				digest.Count,
+			)
+			if err != nil || tag.RowsAffected() > 0 {
+				return err
+			}
+			_, err = tx.Exec(
+				ctx,
+				`UPDATE notification_digests SET count = count - $3, deliver_at = NOW() + make_interval(secs => $4)
+				WHERE user_id = $1 AND channel_id = $2`,
+				userID,
+				digest.ChannelID.String(),
+				digest.Count,
+				window.Seconds(),
+			)
+			return err
+		},
+		nil,
+		"userID", digest.UserID,
+		"channelID", digest.ChannelID,
+	)
+}
@@ -0,0 +1,404 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+type (
+	// NotificationOutboxEntry is a notification that is waiting to be delivered to a single subscription.
+	NotificationOutboxEntry struct {
+		ID int64
+		// Provider is the push provider the notification is delivered with, for example "apn".
+		Provider  string
+		UserID    common.Address
+		EventHash common.Hash
+		// Target identifies the subscription the notification is delivered to, its encoding is provider specific.
+		Target []byte
+		// Payload is the provider specific notification content.
+		Payload []byte
+		// CollapseID if set replaces earlier notifications with the same collapse id on the device.
+		CollapseID string
+		// Attempts is the number of delivery attempts, including the attempt the entry is claimed for.
+		Attempts  int
+		CreatedAt time.Time
+	}
+
+	// NotificationOutboxStats describes the outbox entries of a provider.
+	NotificationOutboxStats struct {
+		Provider     string
+		Pending      int64
+		DeadLettered int64
+		// Failures counts pending and dead lettered entries by the reason of their last failed attempt.
+		Failures map[string]int64
+	}
+
+	// NotificationOutboxStore keeps notifications until they are delivered.
+	NotificationOutboxStore interface {
+		// EnqueueNotifications adds the given entries to the outbox. They are due for delivery immediately.
+		// Entries for an event and subscription that are already in the outbox, including delivered entries
+		// that are not removed yet, are ignored.
+		// If lease is not nil the entries are only added when the shard lease is held.
+		EnqueueNotifications(ctx context.Context, lease *ShardLease, entries []*NotificationOutboxEntry) error
+
+		// ClaimNotifications returns at most limit due entries for the given provider. Claimed entries are not
+		// returned by other calls until the lease expires, the attempts counter is incremented.
+		ClaimNotifications(
+			ctx context.Context,
+			provider string,
+			limit int,
+			lease time.Duration,
+		) ([]*NotificationOutboxEntry, error)
+
+		// CompleteNotification marks the entry as delivered. Delivered entries are kept until they are
+		// removed by DeleteDeliveredNotifications.
+		CompleteNotification(ctx context.Context, id int64) error
+
+		// RetryNotification schedules the next delivery attempt of the entry after the given delay.
+		RetryNotification(ctx context.Context, id int64, delay time.Duration, reason string, lastErr string) error
+
+		// DeadLetterNotification stops delivery attempts of the entry. The entry is kept for inspection.
+		DeadLetterNotification(ctx context.Context, id int64, reason string, lastErr string) error
+
+		// DeleteDeliveredNotifications removes entries that were delivered longer than olderThan ago
+		// and returns the number of removed entries.
+		DeleteDeliveredNotifications(ctx context.Context, olderThan time.Duration) (int64, error)
+
+		// DeleteDeadLetteredNotifications removes entries that were dead lettered longer than olderThan ago
+		// and returns the number of removed entries.
+		DeleteDeadLetteredNotifications(ctx context.Context, olderThan time.Duration) (int64, error)
+
+		// GetNotificationOutboxStats returns the outbox stats for each provider with entries in the outbox.
+		GetNotificationOutboxStats(ctx context.Context) ([]*NotificationOutboxStats, error)
+	}
+)
+
+var _ NotificationOutboxStore = (*PostgresNotificationStore)(nil)
+
+func (s *PostgresNotificationStore) EnqueueNotifications(
+	ctx context.Context,
+	lease *ShardLease,
+	entries []*NotificationOutboxEntry,
+) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return s.txRunner(
+		ctx,
+		"EnqueueNotifications",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if lease != nil {
+				if err := checkShardLeaseTx(ctx, tx, lease); err != nil {
+					return err
+				}
+			}
+			return s.enqueueNotificationsTx(ctx, tx, entries)
+		},
+		nil,
+		"numEntries", len(entries),
+	)
+}
+
+func (s *PostgresNotificationStore) enqueueNotificationsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	entries []*NotificationOutboxEntry,
+) error {
+	batch := &pgx.Batch{}
+	for _, e := range entries {
+		batch.Queue(
+			`INSERT INTO notification_outbox (provider, user_id, event_hash, target, payload, collapse_id, next_attempt)
+			VALUES ($1, $2, $3, $4, $5, $6, NOW())
+			ON CONFLICT DO NOTHING`,
+			e.Provider,
+			hex.EncodeToString(e.UserID[:]),
+			hex.EncodeToString(e.EventHash[:]),
+			e.Target,
+			e.Payload,
+			e.CollapseID,
+		)
+	}
+
+	br := tx.SendBatch(ctx, batch)
+	_, _ = br.Exec()
+	return br.Close() // returns the cause why br.Exec failed
+}
+
+func (s *PostgresNotificationStore) ClaimNotifications(
+	ctx context.Context,
+	provider string,
+	limit int,
+	lease time.Duration,
+) ([]*NotificationOutboxEntry, error) {
+	var entries []*NotificationOutboxEntry
+	if err := s.txRunner(
+		ctx,
+		"ClaimNotifications",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			entries, err = s.claimNotificationsTx(ctx, tx, provider, limit, lease)
+			return err
+		},
+		nil,
+		"provider", provider,
+	); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *PostgresNotificationStore) claimNotificationsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	provider string,
+	limit int,
+	lease time.Duration,
+) ([]*NotificationOutboxEntry, error) {
+	rows, err := tx.Query(
+		ctx,
+		`UPDATE notification_outbox SET next_attempt = NOW() + make_interval(secs => $3), attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM notification_outbox
+			WHERE provider = $1 AND dead_lettered_at IS NULL AND delivered_at IS NULL AND next_attempt <= NOW()
+			ORDER BY next_attempt LIMIT $2 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, user_id, event_hash, target, payload, collapse_id, attempts, created_at`,
+		provider,
+		limit,
+		lease.Seconds(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*NotificationOutboxEntry
+	for rows.Next() {
+		var (
+			e         = NotificationOutboxEntry{Provider: provider}
+			userID    string
+			eventHash string
+		)
+		if err := rows.Scan(
+			&e.ID, &userID, &eventHash, &e.Target, &e.Payload, &e.CollapseID, &e.Attempts, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		e.UserID = common.HexToAddress(userID)
+		e.EventHash = common.HexToHash(eventHash)
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+func (s *PostgresNotificationStore) CompleteNotification(ctx context.Context, id int64) error {
+	return s.txRunner(
+		ctx,
+		"CompleteNotification",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `UPDATE notification_outbox SET delivered_at = NOW() WHERE id = $1`, id)
+			return err
+		},
+		nil,
+		"id", id,
+	)
+}
+
+func (s *PostgresNotificationStore) RetryNotification(
+	ctx context.Context,
+	id int64,
+	delay time.Duration,
+	reason string,
+	lastErr string,
+) error {
+	return s.txRunner(
+		ctx,
+		"RetryNotification",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.updateOutboxEntryTx(
+				ctx,
+				tx,
+				id,
+				`UPDATE notification_outbox SET next_attempt = NOW() + make_interval(secs => $2), failure_reason = $3,
+				last_error = $4 WHERE id = $1`,
+				id,
+				delay.Seconds(),
+				reason,
+				lastErr,
+			)
+		},
+		nil,
+		"id", id,
+	)
+}
+
+func (s *PostgresNotificationStore) DeadLetterNotification(
+	ctx context.Context,
+	id int64,
+	reason string,
+	lastErr string,
+) error {
+	return s.txRunner(
+		ctx,
+		"DeadLetterNotification",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.updateOutboxEntryTx(
+				ctx,
+				tx,
+				id,
+				`UPDATE notification_outbox SET dead_lettered_at = NOW(), failure_reason = $2, last_error = $3
+				WHERE id = $1`,
+				id,
+				reason,
+				lastErr,
+			)
+		},
+		nil,
+		"id", id,
+	)
+}
+
+func (s *PostgresNotificationStore) DeleteDeliveredNotifications(
+	ctx context.Context,
+	olderThan time.Duration,
+) (int64, error) {
+	var deleted int64
+	err := s.txRunner(
+		ctx,
+		"DeleteDeliveredNotifications",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			tag, err := tx.Exec(
+				ctx,
+				`DELETE FROM notification_outbox
+				WHERE delivered_at IS NOT NULL AND delivered_at < NOW() - make_interval(secs => $1)`,
+				olderThan.Seconds(),
+			)
+			if err != nil {
+				return err
+			}
+			deleted = tag.RowsAffected()
+			return nil
+		},
+		nil,
+		"olderThan", olderThan,
+	)
+	return deleted, err
+}
+
+func (s *PostgresNotificationStore) DeleteDeadLetteredNotifications(
+	ctx context.Context,
+	olderThan time.Duration,
+) (int64, error) {
+	var deleted int64
+	err := s.txRunner(
+		ctx,
+		"DeleteDeadLetteredNotifications",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			tag, err := tx.Exec(
+				ctx,
+				`DELETE FROM notification_outbox
+				WHERE dead_lettered_at IS NOT NULL AND dead_lettered_at < NOW() - make_interval(secs => $1)`,
+				olderThan.Seconds(),
+			)
+			if err != nil {
+				return err
+			}
+			deleted = tag.RowsAffected()
+			return nil
+		},
+		nil,
+		"olderThan", olderThan,
+	)
+	return deleted, err
+}
+
+func (s *PostgresNotificationStore) updateOutboxEntryTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	id int64,
+	sql string,
+	args ...any,
+) error {
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return RiverError(Err_NOT_FOUND, "Notification outbox entry not found", "id", id)
+	}
+	return nil
+}
+
+func (s *PostgresNotificationStore) GetNotificationOutboxStats(ctx context.Context) ([]*NotificationOutboxStats, error) {
+	var stats []*NotificationOutboxStats
+	if err := s.txRunner(
+		ctx,
+		"GetNotificationOutboxStats",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			stats, err = s.getNotificationOutboxStatsTx(ctx, tx)
+			return err
+		},
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (s *PostgresNotificationStore) getNotificationOutboxStatsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+) ([]*NotificationOutboxStats, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT provider, dead_lettered_at IS NOT NULL, COALESCE(failure_reason, ''), COUNT(*)
+		FROM notification_outbox WHERE delivered_at IS NULL GROUP BY 1, 2, 3 ORDER BY 1`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []*NotificationOutboxStats
+	for rows.Next() {
+		var (
+			provider     string
+			deadLettered bool
+			reason       string
+			count        int64
+		)
+		if err := rows.Scan(&provider, &deadLettered, &reason, &count); err != nil {
+			return nil, err
+		}
+		if len(stats) == 0 || stats[len(stats)-1].Provider != provider {
+			stats = append(stats, &NotificationOutboxStats{Provider: provider, Failures: make(map[string]int64)})
+		}
+		s := stats[len(stats)-1]
+		if deadLettered {
+			s.DeadLettered += count
+		} else {
+			s.Pending += count
+		}
+		if reason != "" {
+			s.Failures[reason] += count
+		}
+	}
+	return stats, rows.Err()
+}
@@ -0,0 +1,199 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+// SetQuietHours sets the quiet hours for the user, quiet hours are removed if quietHours is nil.
+func (s *PostgresNotificationStore) SetQuietHours(
+	ctx context.Context,
+	userID common.Address,
+	quietHours *types.QuietHoursSettings,
+) error {
+	return s.txRunner(
+		ctx,
+		"SetQuietHours",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.setQuietHoursTx(ctx, tx, userID, quietHours)
+		},
+		nil,
+		"userID", userID,
+	)
+}
+
+func (s *PostgresNotificationStore) setQuietHoursTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID common.Address,
+	quietHours *types.QuietHoursSettings,
+) error {
+	userIDStr := hex.EncodeToString(userID[:])
+
+	if quietHours == nil {
+		_, err := tx.Exec(ctx, `DELETE FROM quiethours WHERE user_id = $1`, userIDStr)
+		return err
+	}
+
+	// only the windows are encoded, the time zone and mentions flag have their own columns
+	windows, err := proto.Marshal(&QuietHours{Windows: quietHours.Protobuf().GetWindows()})
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL)
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO quiethours (user_id, timezone, windows, allow_mentions) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET timezone = $2, windows = $3, allow_mentions = $4`,
+		userIDStr,
+		quietHours.Location.String(),
+		windows,
+		quietHours.AllowMentions,
+	)
+	return err
+}
+
+func (s *PostgresNotificationStore) getQuietHoursTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID common.Address,
+) (*types.QuietHoursSettings, error) {
+	var (
+		timezone      string
+		windows       []byte
+		allowMentions bool
+	)
+	err := tx.QueryRow(
+		ctx,
+		`SELECT timezone, windows, allow_mentions FROM quiethours WHERE user_id = $1`,
+		hex.EncodeToString(userID[:]),
+	).Scan(&timezone, &windows, &allowMentions)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var msg QuietHours
+	if err := proto.Unmarshal(windows, &msg); err != nil {
+		return nil, AsRiverError(err, Err_INTERNAL)
+	}
+	msg.Timezone = timezone
+	msg.AllowMentions = allowMentions
+
+	return types.DecodeQuietHoursFromMsg(&msg)
+}
+
+// SetSnooze sets the snooze for the given space, or for all streams if spaceID is nil.
+// The snooze is removed if snooze is nil.
+func (s *PostgresNotificationStore) SetSnooze(
+	ctx context.Context,
+	userID common.Address,
+	spaceID *shared.StreamId,
+	snooze *types.SnoozeSettings,
+) error {
+	return s.txRunner(
+		ctx,
+		"SetSnooze",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.setSnoozeTx(ctx, tx, userID, spaceID, snooze)
+		},
+		nil,
+		"userID", userID,
+	)
+}
+
+func (s *PostgresNotificationStore) setSnoozeTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID common.Address,
+	spaceID *shared.StreamId,
+	snooze *types.SnoozeSettings,
+) error {
+	userIDStr := hex.EncodeToString(userID[:])
+	spaceIDStr := ""
+	if spaceID != nil {
+		spaceIDStr = spaceID.String()
+	}
+
+	// remove expired snoozes of the user to prevent them from piling up
+	if _, err := tx.Exec(
+		ctx,
+		`DELETE FROM snoozes WHERE user_id = $1 AND (space_id = $2 OR until_ms <= $3)`,
+		userIDStr,
+		spaceIDStr,
+		time.Now().UnixMilli(),
+	); err != nil {
+		return err
+	}
+
+	if snooze == nil {
+		return nil
+	}
+
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO snoozes (user_id, space_id, until_ms, allow_mentions) VALUES ($1, $2, $3, $4)`,
+		userIDStr,
+		spaceIDStr,
+		snooze.Until.UnixMilli(),
+		snooze.AllowMentions,
+	)
+	return err
+}
+
+// getSnoozesTx returns the active global snooze and the active space snoozes of the user.
+func (s *PostgresNotificationStore) getSnoozesTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID common.Address,
+) (*types.SnoozeSettings, types.SnoozesMap, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT space_id, until_ms, allow_mentions FROM snoozes WHERE user_id = $1 AND until_ms > $2`,
+		hex.EncodeToString(userID[:]),
+		time.Now().UnixMilli(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		global        *types.SnoozeSettings
+		spaces        = make(types.SnoozesMap)
+		spaceIDStr    string
+		untilMs       int64
+		allowMentions bool
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&spaceIDStr, &untilMs, &allowMentions}, func() error {
+		snooze := &types.SnoozeSettings{Until: time.UnixMilli(untilMs), AllowMentions: allowMentions}
+		if spaceIDStr == "" {
+			global = snooze
+			return nil
+		}
+		spaceID, err := shared.StreamIdFromString(spaceIDStr)
+		if err != nil {
+			return err
+		}
+		spaces[spaceID] = snooze
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return global, spaces, nil
+}
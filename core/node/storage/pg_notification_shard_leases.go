@@ -0,0 +1,225 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+type (
+	// ShardLease identifies a shard that is held by a notification service instance.
+	ShardLease struct {
+		Shard int
+		Owner string
+	}
+
+	// NotificationShardLeaseStore keeps track of the notification service instances that are alive and the
+	// shards they hold. Leases expire when they are not renewed in time, after which they can be acquired by
+	// another instance.
+	NotificationShardLeaseStore interface {
+		// HeartbeatNotificationInstance marks the instance as alive for the given ttl and returns the ids
+		// of all instances that are alive, including the given instance.
+		HeartbeatNotificationInstance(ctx context.Context, instanceID string, ttl time.Duration) ([]string, error)
+
+		// AcquireShardLeases acquires or renews the leases for the given shards for the given ttl. Shards that
+		// are held by another instance with a lease that has not expired are skipped. It returns the shards
+		// the instance holds.
+		AcquireShardLeases(
+			ctx context.Context,
+			instanceID string,
+			shards []int,
+			ttl time.Duration,
+		) ([]int, error)
+
+		// ReleaseShardLeases releases the leases the instance holds for the given shards.
+		ReleaseShardLeases(ctx context.Context, instanceID string, shards []int) error
+	}
+)
+
+var _ NotificationShardLeaseStore = (*PostgresNotificationStore)(nil)
+
+func (s *PostgresNotificationStore) HeartbeatNotificationInstance(
+	ctx context.Context,
+	instanceID string,
+	ttl time.Duration,
+) ([]string, error) {
+	var instances []string
+	if err := s.txRunner(
+		ctx,
+		"HeartbeatNotificationInstance",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			instances, err = s.heartbeatNotificationInstanceTx(ctx, tx, instanceID, ttl)
+			return err
+		},
+		nil,
+		"instanceID", instanceID,
+	); err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+func (s *PostgresNotificationStore) heartbeatNotificationInstanceTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	instanceID string,
+	ttl time.Duration,
+) ([]string, error) {
+	if _, err := tx.Exec(
+		ctx,
+		`INSERT INTO notification_instances (instance_id, expires_at) VALUES ($1, NOW() + make_interval(secs => $2))
+		ON CONFLICT (instance_id) DO UPDATE SET expires_at = EXCLUDED.expires_at`,
+		instanceID,
+		ttl.Seconds(),
+	); err != nil {
+		return nil, err
+	}
+
+	// instances that stopped without cleaning up are removed once they are expired for a while
+	if _, err := tx.Exec(
+		ctx,
+		`DELETE FROM notification_instances WHERE expires_at < NOW() - make_interval(secs => $1)`,
+		(10 * ttl).Seconds(),
+	); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		`SELECT instance_id FROM notification_instances WHERE expires_at > NOW() ORDER BY instance_id`,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		instances []string
+		instance  string
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&instance}, func() error {
+		instances = append(instances, instance)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+func (s *PostgresNotificationStore) AcquireShardLeases(
+	ctx context.Context,
+	instanceID string,
+	shards []int,
+	ttl time.Duration,
+) ([]int, error) {
+	if len(shards) == 0 {
+		return nil, nil
+	}
+
+	var acquired []int
+	if err := s.txRunner(
+		ctx,
+		"AcquireShardLeases",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			acquired, err = s.acquireShardLeasesTx(ctx, tx, instanceID, shards, ttl)
+			return err
+		},
+		nil,
+		"instanceID", instanceID,
+		"numShards", len(shards),
+	); err != nil {
+		return nil, err
+	}
+	return acquired, nil
+}
+
+func (s *PostgresNotificationStore) acquireShardLeasesTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	instanceID string,
+	shards []int,
+	ttl time.Duration,
+) ([]int, error) {
+	rows, err := tx.Query(
+		ctx,
+		`INSERT INTO notification_shard_leases (shard, owner, expires_at)
+		SELECT shard, $1, NOW() + make_interval(secs => $3) FROM unnest($2::INT[]) AS shard
+		ON CONFLICT (shard) DO UPDATE SET owner = EXCLUDED.owner, expires_at = EXCLUDED.expires_at
+		WHERE notification_shard_leases.owner = EXCLUDED.owner OR notification_shard_leases.expires_at <= NOW()
+		RETURNING shard`,
+		instanceID,
+		shards,
+		ttl.Seconds(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		acquired []int
+		shard    int
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&shard}, func() error {
+		acquired = append(acquired, shard)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return acquired, nil
+}
+
+func (s *PostgresNotificationStore) ReleaseShardLeases(
+	ctx context.Context,
+	instanceID string,
+	shards []int,
+) error {
+	if len(shards) == 0 {
+		return nil
+	}
+
+	return s.txRunner(
+		ctx,
+		"ReleaseShardLeases",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				`DELETE FROM notification_shard_leases WHERE owner = $1 AND shard = ANY($2)`,
+				instanceID,
+				shards,
+			)
+			return err
+		},
+		nil,
+		"instanceID", instanceID,
+		"numShards", len(shards),
+	)
+}
+
+// checkShardLeaseTx returns an error if the lease is not held anymore. The lease row is locked until the
+// transaction ends to prevent that the lease is taken over by another instance in the meantime.
+func checkShardLeaseTx(ctx context.Context, tx pgx.Tx, lease *ShardLease) error {
+	var shard int
+	err := tx.QueryRow(
+		ctx,
+		`SELECT shard FROM notification_shard_leases WHERE shard = $1 AND owner = $2 AND expires_at > NOW() FOR SHARE`,
+		lease.Shard,
+		lease.Owner,
+	).Scan(&shard)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return RiverError(Err_UNAVAILABLE, "Shard lease not held").
+			Tag("shard", lease.Shard).
+			Tag("owner", lease.Owner)
+	}
+	return err
+}
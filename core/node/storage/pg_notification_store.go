@@ -130,6 +130,70 @@ type (
 			deviceToken []byte,
 			userID common.Address,
 		) error
+
+		GetFCMSubscriptions(
+			ctx context.Context,
+			userID common.Address,
+		) ([]*types.FCMPushSubscription, error)
+
+		// AddFCMSubscription does an upsert for the given userID and FCM registration token.
+		AddFCMSubscription(
+			ctx context.Context,
+			userID common.Address,
+			token string,
+			pushVersion NotificationPushVersion,
+		) error
+
+		RemoveFCMSubscription(
+			ctx context.Context,
+			token string,
+			userID common.Address,
+		) error
+
+		// SetQuietHours sets the quiet hours for the user, quiet hours are removed if quietHours is nil.
+		SetQuietHours(
+			ctx context.Context,
+			userID common.Address,
+			quietHours *types.QuietHoursSettings,
+		) error
+
+		// SetSnooze sets the snooze for the given space, or for all streams if spaceID is nil.
+		// The snooze is removed if snooze is nil.
+		SetSnooze(
+			ctx context.Context,
+			userID common.Address,
+			spaceID *shared.StreamId,
+			snooze *types.SnoozeSettings,
+		) error
+
+		// IncrementUnreadCounts increments the unread counter of the given channel for each user in users,
+		// the mentions counter is incremented for users that are mentioned. Counters are incremented once
+		// per event. It returns the total number of unread messages in all channels for each user.
+		// If lease is not nil the counters are only incremented when the shard lease is held.
+		IncrementUnreadCounts(
+			ctx context.Context,
+			lease *ShardLease,
+			channelID shared.StreamId,
+			eventHash common.Hash,
+			users map[common.Address]bool,
+		) (map[common.Address]int, error)
+
+		// ResetUnreadCounts removes the unread counters of the user for the given channels.
+		ResetUnreadCounts(
+			ctx context.Context,
+			userID common.Address,
+			channelIDs []shared.StreamId,
+		) error
+
+		// GetUnreadCounts returns the unread counters of the user for all channels with unread messages.
+		GetUnreadCounts(
+			ctx context.Context,
+			userID common.Address,
+		) ([]*types.UnreadCount, error)
+
+		// PruneUnreadCountEvents removes counted events that are too old to be replayed and returns
+		// the number of removed events.
+		PruneUnreadCountEvents(ctx context.Context) (int64, error)
 	}
 )
 
@@ -558,6 +622,18 @@ func (s *PostgresNotificationStore) getUserPreferencesTx(
 	if err != nil {
 		return nil, err
 	}
+	userPref.Subscriptions.FCMPush, err = s.getFCMSubscriptions(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	userPref.QuietHours, err = s.getQuietHoursTx(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	userPref.Snooze, userPref.SpaceSnoozes, err = s.getSnoozesTx(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	return userPref, nil
 }
@@ -868,6 +944,137 @@ func (s *PostgresNotificationStore) removeAPNSubscription(
 	return err
 }
 
+func (s *PostgresNotificationStore) GetFCMSubscriptions(
+	ctx context.Context,
+	userID common.Address,
+) ([]*types.FCMPushSubscription, error) {
+	var (
+		err  error
+		subs []*types.FCMPushSubscription
+	)
+
+	err = s.txRunner(
+		ctx,
+		"GetFCMSubscriptions",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			subs, err = s.getFCMSubscriptions(ctx, tx, userID)
+			return err
+		},
+		nil,
+	)
+
+	return subs, err
+}
+
+func (s *PostgresNotificationStore) getFCMSubscriptions(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID common.Address,
+) ([]*types.FCMPushSubscription, error) {
+	var subs []*types.FCMPushSubscription
+	rows, err := tx.Query(
+		ctx,
+		"select token, last_seen, push_version from fcmpushsubscriptions where user_id=$1",
+		hex.EncodeToString(userID[:]),
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return subs, nil
+		}
+		return nil, err
+	}
+
+	var (
+		token       string
+		lastSeen    time.Time
+		pushVersion int32
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&token, &lastSeen, &pushVersion}, func() error {
+		subs = append(subs, &types.FCMPushSubscription{
+			Token:       token,
+			LastSeen:    lastSeen,
+			PushVersion: NotificationPushVersion(pushVersion),
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+func (s *PostgresNotificationStore) AddFCMSubscription(
+	ctx context.Context,
+	userID common.Address,
+	token string,
+	pushVersion NotificationPushVersion,
+) error {
+	return s.txRunner(
+		ctx,
+		"AddFCMSubscription",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.addFCMSubscription(ctx, tx, token, userID, pushVersion)
+		},
+		nil,
+		"userID", userID,
+	)
+}
+
+func (s *PostgresNotificationStore) addFCMSubscription(
+	ctx context.Context,
+	tx pgx.Tx,
+	token string,
+	userID common.Address,
+	pushVersion NotificationPushVersion,
+) error {
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO fcmpushsubscriptions (token, user_id, last_seen, push_version) VALUES ($1, $2, NOW(), $3) ON CONFLICT (token) DO UPDATE SET user_id = $2, last_seen = NOW(), push_version = $3`,
+		token,
+		hex.EncodeToString(userID[:]),
+		int32(pushVersion),
+	)
+
+	return err
+}
+
+func (s *PostgresNotificationStore) RemoveFCMSubscription(
+	ctx context.Context,
+	token string,
+	userID common.Address,
+) error {
+	return s.txRunner(
+		ctx,
+		"RemoveFCMSubscription",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.removeFCMSubscription(ctx, tx, token, userID)
+		},
+		nil,
+		"userID", userID,
+	)
+}
+
+func (s *PostgresNotificationStore) removeFCMSubscription(
+	ctx context.Context,
+	tx pgx.Tx,
+	token string,
+	userID common.Address,
+) error {
+	result, err := tx.Exec(
+		ctx,
+		`DELETE FROM fcmpushsubscriptions where token=$1`,
+		token,
+	)
+
+	logging.FromCtx(ctx).Infow("remove FCM subscription",
+		"userID", userID, "records", result.RowsAffected(), "err", err)
+
+	return err
+}
+
 func (s *PostgresNotificationStore) SetDMChannelSetting(
 	ctx context.Context,
 	userID common.Address,
@@ -0,0 +1,244 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+// IncrementUnreadCounts increments the unread counter of the given channel for each user in users, the mentions
+// counter is incremented for users that are mentioned. Counters are incremented once per event, replayed events
+// are not counted again. It returns the total number of unread messages in all channels for each user.
+// If lease is not nil the counters are only incremented when the shard lease is held.
+func (s *PostgresNotificationStore) IncrementUnreadCounts(
+	ctx context.Context,
+	lease *ShardLease,
+	channelID shared.StreamId,
+	eventHash common.Hash,
+	users map[common.Address]bool,
+) (map[common.Address]int, error) {
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	var totals map[common.Address]int
+	if err := s.txRunner(
+		ctx,
+		"IncrementUnreadCounts",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if lease != nil {
+				if err := checkShardLeaseTx(ctx, tx, lease); err != nil {
+					return err
+				}
+			}
+			var err error
+			totals, err = s.incrementUnreadCountsTx(ctx, tx, channelID, eventHash, users)
+			return err
+		},
+		nil,
+		"channelID", channelID,
+		"event", eventHash,
+		"numUsers", len(users),
+	); err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+func (s *PostgresNotificationStore) incrementUnreadCountsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	channelID shared.StreamId,
+	eventHash common.Hash,
+	users map[common.Address]bool,
+) (map[common.Address]int, error) {
+	var (
+		batch   = &pgx.Batch{}
+		userIDs = make([]string, 0, len(users))
+	)
+	for user, mentioned := range users {
+		mentions := 0
+		if mentioned {
+			mentions = 1
+		}
+		userID := hex.EncodeToString(user[:])
+		userIDs = append(userIDs, userID)
+		batch.Queue(
+			`WITH counted AS (
+				INSERT INTO unreadcount_events (user_id, channel_id, event_hash) VALUES ($1, $2, $4)
+				ON CONFLICT DO NOTHING RETURNING user_id
+			)
+			INSERT INTO unreadcounts (user_id, channel_id, unread, mentions) SELECT $1, $2, 1, $3 FROM counted
+			ON CONFLICT (user_id, channel_id)
+			DO UPDATE SET unread = unreadcounts.unread + 1, mentions = unreadcounts.mentions + $3`,
+			userID,
+			channelID.String(),
+			mentions,
+			hex.EncodeToString(eventHash[:]),
+		)
+	}
+
+	br := tx.SendBatch(ctx, batch)
+	_, _ = br.Exec()
+	if err := br.Close(); err != nil { // returns the cause why br.Exec failed
+		return nil, err
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		`SELECT user_id, SUM(unread) FROM unreadcounts WHERE user_id = ANY($1) GROUP BY user_id`,
+		userIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		totals = make(map[common.Address]int, len(users))
+		userID string
+		total  int
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&userID, &total}, func() error {
+		totals[common.HexToAddress(userID)] = total
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+// unreadCountEventsRetention is how long counted events are remembered to ignore events that are replayed
+// after a restart or shard move.
+const unreadCountEventsRetention = 24 * time.Hour
+
+// ResetUnreadCounts removes the unread counters of the user for the given channels.
+func (s *PostgresNotificationStore) ResetUnreadCounts(
+	ctx context.Context,
+	userID common.Address,
+	channelIDs []shared.StreamId,
+) error {
+	if len(channelIDs) == 0 {
+		return nil
+	}
+
+	channels := make([]string, len(channelIDs))
+	for i, channelID := range channelIDs {
+		channels[i] = channelID.String()
+	}
+
+	return s.txRunner(
+		ctx,
+		"ResetUnreadCounts",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if _, err := tx.Exec(
+				ctx,
+				`DELETE FROM unreadcount_events WHERE user_id = $1 AND channel_id = ANY($2)
+				AND created_at < NOW() - make_interval(secs => $3)`,
+				hex.EncodeToString(userID[:]),
+				channels,
+				unreadCountEventsRetention.Seconds(),
+			); err != nil {
+				return err
+			}
+			_, err := tx.Exec(
+				ctx,
+				`DELETE FROM unreadcounts WHERE user_id = $1 AND channel_id = ANY($2)`,
+				hex.EncodeToString(userID[:]),
+				channels,
+			)
+			return err
+		},
+		nil,
+		"userID", userID,
+	)
+}
+
+// PruneUnreadCountEvents removes counted events that are older than the replay window.
+func (s *PostgresNotificationStore) PruneUnreadCountEvents(ctx context.Context) (int64, error) {
+	var pruned int64
+	err := s.txRunner(
+		ctx,
+		"PruneUnreadCountEvents",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			tag, err := tx.Exec(
+				ctx,
+				`DELETE FROM unreadcount_events WHERE created_at < NOW() - make_interval(secs => $1)`,
+				unreadCountEventsRetention.Seconds(),
+			)
+			if err != nil {
+				return err
+			}
+			pruned = tag.RowsAffected()
+			return nil
+		},
+		nil,
+	)
+	return pruned, err
+}
+
+// GetUnreadCounts returns the unread counters of the user for all channels with unread messages.
+func (s *PostgresNotificationStore) GetUnreadCounts(
+	ctx context.Context,
+	userID common.Address,
+) ([]*types.UnreadCount, error) {
+	var counts []*types.UnreadCount
+	if err := s.txRunner(
+		ctx,
+		"GetUnreadCounts",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			counts, err = s.getUnreadCountsTx(ctx, tx, userID)
+			return err
+		},
+		nil,
+		"userID", userID,
+	); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (s *PostgresNotificationStore) getUnreadCountsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID common.Address,
+) ([]*types.UnreadCount, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT channel_id, unread, mentions FROM unreadcounts WHERE user_id = $1 ORDER BY channel_id`,
+		hex.EncodeToString(userID[:]),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		counts    []*types.UnreadCount
+		channelID string
+		unread    int
+		mentions  int
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&channelID, &unread, &mentions}, func() error {
+		streamID, err := shared.StreamIdFromString(channelID)
+		if err != nil {
+			return err
+		}
+		counts = append(counts, &types.UnreadCount{ChannelID: streamID, Unread: unread, Mentions: mentions})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -17,71 +18,68 @@ func mbDataForNumb(n int64) []byte {
 }
 
 func TestArchive(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+		streamId1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
 
-	streamId1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		_, err := store.GetMaxArchivedMiniblockNumber(ctx, streamId1)
+		require.Error(err)
+		require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
 
-	_, err := pgStreamStore.GetMaxArchivedMiniblockNumber(ctx, streamId1)
-	require.Error(err)
-	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+		err = store.CreateStreamArchiveStorage(ctx, streamId1)
+		require.NoError(err)
 
-	err = pgStreamStore.CreateStreamArchiveStorage(ctx, streamId1)
-	require.NoError(err)
+		err = store.CreateStreamArchiveStorage(ctx, streamId1)
+		require.Error(err)
+		require.Equal(Err_ALREADY_EXISTS, AsRiverError(err).Code)
 
-	err = pgStreamStore.CreateStreamArchiveStorage(ctx, streamId1)
-	require.Error(err)
-	require.Equal(Err_ALREADY_EXISTS, AsRiverError(err).Code)
+		bn, err := store.GetMaxArchivedMiniblockNumber(ctx, streamId1)
+		require.NoError(err)
+		require.Equal(int64(-1), bn)
 
-	bn, err := pgStreamStore.GetMaxArchivedMiniblockNumber(ctx, streamId1)
-	require.NoError(err)
-	require.Equal(int64(-1), bn)
+		data := [][]byte{
+			mbDataForNumb(0),
+			mbDataForNumb(1),
+			mbDataForNumb(2),
+		}
 
-	data := [][]byte{
-		mbDataForNumb(0),
-		mbDataForNumb(1),
-		mbDataForNumb(2),
-	}
+		err = store.WriteArchiveMiniblocks(ctx, streamId1, 1, data)
+		require.Error(err)
 
-	err = pgStreamStore.WriteArchiveMiniblocks(ctx, streamId1, 1, data)
-	require.Error(err)
+		err = store.WriteArchiveMiniblocks(ctx, streamId1, 0, data)
+		require.NoError(err)
 
-	err = pgStreamStore.WriteArchiveMiniblocks(ctx, streamId1, 0, data)
-	require.NoError(err)
+		readMBs, err := store.ReadMiniblocks(ctx, streamId1, 0, 3)
+		require.NoError(err)
+		require.Len(readMBs, 3)
+		require.Equal(data, readMBs)
 
-	readMBs, err := pgStreamStore.ReadMiniblocks(ctx, streamId1, 0, 3)
-	require.NoError(err)
-	require.Len(readMBs, 3)
-	require.Equal(data, readMBs)
+		data2 := [][]byte{
+			mbDataForNumb(3),
+			mbDataForNumb(4),
+			mbDataForNumb(5),
+		}
 
-	data2 := [][]byte{
-		mbDataForNumb(3),
-		mbDataForNumb(4),
-		mbDataForNumb(5),
-	}
+		bn, err = store.GetMaxArchivedMiniblockNumber(ctx, streamId1)
+		require.NoError(err)
+		require.Equal(int64(2), bn)
 
-	bn, err = pgStreamStore.GetMaxArchivedMiniblockNumber(ctx, streamId1)
-	require.NoError(err)
-	require.Equal(int64(2), bn)
+		err = store.WriteArchiveMiniblocks(ctx, streamId1, 2, data2)
+		require.Error(err)
 
-	err = pgStreamStore.WriteArchiveMiniblocks(ctx, streamId1, 2, data2)
-	require.Error(err)
+		err = store.WriteArchiveMiniblocks(ctx, streamId1, 10, data2)
+		require.Error(err)
 
-	err = pgStreamStore.WriteArchiveMiniblocks(ctx, streamId1, 10, data2)
-	require.Error(err)
+		err = store.WriteArchiveMiniblocks(ctx, streamId1, 3, data2)
+		require.NoError(err)
 
-	err = pgStreamStore.WriteArchiveMiniblocks(ctx, streamId1, 3, data2)
-	require.NoError(err)
+		readMBs, err = store.ReadMiniblocks(ctx, streamId1, 0, 8)
+		require.NoError(err)
+		require.Equal(append(data, data2...), readMBs)
 
-	readMBs, err = pgStreamStore.ReadMiniblocks(ctx, streamId1, 0, 8)
-	require.NoError(err)
-	require.Equal(append(data, data2...), readMBs)
-
-	bn, err = pgStreamStore.GetMaxArchivedMiniblockNumber(ctx, streamId1)
-	require.NoError(err)
-	require.Equal(int64(5), bn)
+		bn, err = store.GetMaxArchivedMiniblockNumber(ctx, streamId1)
+		require.NoError(err)
+		require.Equal(int64(5), bn)
+	})
 }
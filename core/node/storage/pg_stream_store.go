@@ -37,9 +37,15 @@ type PostgresStreamStore struct {
 
 	//
 	esm *ephemeralStreamMonitor
+
+	// segments stores miniblocks offloaded from the database, nil if the cold tier is disabled.
+	segments SegmentBackend
 }
 
-var _ StreamStorage = (*PostgresStreamStore)(nil)
+var (
+	_ StreamStorage   = (*PostgresStreamStore)(nil)
+	_ ColdTierStorage = (*PostgresStreamStore)(nil)
+)
 
 //go:embed migrations/*.sql
 var migrationsDir embed.FS
@@ -984,12 +990,16 @@ func (s *PostgresStreamStore) readMiniblocksTx(
 	var blockdata []byte
 	var seq_num int
 
+	var firstSeqNum int64 = -1
 	if _, err := pgx.ForEachRow(miniblocksRow, []any{&blockdata, &seq_num}, func() error {
 		if (prevSeqNum != -1) && (seq_num != prevSeqNum+1) {
 			// There is a gap in sequence numbers
 			return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
 				Tag("ActualBlockNumber", seq_num).Tag("ExpectedBlockNumber", prevSeqNum+1).Tag("streamId", streamId)
 		}
+		if prevSeqNum == -1 {
+			firstSeqNum = int64(seq_num)
+		}
 		prevSeqNum = seq_num
 		miniblocks = append(miniblocks, blockdata)
 		return nil
@@ -997,9 +1007,113 @@ func (s *PostgresStreamStore) readMiniblocksTx(
 		return nil, err
 	}
 
+	// If the range doesn't start with the requested miniblock, the miniblocks are either
+	// offloaded into segments or the history was trimmed.
+	if firstSeqNum != fromInclusive && fromInclusive < toExclusive {
+		if s.segments != nil {
+			segmentMiniblocks, err := s.readSegmentMiniblocksTx(ctx, tx, streamId, fromInclusive, toExclusive)
+			if err != nil {
+				return nil, err
+			}
+			if len(segmentMiniblocks) > 0 {
+				if firstSeqNum != -1 && firstSeqNum != fromInclusive+int64(len(segmentMiniblocks)) {
+					return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+						Tag("ActualBlockNumber", firstSeqNum).
+						Tag("ExpectedBlockNumber", fromInclusive+int64(len(segmentMiniblocks))).
+						Tag("streamId", streamId)
+				}
+				return append(segmentMiniblocks, miniblocks...), nil
+			}
+		}
+
+		if err := s.checkMiniblocksNotTrimmedTx(ctx, tx, streamId, fromInclusive); err != nil {
+			return nil, err
+		}
+	}
+
 	return miniblocks, nil
 }
 
+// checkMiniblocksNotTrimmedTx returns Err_MINIBLOCKS_TRIMMED if the miniblock with the given number
+// was removed by TrimStream.
+func (s *PostgresStreamStore) checkMiniblocksNotTrimmedTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	miniblockNum int64,
+) error {
+	var firstAvailable int64
+	if err := tx.QueryRow(
+		ctx,
+		s.sqlForStream(
+			"SELECT seq_num FROM {{miniblocks}} WHERE stream_id = $1 ORDER BY seq_num LIMIT 1",
+			streamId,
+		),
+		streamId,
+	).Scan(&firstAvailable); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if miniblockNum < firstAvailable {
+		return RiverError(Err_MINIBLOCKS_TRIMMED, "Miniblocks trimmed, fetch from archive").
+			Tag("streamId", streamId).
+			Tag("miniblockNum", miniblockNum).
+			Tag("firstAvailableMiniblockNum", firstAvailable)
+	}
+	return nil
+}
+
+// TrimStream deletes miniblocks with numbers lower than trimBeforeMiniblockNum.
+func (s *PostgresStreamStore) TrimStream(
+	ctx context.Context,
+	streamId StreamId,
+	trimBeforeMiniblockNum int64,
+) error {
+	return s.txRunner(
+		ctx,
+		"TrimStream",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.trimStreamTx(ctx, tx, streamId, trimBeforeMiniblockNum)
+		},
+		nil,
+		"streamId", streamId,
+		"trimBeforeMiniblockNum", trimBeforeMiniblockNum,
+	)
+}
+
+func (s *PostgresStreamStore) trimStreamTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	trimBeforeMiniblockNum int64,
+) error {
+	lastSnapshotMiniblock, err := s.lockStream(ctx, tx, streamId, true)
+	if err != nil {
+		return err
+	}
+
+	if trimBeforeMiniblockNum > lastSnapshotMiniblock {
+		return RiverError(Err_INVALID_ARGUMENT, "Can't trim miniblocks after the latest snapshot").
+			Tag("lastSnapshotMiniblock", lastSnapshotMiniblock)
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		s.sqlForStream(
+			`DELETE FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num < $2;
+				DELETE FROM event_index WHERE stream_id = $1 AND miniblock_num < $2`,
+			streamId,
+		),
+		streamId,
+		trimBeforeMiniblockNum,
+	)
+	return err
+}
+
 // ReadMiniblocksByStream returns miniblocks data stream by the given stream ID.
 // It does not read data from the database, but returns a MiniblocksDataStream object that can be used to read miniblocks.
 // Client should call Close() on the returned MiniblocksDataStream object when done.
@@ -1030,6 +1144,15 @@ func (s *PostgresStreamStore) readMiniblocksByStreamTx(
 		return err
 	}
 
+	// offloaded miniblocks precede the miniblocks in the database
+	prevSeqNum := int64(-1)
+	if err := s.forEachSegmentMiniblockTx(ctx, tx, streamId, nil, func(blockdata []byte, seqNum int64) error {
+		prevSeqNum = seqNum
+		return onEachMb(blockdata, seqNum)
+	}); err != nil {
+		return err
+	}
+
 	rows, err := tx.Query(
 		ctx,
 		s.sqlForStream(
@@ -1042,7 +1165,6 @@ func (s *PostgresStreamStore) readMiniblocksByStreamTx(
 		return err
 	}
 
-	prevSeqNum := int64(-1)
 	var blockdata []byte
 	var seqNum int64
 	_, err = pgx.ForEachRow(rows, []any{&blockdata, &seqNum}, func() error {
@@ -1092,6 +1214,21 @@ func (s *PostgresStreamStore) readMiniblocksByIdsTx(
 		return err
 	}
 
+	// offloaded miniblocks precede the miniblocks in the database
+	if s.segments != nil {
+		ids := make(map[int64]struct{}, len(mbs))
+		for _, mb := range mbs {
+			ids[mb] = struct{}{}
+		}
+		include := func(seqNum int64) bool {
+			_, ok := ids[seqNum]
+			return ok
+		}
+		if err := s.forEachSegmentMiniblockTx(ctx, tx, streamId, include, onEachMb); err != nil {
+			return err
+		}
+	}
+
 	rows, err := tx.Query(
 		ctx,
 		s.sqlForStream(
@@ -1592,35 +1729,62 @@ func (s *PostgresStreamStore) getStreamsTx(ctx context.Context, tx pgx.Tx) ([]St
 }
 
 func (s *PostgresStreamStore) DeleteStream(ctx context.Context, streamId StreamId) error {
-	return s.txRunner(
+	var segmentNames []string
+	if err := s.txRunner(
 		ctx,
 		"DeleteStream",
 		pgx.ReadWrite,
 		func(ctx context.Context, tx pgx.Tx) error {
-			return s.deleteStreamTx(ctx, tx, streamId)
+			var err error
+			segmentNames, err = s.deleteStreamTx(ctx, tx, streamId)
+			return err
 		},
 		nil,
 		"streamId", streamId,
-	)
+	); err != nil {
+		return err
+	}
+
+	// segment files are only removed after their index entries are gone, so they are never read while missing
+	if s.segments != nil {
+		for _, name := range segmentNames {
+			if err := s.segments.DeleteSegment(ctx, name); err != nil {
+				logging.FromCtx(ctx).Warnw("Failed to delete miniblock segment",
+					"streamId", streamId, "segment", name, "error", err)
+			}
+		}
+	}
+	return nil
 }
 
-func (s *PostgresStreamStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, streamId StreamId) error {
+// deleteStreamTx deletes the stream and returns the names of its segment files that must be deleted
+// after the transaction is committed.
+func (s *PostgresStreamStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, streamId StreamId) ([]string, error) {
 	if _, err := s.lockStream(ctx, tx, streamId, true); err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err := tx.Exec(
+	segmentNames, err := s.deleteSegmentsTx(ctx, tx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(
 		ctx,
 		s.sqlForStream(
 			`DELETE from {{miniblocks}} WHERE stream_id = $1;
 				DELETE from {{minipools}} WHERE stream_id = $1;
 				DELETE from {{miniblock_candidates}} where stream_id = $1;
+				DELETE FROM event_index WHERE stream_id = $1;
 				DELETE FROM es WHERE stream_id = $1`,
 			streamId,
 		),
 		streamId,
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return segmentNames, nil
 }
 
 func DbSchemaNameFromAddress(address string) string {
@@ -1853,6 +2017,14 @@ func (s *PostgresStreamStore) debugReadStreamDataTx(
 		LatestSnapshotMiniblockNum: lastSnapshotMiniblock,
 	}
 
+	// offloaded miniblocks precede the miniblocks in the database
+	if err := s.forEachSegmentMiniblockTx(ctx, tx, streamId, nil, func(blockdata []byte, seqNum int64) error {
+		result.Miniblocks = append(result.Miniblocks, MiniblockDescriptor{MiniblockNumber: seqNum, Data: blockdata})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
 	miniblocksRow, err := tx.Query(
 		ctx,
 		s.sqlForStream(
@@ -3,11 +3,13 @@ package storage
 import (
 	"context"
 	"encoding/hex"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/pebble"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/rand"
@@ -86,7 +88,7 @@ func setupStreamStorageTest(t *testing.T) *testStreamStoreParams {
 
 func promoteMiniblockCandidate(
 	ctx context.Context,
-	pgStreamStore *PostgresStreamStore,
+	pgStreamStore StreamStorage,
 	streamId StreamId,
 	mbNum int64,
 	candidateBlockHash common.Hash,
@@ -221,104 +223,102 @@ func TestPostgresStreamStore(t *testing.T) {
 }
 
 func TestPromoteMiniblockCandidate(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
-	require := require.New(t)
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	streamId2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		streamId2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
 
-	prepareTestDataForAddEventConsistencyCheck(ctx, pgStreamStore, streamId)
+		prepareTestDataForAddEventConsistencyCheck(ctx, store, streamId)
 
-	candidateHash := common.BytesToHash([]byte("block_hash"))
-	candidateHash2 := common.BytesToHash([]byte("block_hash_2"))
-	candidateHash_block2 := common.BytesToHash([]byte("block_hash_block2"))
-	miniblock_bytes := []byte("miniblock_bytes")
+		candidateHash := common.BytesToHash([]byte("block_hash"))
+		candidateHash2 := common.BytesToHash([]byte("block_hash_2"))
+		candidateHash_block2 := common.BytesToHash([]byte("block_hash_block2"))
+		miniblock_bytes := []byte("miniblock_bytes")
 
-	// Miniblock candidate seq number must be at least current
-	err := pgStreamStore.WriteMiniblockCandidate(ctx, streamId, candidateHash, 0, miniblock_bytes)
-	require.True(IsRiverErrorCode(err, Err_MINIBLOCKS_STORAGE_FAILURE))
-	require.Equal(AsRiverError(err).GetTag("LastBlockInStorage"), int64(0))
-	require.Equal(AsRiverError(err).GetTag("CandidateBlockNumber"), int64(0))
+		// Miniblock candidate seq number must be at least current
+		err := store.WriteMiniblockCandidate(ctx, streamId, candidateHash, 0, miniblock_bytes)
+		require.True(IsRiverErrorCode(err, Err_MINIBLOCKS_STORAGE_FAILURE))
+		require.Equal(AsRiverError(err).GetTag("LastBlockInStorage"), int64(0))
+		require.Equal(AsRiverError(err).GetTag("CandidateBlockNumber"), int64(0))
 
-	// Future candidates fine
-	err = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, candidateHash_block2, 2, miniblock_bytes)
-	require.NoError(err)
+		// Future candidates fine
+		err = store.WriteMiniblockCandidate(ctx, streamId, candidateHash_block2, 2, miniblock_bytes)
+		require.NoError(err)
 
-	// Write two candidates for this block number
-	err = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, candidateHash, 1, miniblock_bytes)
-	require.NoError(err)
+		// Write two candidates for this block number
+		err = store.WriteMiniblockCandidate(ctx, streamId, candidateHash, 1, miniblock_bytes)
+		require.NoError(err)
 
-	err = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, candidateHash, 1, miniblock_bytes)
-	require.True(IsRiverErrorCode(err, Err_ALREADY_EXISTS))
+		err = store.WriteMiniblockCandidate(ctx, streamId, candidateHash, 1, miniblock_bytes)
+		require.True(IsRiverErrorCode(err, Err_ALREADY_EXISTS))
 
-	err = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, candidateHash2, 1, miniblock_bytes)
-	require.NoError(err)
+		err = store.WriteMiniblockCandidate(ctx, streamId, candidateHash2, 1, miniblock_bytes)
+		require.NoError(err)
 
-	// Add candidate from another stream. This candidate should be untouched by the delete when a
-	// candidate from the first stream is promoted.
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId2, genesisMiniblock)
-	err = pgStreamStore.WriteMiniblockCandidate(ctx, streamId2, candidateHash, 1, []byte("some bytes"))
-	require.NoError(err)
+		// Add candidate from another stream. This candidate should be untouched by the delete when a
+		// candidate from the first stream is promoted.
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId2, genesisMiniblock)
+		err = store.WriteMiniblockCandidate(ctx, streamId2, candidateHash, 1, []byte("some bytes"))
+		require.NoError(err)
 
-	var testEnvelopes [][]byte
-	testEnvelopes = append(testEnvelopes, []byte("event1"))
-	testEnvelopes = append(testEnvelopes, []byte("event2"))
+		var testEnvelopes [][]byte
+		testEnvelopes = append(testEnvelopes, []byte("event1"))
+		testEnvelopes = append(testEnvelopes, []byte("event2"))
 
-	// Nonexistent hash promotion fails
-	err = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		1,
-		common.BytesToHash([]byte("nonexistent_hash")),
-		false,
-		testEnvelopes,
-	)
-	require.Error(err)
-	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+		// Nonexistent hash promotion fails
+		err = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			1,
+			common.BytesToHash([]byte("nonexistent_hash")),
+			false,
+			testEnvelopes,
+		)
+		require.Error(err)
+		require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
 
-	// Stream 1 promotion succeeds.
-	err = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		1,
-		candidateHash,
-		false,
-		testEnvelopes,
-	)
-	require.NoError(err)
+		// Stream 1 promotion succeeds.
+		err = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			1,
+			candidateHash,
+			false,
+			testEnvelopes,
+		)
+		require.NoError(err)
 
-	// Stream 1 able to promote candidate block from round 2 - candidate unaffected by delete at round 1 promotion.
-	err = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		2,
-		candidateHash_block2,
-		false,
-		testEnvelopes,
-	)
-	require.NoError(err)
+		// Stream 1 able to promote candidate block from round 2 - candidate unaffected by delete at round 1 promotion.
+		err = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			2,
+			candidateHash_block2,
+			false,
+			testEnvelopes,
+		)
+		require.NoError(err)
 
-	// Stream 2 should be unaffected by stream 1 promotion, which deletes all candidates for stream 1 only.
-	err = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId2,
-		1,
-		candidateHash,
-		false,
-		testEnvelopes,
-	)
-	require.NoError(err)
+		// Stream 2 should be unaffected by stream 1 promotion, which deletes all candidates for stream 1 only.
+		err = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId2,
+			1,
+			candidateHash,
+			false,
+			testEnvelopes,
+		)
+		require.NoError(err)
+	})
 }
 
-func prepareTestDataForAddEventConsistencyCheck(ctx context.Context, s *PostgresStreamStore, streamId StreamId) {
+func prepareTestDataForAddEventConsistencyCheck(ctx context.Context, s StreamStorage, streamId StreamId) {
 	genesisMiniblock := []byte("genesisMiniblock")
 	_ = s.CreateStreamStorage(ctx, streamId, genesisMiniblock)
 	_ = s.WriteEvent(ctx, streamId, 1, 0, []byte("event1"))
@@ -326,241 +326,276 @@ func prepareTestDataForAddEventConsistencyCheck(ctx context.Context, s *Postgres
 	_ = s.WriteEvent(ctx, streamId, 1, 2, []byte("event3"))
 }
 
+// findPebbleMinipoolKey returns the key of the given minipool slot in the pebble store.
+func findPebbleMinipoolKey(t *testing.T, store *PebbleStreamStore, streamId StreamId, slot int64) []byte {
+	var found []byte
+	require.NoError(t, store.forEach(
+		pebbleStreamTableBounds(pebbleMinipoolPrefix, streamId),
+		func(key []byte, _ []byte) error {
+			if pebbleKeyNum(key, 1)-1 == slot {
+				found = slices.Clone(key)
+			}
+			return nil
+		},
+	))
+	require.NotNil(t, found, "minipool slot not found")
+	return found
+}
+
+// setMinipoolSlotGeneration corrupts the stream by moving the given minipool slot to another generation.
+func setMinipoolSlotGeneration(
+	t *testing.T,
+	ctx context.Context,
+	store StreamStorage,
+	streamId StreamId,
+	slot int64,
+	generation int64,
+) {
+	switch s := store.(type) {
+	case *PostgresStreamStore:
+		_, err := s.pool.Exec(
+			ctx,
+			s.sqlForStream("UPDATE {{minipools}} SET generation = $1 WHERE slot_num = $2", streamId),
+			generation,
+			slot,
+		)
+		require.NoError(t, err)
+	case *PebbleStreamStore:
+		key := findPebbleMinipoolKey(t, s, streamId, slot)
+		value, err := s.get(key)
+		require.NoError(t, err)
+		require.NoError(t, s.db.Delete(key, pebble.Sync))
+		require.NoError(t, s.db.Set(pebbleMinipoolKey(streamId, generation, slot), value, pebble.Sync))
+	default:
+		t.Fatalf("unsupported stream storage %T", store)
+	}
+}
+
+// deleteMinipoolSlot corrupts the stream by deleting the given minipool slot.
+func deleteMinipoolSlot(t *testing.T, ctx context.Context, store StreamStorage, streamId StreamId, slot int64) {
+	switch s := store.(type) {
+	case *PostgresStreamStore:
+		_, err := s.pool.Exec(ctx, s.sqlForStream("DELETE FROM {{minipools}} WHERE slot_num = $1", streamId), slot)
+		require.NoError(t, err)
+	case *PebbleStreamStore:
+		require.NoError(t, s.db.Delete(findPebbleMinipoolKey(t, s, streamId, slot), pebble.Sync))
+	default:
+		t.Fatalf("unsupported stream storage %T", store)
+	}
+}
+
+// deleteMiniblocks corrupts the stream by deleting the given miniblocks, or all miniblocks if none are given.
+func deleteMiniblocks(t *testing.T, ctx context.Context, store StreamStorage, streamId StreamId, seqNums ...int64) {
+	switch s := store.(type) {
+	case *PostgresStreamStore:
+		var err error
+		if len(seqNums) == 0 {
+			_, err = s.pool.Exec(ctx, s.sqlForStream("DELETE FROM {{miniblocks}} WHERE stream_id = $1", streamId), streamId)
+		} else {
+			_, err = s.pool.Exec(
+				ctx,
+				s.sqlForStream("DELETE FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num = ANY($2)", streamId),
+				streamId,
+				seqNums,
+			)
+		}
+		require.NoError(t, err)
+	case *PebbleStreamStore:
+		if len(seqNums) == 0 {
+			bounds := pebbleStreamTableBounds(pebbleMiniblockPrefix, streamId)
+			require.NoError(t, s.db.DeleteRange(bounds.LowerBound, bounds.UpperBound, pebble.Sync))
+		}
+		for _, seqNum := range seqNums {
+			require.NoError(t, s.db.Delete(pebbleMiniblockKey(streamId, seqNum), pebble.Sync))
+		}
+	default:
+		t.Fatalf("unsupported stream storage %T", store)
+	}
+}
+
 // Test that if there is an event with wrong generation in minipool, we will get error
 func TestAddEventConsistencyChecksImproperGeneration(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
 
-	prepareTestDataForAddEventConsistencyCheck(ctx, pgStreamStore, streamId)
+		prepareTestDataForAddEventConsistencyCheck(ctx, store, streamId)
 
-	// Corrupt record in minipool
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"UPDATE {{minipools}} SET generation = 777 WHERE slot_num = 1",
-			streamId,
-		),
-	)
-	err := pgStreamStore.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
+		// Corrupt record in minipool
+		setMinipoolSlotGeneration(t, ctx, store, streamId, 1, 777)
+		err := store.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
 
-	require.NotNil(err)
-	require.Contains(err.Error(), "Wrong slot number in minipool")
-	require.Equal(AsRiverError(err).GetTag("ActualSlotNumber"), 2)
-	require.Equal(AsRiverError(err).GetTag("ExpectedSlotNumber"), 1)
+		require.NotNil(err)
+		require.Contains(err.Error(), "Wrong slot number in minipool")
+		require.Equal(AsRiverError(err).GetTag("ActualSlotNumber"), 2)
+		require.Equal(AsRiverError(err).GetTag("ExpectedSlotNumber"), 1)
+	})
 }
 
 // Test that if there is a gap in minipool records, we will get error
 func TestAddEventConsistencyChecksGaps(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
 
-	prepareTestDataForAddEventConsistencyCheck(ctx, pgStreamStore, streamId)
+		prepareTestDataForAddEventConsistencyCheck(ctx, store, streamId)
 
-	// Corrupt record in minipool
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{minipools}} WHERE slot_num = 1",
-			streamId,
-		),
-	)
-	err := pgStreamStore.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
+		// Corrupt record in minipool
+		deleteMinipoolSlot(t, ctx, store, streamId, 1)
+		err := store.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
 
-	require.NotNil(err)
-	require.Contains(err.Error(), "Wrong slot number in minipool")
-	require.Equal(AsRiverError(err).GetTag("ActualSlotNumber"), 2)
-	require.Equal(AsRiverError(err).GetTag("ExpectedSlotNumber"), 1)
+		require.NotNil(err)
+		require.Contains(err.Error(), "Wrong slot number in minipool")
+		require.Equal(AsRiverError(err).GetTag("ActualSlotNumber"), 2)
+		require.Equal(AsRiverError(err).GetTag("ExpectedSlotNumber"), 1)
+	})
 }
 
 // Test that if there is a wrong number minipool records, we will get error
 func TestAddEventConsistencyChecksEventsNumberMismatch(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
 
-	prepareTestDataForAddEventConsistencyCheck(ctx, pgStreamStore, streamId)
+		prepareTestDataForAddEventConsistencyCheck(ctx, store, streamId)
 
-	// Corrupt record in minipool
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{minipools}} WHERE slot_num = 2",
-			streamId,
-		),
-	)
-	err := pgStreamStore.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
+		// Corrupt record in minipool
+		deleteMinipoolSlot(t, ctx, store, streamId, 2)
+		err := store.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
 
-	require.NotNil(err)
-	require.Contains(err.Error(), "Wrong number of records in minipool")
-	require.Equal(AsRiverError(err).GetTag("ActualRecordsNumber"), 2)
-	require.Equal(AsRiverError(err).GetTag("ExpectedRecordsNumber"), 3)
+		require.NotNil(err)
+		require.Contains(err.Error(), "Wrong number of records in minipool")
+		require.Equal(AsRiverError(err).GetTag("ActualRecordsNumber"), 2)
+		require.Equal(AsRiverError(err).GetTag("ExpectedRecordsNumber"), 3)
+	})
 }
 
 func TestNoStream(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
-
-	res, err := pgStreamStore.ReadStreamFromLastSnapshot(ctx, testutils.FakeStreamId(STREAM_CHANNEL_BIN), 0)
-	require.Nil(res)
-	require.Error(err)
-	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code, err)
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
+
+		res, err := store.ReadStreamFromLastSnapshot(ctx, testutils.FakeStreamId(STREAM_CHANNEL_BIN), 0)
+		require.Nil(res)
+		require.Error(err)
+		require.Equal(Err_NOT_FOUND, AsRiverError(err).Code, err)
+	})
 }
 
 func TestCreateBlockProposalConsistencyChecksProperNewMinipoolGeneration(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
 
-	var testEnvelopes1 [][]byte
-	testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
-	var testEnvelopes2 [][]byte
-	testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
+		var testEnvelopes1 [][]byte
+		testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
+		var testEnvelopes2 [][]byte
+		testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
 
-	blockHash1 := common.BytesToHash([]byte("hash1"))
-	blockHash2 := common.BytesToHash([]byte("hash2"))
-	blockHash3 := common.BytesToHash([]byte("hash3"))
-	_ = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, blockHash1, 1, []byte("block1"))
-	_ = promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 1, blockHash1, true, testEnvelopes1)
+		blockHash1 := common.BytesToHash([]byte("hash1"))
+		blockHash2 := common.BytesToHash([]byte("hash2"))
+		blockHash3 := common.BytesToHash([]byte("hash3"))
+		_ = store.WriteMiniblockCandidate(ctx, streamId, blockHash1, 1, []byte("block1"))
+		_ = promoteMiniblockCandidate(ctx, store, streamId, 1, blockHash1, true, testEnvelopes1)
 
-	_ = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, blockHash2, 2, []byte("block2"))
-	_ = promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 2, blockHash2, false, testEnvelopes2)
+		_ = store.WriteMiniblockCandidate(ctx, streamId, blockHash2, 2, []byte("block2"))
+		_ = promoteMiniblockCandidate(ctx, store, streamId, 2, blockHash2, false, testEnvelopes2)
 
-	_, _ = pgStreamStore.pool.Exec(ctx, "DELETE FROM miniblocks WHERE seq_num = 2")
+		deleteMiniblocks(t, ctx, store, streamId, 2)
 
-	// Future candidate writes are fine, these may come from other nodes.
-	err := pgStreamStore.WriteMiniblockCandidate(ctx, streamId, blockHash3, 3, []byte("block3"))
-	require.Nil(err)
+		// Future candidate writes are fine, these may come from other nodes.
+		err := store.WriteMiniblockCandidate(ctx, streamId, blockHash3, 3, []byte("block3"))
+		require.Nil(err)
+	})
 }
 
 func TestPromoteBlockConsistencyChecksProperNewMinipoolGeneration(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
-
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
-
-	var testEnvelopes1 [][]byte
-	testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
-	var testEnvelopes2 [][]byte
-	testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
-	var testEnvelopes3 [][]byte
-	testEnvelopes3 = append(testEnvelopes3, []byte("event3"))
-
-	blockHash1 := common.BytesToHash([]byte("hash1"))
-	blockHash2 := common.BytesToHash([]byte("hash2"))
-	blockHash3 := common.BytesToHash([]byte("hash3"))
-	_ = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, blockHash1, 1, []byte("block1"))
-	_ = promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 1, blockHash1, true, testEnvelopes1)
-
-	_ = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, blockHash2, 2, []byte("block2"))
-	_ = promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 2, blockHash2, false, testEnvelopes2)
-
-	_ = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, blockHash3, 3, []byte("block3"))
-
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{miniblocks}} WHERE seq_num = 2",
-			streamId,
-		),
-	)
-	err := promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 3, blockHash3, false, testEnvelopes3)
-
-	// TODO(crystal): tune these
-	require.NotNil(err)
-	require.Contains(err.Error(), "DB data consistency check failed: Previous minipool generation mismatch")
-	require.Equal(AsRiverError(err).GetTag("lastMbInStorage"), int64(1))
-	require.Equal(AsRiverError(err).GetTag("lastMiniblockNumber"), int64(3))
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
+
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+
+		var testEnvelopes1 [][]byte
+		testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
+		var testEnvelopes2 [][]byte
+		testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
+		var testEnvelopes3 [][]byte
+		testEnvelopes3 = append(testEnvelopes3, []byte("event3"))
+
+		blockHash1 := common.BytesToHash([]byte("hash1"))
+		blockHash2 := common.BytesToHash([]byte("hash2"))
+		blockHash3 := common.BytesToHash([]byte("hash3"))
+		_ = store.WriteMiniblockCandidate(ctx, streamId, blockHash1, 1, []byte("block1"))
+		_ = promoteMiniblockCandidate(ctx, store, streamId, 1, blockHash1, true, testEnvelopes1)
+
+		_ = store.WriteMiniblockCandidate(ctx, streamId, blockHash2, 2, []byte("block2"))
+		_ = promoteMiniblockCandidate(ctx, store, streamId, 2, blockHash2, false, testEnvelopes2)
+
+		_ = store.WriteMiniblockCandidate(ctx, streamId, blockHash3, 3, []byte("block3"))
+
+		deleteMiniblocks(t, ctx, store, streamId, 2)
+		err := promoteMiniblockCandidate(ctx, store, streamId, 3, blockHash3, false, testEnvelopes3)
+
+		// TODO(crystal): tune these
+		require.NotNil(err)
+		require.Contains(err.Error(), "DB data consistency check failed: Previous minipool generation mismatch")
+		require.Equal(AsRiverError(err).GetTag("lastMbInStorage"), int64(1))
+		require.Equal(AsRiverError(err).GetTag("lastMiniblockNumber"), int64(3))
+	})
 }
 
 func TestCreateBlockProposalNoSuchStreamError(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
 
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{miniblocks}}",
-			streamId,
-		),
-	)
+		deleteMiniblocks(t, ctx, store, streamId)
 
-	err := pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("block_hash")),
-		1,
-		[]byte("block1"),
-	)
+		err := store.WriteMiniblockCandidate(
+			ctx,
+			streamId,
+			common.BytesToHash([]byte("block_hash")),
+			1,
+			[]byte("block1"),
+		)
 
-	require.NotNil(err)
-	require.Contains(err.Error(), "No blocks for the stream found in block storage")
-	require.Equal(AsRiverError(err).GetTag("streamId"), streamId)
+		require.NotNil(err)
+		require.Contains(err.Error(), "No blocks for the stream found in block storage")
+		require.Equal(AsRiverError(err).GetTag("streamId"), streamId)
+	})
 }
 
 func TestPromoteBlockNoSuchStreamError(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
 
-	var testEnvelopes1 [][]byte
-	testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
-	block_hash := common.BytesToHash([]byte("block_hash"))
-	_ = pgStreamStore.WriteMiniblockCandidate(ctx, streamId, block_hash, 1, []byte("block1"))
+		var testEnvelopes1 [][]byte
+		testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
+		block_hash := common.BytesToHash([]byte("block_hash"))
+		_ = store.WriteMiniblockCandidate(ctx, streamId, block_hash, 1, []byte("block1"))
 
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{miniblocks}}",
-			streamId,
-		),
-	)
+		deleteMiniblocks(t, ctx, store, streamId)
 
-	err := promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 1, block_hash, true, testEnvelopes1)
+		err := promoteMiniblockCandidate(ctx, store, streamId, 1, block_hash, true, testEnvelopes1)
 
-	require.NotNil(err)
-	require.Contains(err.Error(), "No blocks for the stream found in block storage")
-	require.Equal(AsRiverError(err).GetTag("streamId"), streamId)
+		require.NotNil(err)
+		require.Contains(err.Error(), "No blocks for the stream found in block storage")
+		require.Equal(AsRiverError(err).GetTag("streamId"), streamId)
+	})
 }
 
 func TestExitIfSecondStorageCreated(t *testing.T) {
@@ -624,396 +659,352 @@ func TestExitIfSecondStorageCreated(t *testing.T) {
 
 // Test that if there is a gap in miniblocks sequence, we will get error
 func TestGetStreamFromLastSnapshotConsistencyChecksMissingBlockFailure(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
-
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
-	var testEnvelopes1 [][]byte
-	testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
-	var testEnvelopes2 [][]byte
-	testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
-	var testEnvelopes3 [][]byte
-	testEnvelopes3 = append(testEnvelopes3, []byte("event3"))
-
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash1")),
-		1,
-		[]byte("block1"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		1,
-		common.BytesToHash([]byte("blockhash1")),
-		true,
-		testEnvelopes1,
-	)
-
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash2")),
-		2,
-		[]byte("block2"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		2,
-		common.BytesToHash([]byte("blockhash2")),
-		false,
-		testEnvelopes2,
-	)
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
+
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		var testEnvelopes1 [][]byte
+		testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
+		var testEnvelopes2 [][]byte
+		testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
+		var testEnvelopes3 [][]byte
+		testEnvelopes3 = append(testEnvelopes3, []byte("event3"))
+
+		_ = store.WriteMiniblockCandidate(
+			ctx,
+			streamId,
+			common.BytesToHash([]byte("blockhash1")),
+			1,
+			[]byte("block1"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			1,
+			common.BytesToHash([]byte("blockhash1")),
+			true,
+			testEnvelopes1,
+		)
 
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash3")),
-		3,
-		[]byte("block3"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		3,
-		common.BytesToHash([]byte("blockhash3")),
-		false,
-		testEnvelopes3,
-	)
+		_ = store.WriteMiniblockCandidate(
+			ctx,
+			streamId,
+			common.BytesToHash([]byte("blockhash2")),
+			2,
+			[]byte("block2"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			2,
+			common.BytesToHash([]byte("blockhash2")),
+			false,
+			testEnvelopes2,
+		)
 
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{miniblocks}} WHERE seq_num = 2",
+		_ = store.WriteMiniblockCandidate(
+			ctx,
 			streamId,
-		),
-	)
+			common.BytesToHash([]byte("blockhash3")),
+			3,
+			[]byte("block3"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			3,
+			common.BytesToHash([]byte("blockhash3")),
+			false,
+			testEnvelopes3,
+		)
+
+		deleteMiniblocks(t, ctx, store, streamId, 2)
 
-	_, err := pgStreamStore.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+		_, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
 
-	require.NotNil(err)
-	require.EqualValues(Err_INTERNAL, AsRiverError(err).Code)
-	require.Equal(AsRiverError(err).GetTag("ActualSeqNum"), int64(3))
-	require.Equal(AsRiverError(err).GetTag("ExpectedSeqNum"), int64(2))
+		require.NotNil(err)
+		require.EqualValues(Err_INTERNAL, AsRiverError(err).Code)
+		require.Equal(AsRiverError(err).GetTag("ActualSeqNum"), int64(3))
+		require.Equal(AsRiverError(err).GetTag("ExpectedSeqNum"), int64(2))
+	})
 }
 
 func TestGetStreamFromLastSnapshotConsistencyCheckWrongEnvelopeGeneration(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
 
-	var testEnvelopes1 [][]byte
-	testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
+		var testEnvelopes1 [][]byte
+		testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
 
-	var testEnvelopes2 [][]byte
-	testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
-	testEnvelopes2 = append(testEnvelopes2, []byte("event3"))
+		var testEnvelopes2 [][]byte
+		testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
+		testEnvelopes2 = append(testEnvelopes2, []byte("event3"))
 
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash1")),
-		1,
-		[]byte("block1"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		1,
-		common.BytesToHash([]byte("blockhash1")),
-		true,
-		testEnvelopes1,
-	)
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash2")),
-		2,
-		[]byte("block2"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		2,
-		common.BytesToHash([]byte("blockhash2")),
-		false,
-		testEnvelopes2,
-	)
-
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"UPDATE {{minipools}} SET generation = 777 WHERE slot_num = 1",
+		_ = store.WriteMiniblockCandidate(
+			ctx,
 			streamId,
-		),
-	)
+			common.BytesToHash([]byte("blockhash1")),
+			1,
+			[]byte("block1"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			1,
+			common.BytesToHash([]byte("blockhash1")),
+			true,
+			testEnvelopes1,
+		)
+		_ = store.WriteMiniblockCandidate(
+			ctx,
+			streamId,
+			common.BytesToHash([]byte("blockhash2")),
+			2,
+			[]byte("block2"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			2,
+			common.BytesToHash([]byte("blockhash2")),
+			false,
+			testEnvelopes2,
+		)
+
+		setMinipoolSlotGeneration(t, ctx, store, streamId, 1, 777)
 
-	_, err := pgStreamStore.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+		_, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
 
-	require.NotNil(err)
-	require.EqualValues(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+		require.NotNil(err)
+		require.EqualValues(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+	})
 }
 
 func TestGetStreamFromLastSnapshotConsistencyCheckNoZeroIndexEnvelope(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
-
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	var testEnvelopes1 [][]byte
-	testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
 
-	var testEnvelopes2 [][]byte
-	testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
-	testEnvelopes2 = append(testEnvelopes2, []byte("event3"))
-	testEnvelopes2 = append(testEnvelopes2, []byte("event4"))
+		var testEnvelopes1 [][]byte
+		testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
 
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash1")),
-		1,
-		[]byte("block1"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		1,
-		common.BytesToHash([]byte("blockhash1")),
-		true,
-		testEnvelopes1,
-	)
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash2")),
-		2,
-		[]byte("block2"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		2,
-		common.BytesToHash([]byte("blockhash2")),
-		false,
-		testEnvelopes2,
-	)
+		var testEnvelopes2 [][]byte
+		testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
+		testEnvelopes2 = append(testEnvelopes2, []byte("event3"))
+		testEnvelopes2 = append(testEnvelopes2, []byte("event4"))
 
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{minipools}} WHERE slot_num = 0",
+		_ = store.WriteMiniblockCandidate(
+			ctx,
 			streamId,
-		),
-	)
+			common.BytesToHash([]byte("blockhash1")),
+			1,
+			[]byte("block1"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			1,
+			common.BytesToHash([]byte("blockhash1")),
+			true,
+			testEnvelopes1,
+		)
+		_ = store.WriteMiniblockCandidate(
+			ctx,
+			streamId,
+			common.BytesToHash([]byte("blockhash2")),
+			2,
+			[]byte("block2"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			2,
+			common.BytesToHash([]byte("blockhash2")),
+			false,
+			testEnvelopes2,
+		)
 
-	_, err := pgStreamStore.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+		deleteMinipoolSlot(t, ctx, store, streamId, 0)
 
-	require.NotNil(err)
-	require.Contains(err.Error(), "Minipool consistency violation - slotNums are not sequential")
+		_, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+
+		require.NotNil(err)
+		require.Contains(err.Error(), "Minipool consistency violation - slotNums are not sequential")
+	})
 }
 
 func TestGetStreamFromLastSnapshotConsistencyCheckGapInEnvelopesIndexes(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
 
-	var testEnvelopes1 [][]byte
-	testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
+		var testEnvelopes1 [][]byte
+		testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
 
-	var testEnvelopes2 [][]byte
-	testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
-	testEnvelopes2 = append(testEnvelopes2, []byte("event3"))
-	testEnvelopes2 = append(testEnvelopes2, []byte("event4"))
+		var testEnvelopes2 [][]byte
+		testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
+		testEnvelopes2 = append(testEnvelopes2, []byte("event3"))
+		testEnvelopes2 = append(testEnvelopes2, []byte("event4"))
 
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash1")),
-		1,
-		[]byte("block1"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		1,
-		common.BytesToHash([]byte("blockhash1")),
-		true,
-		testEnvelopes1,
-	)
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash2")),
-		2,
-		[]byte("block2"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		2,
-		common.BytesToHash([]byte("blockhash2")),
-		false,
-		testEnvelopes2,
-	)
-
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{minipools}} WHERE slot_num = 1",
+		_ = store.WriteMiniblockCandidate(
+			ctx,
 			streamId,
-		),
-	)
+			common.BytesToHash([]byte("blockhash1")),
+			1,
+			[]byte("block1"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			1,
+			common.BytesToHash([]byte("blockhash1")),
+			true,
+			testEnvelopes1,
+		)
+		_ = store.WriteMiniblockCandidate(
+			ctx,
+			streamId,
+			common.BytesToHash([]byte("blockhash2")),
+			2,
+			[]byte("block2"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			2,
+			common.BytesToHash([]byte("blockhash2")),
+			false,
+			testEnvelopes2,
+		)
 
-	_, err := pgStreamStore.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+		deleteMinipoolSlot(t, ctx, store, streamId, 1)
 
-	require.NotNil(err)
-	require.EqualValues(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+		_, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+
+		require.NotNil(err)
+		require.EqualValues(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+	})
 }
 
 func TestGetMiniblocksConsistencyChecks(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	_ = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		_ = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
 
-	var testEnvelopes1 [][]byte
-	testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
-	var testEnvelopes2 [][]byte
-	testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
-	var testEnvelopes3 [][]byte
-	testEnvelopes3 = append(testEnvelopes3, []byte("event3"))
+		var testEnvelopes1 [][]byte
+		testEnvelopes1 = append(testEnvelopes1, []byte("event1"))
+		var testEnvelopes2 [][]byte
+		testEnvelopes2 = append(testEnvelopes2, []byte("event2"))
+		var testEnvelopes3 [][]byte
+		testEnvelopes3 = append(testEnvelopes3, []byte("event3"))
 
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash1")),
-		1,
-		[]byte("block1"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		1,
-		common.BytesToHash([]byte("blockhash1")),
-		true,
-		testEnvelopes1,
-	)
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash2")),
-		2,
-		[]byte("block2"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		2,
-		common.BytesToHash([]byte("blockhash2")),
-		false,
-		testEnvelopes2,
-	)
-	_ = pgStreamStore.WriteMiniblockCandidate(
-		ctx,
-		streamId,
-		common.BytesToHash([]byte("blockhash3")),
-		3,
-		[]byte("block3"),
-	)
-	_ = promoteMiniblockCandidate(
-		ctx,
-		pgStreamStore,
-		streamId,
-		3,
-		common.BytesToHash([]byte("blockhash3")),
-		false,
-		testEnvelopes3,
-	)
-
-	_, _ = pgStreamStore.pool.Exec(
-		ctx,
-		pgStreamStore.sqlForStream(
-			"DELETE FROM {{miniblocks}} WHERE seq_num = 2",
+		_ = store.WriteMiniblockCandidate(
+			ctx,
 			streamId,
-		),
-	)
+			common.BytesToHash([]byte("blockhash1")),
+			1,
+			[]byte("block1"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			1,
+			common.BytesToHash([]byte("blockhash1")),
+			true,
+			testEnvelopes1,
+		)
+		_ = store.WriteMiniblockCandidate(
+			ctx,
+			streamId,
+			common.BytesToHash([]byte("blockhash2")),
+			2,
+			[]byte("block2"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			2,
+			common.BytesToHash([]byte("blockhash2")),
+			false,
+			testEnvelopes2,
+		)
+		_ = store.WriteMiniblockCandidate(
+			ctx,
+			streamId,
+			common.BytesToHash([]byte("blockhash3")),
+			3,
+			[]byte("block3"),
+		)
+		_ = promoteMiniblockCandidate(
+			ctx,
+			store,
+			streamId,
+			3,
+			common.BytesToHash([]byte("blockhash3")),
+			false,
+			testEnvelopes3,
+		)
+
+		deleteMiniblocks(t, ctx, store, streamId, 2)
 
-	_, err := pgStreamStore.ReadMiniblocks(ctx, streamId, 1, 4)
+		_, err := store.ReadMiniblocks(ctx, streamId, 1, 4)
 
-	require.NotNil(err)
-	require.Contains(err.Error(), "Miniblocks consistency violation")
-	require.Equal(AsRiverError(err).GetTag("ActualBlockNumber"), 3)
-	require.Equal(AsRiverError(err).GetTag("ExpectedBlockNumber"), 2)
+		require.NotNil(err)
+		require.Contains(err.Error(), "Miniblocks consistency violation")
+		require.Equal(AsRiverError(err).GetTag("ActualBlockNumber"), 3)
+		require.Equal(AsRiverError(err).GetTag("ExpectedBlockNumber"), 2)
+	})
 }
 
 func TestAlreadyExists(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	genesisMiniblock := []byte("genesisMiniblock")
-	err := pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
-	require.NoError(err)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		genesisMiniblock := []byte("genesisMiniblock")
+		err := store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		require.NoError(err)
 
-	err = pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock)
-	require.Equal(Err_ALREADY_EXISTS, AsRiverError(err).Code)
+		err = store.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+		require.Equal(Err_ALREADY_EXISTS, AsRiverError(err).Code)
+	})
 }
 
 func TestNotFound(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
-
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-	result, err := pgStreamStore.ReadStreamFromLastSnapshot(ctx, streamId, 0)
-	require.Nil(result)
-	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
+
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		result, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+		require.Nil(result)
+		require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+	})
 }
 
 type dataMaker rand.Rand
@@ -1071,75 +1062,114 @@ func requireSnapshotResult(
 }
 
 func TestReadStreamFromLastSnapshot(t *testing.T) {
-	params := setupStreamStorageTest(t)
-	require := require.New(t)
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	ctx := params.ctx
-	pgStreamStore := params.pgStreamStore
-	defer params.closer()
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
 
-	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
-
-	dataMaker := newDataMaker()
+		dataMaker := newDataMaker()
 
-	var store StreamStorage = pgStreamStore
+		genMB, _ := dataMaker.mb()
+		mbs := [][]byte{genMB}
+		require.NoError(store.CreateStreamStorage(ctx, streamId, genMB))
 
-	genMB, _ := dataMaker.mb()
-	mbs := [][]byte{genMB}
-	require.NoError(store.CreateStreamStorage(ctx, streamId, genMB))
+		mb1, h1 := dataMaker.mb()
+		mbs = append(mbs, mb1)
+		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, h1, 1, mb1))
 
-	mb1, h1 := dataMaker.mb()
-	mbs = append(mbs, mb1)
-	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, h1, 1, mb1))
+		mb1read, err := store.ReadMiniblockCandidate(ctx, streamId, h1, 1)
+		require.NoError(err)
+		require.EqualValues(mb1, mb1read)
 
-	mb1read, err := store.ReadMiniblockCandidate(ctx, streamId, h1, 1)
-	require.NoError(err)
-	require.EqualValues(mb1, mb1read)
+		eventPool1 := dataMaker.events(5)
+		require.NoError(promoteMiniblockCandidate(ctx, store, streamId, 1, h1, false, eventPool1))
 
-	eventPool1 := dataMaker.events(5)
-	require.NoError(promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 1, h1, false, eventPool1))
+		streamData, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 10)
+		require.NoError(err)
+		requireSnapshotResult(t, streamData, 0, 0, mbs, eventPool1)
 
-	streamData, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 10)
-	require.NoError(err)
-	requireSnapshotResult(t, streamData, 0, 0, mbs, eventPool1)
+		mb2, h2 := dataMaker.mb()
+		mbs = append(mbs, mb2)
+		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, h2, 2, mb2))
 
-	mb2, h2 := dataMaker.mb()
-	mbs = append(mbs, mb2)
-	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, h2, 2, mb2))
+		mb2read, err := store.ReadMiniblockCandidate(ctx, streamId, h2, 2)
+		require.NoError(err)
+		require.EqualValues(mb2, mb2read)
 
-	mb2read, err := store.ReadMiniblockCandidate(ctx, streamId, h2, 2)
-	require.NoError(err)
-	require.EqualValues(mb2, mb2read)
+		eventPool2 := dataMaker.events(5)
+		require.NoError(promoteMiniblockCandidate(ctx, store, streamId, 2, h2, true, eventPool2))
 
-	eventPool2 := dataMaker.events(5)
-	require.NoError(promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 2, h2, true, eventPool2))
+		streamData, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 10)
+		require.NoError(err)
+		requireSnapshotResult(t, streamData, 0, 2, mbs, eventPool2)
+
+		var lastEvents [][]byte
+		for i := range 12 {
+			mb, h := dataMaker.mb()
+			mbs = append(mbs, mb)
+			require.NoError(store.WriteMiniblockCandidate(ctx, streamId, h, 3+int64(i), mb))
+			lastEvents = dataMaker.events(5)
+			require.NoError(promoteMiniblockCandidate(ctx, store, streamId, 3+int64(i), h, false, lastEvents))
+		}
 
-	streamData, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 10)
-	require.NoError(err)
-	requireSnapshotResult(t, streamData, 0, 2, mbs, eventPool2)
+		streamData, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 14)
+		require.NoError(err)
+		requireSnapshotResult(t, streamData, 1, 1, mbs[1:], lastEvents)
 
-	var lastEvents [][]byte
-	for i := range 12 {
 		mb, h := dataMaker.mb()
 		mbs = append(mbs, mb)
-		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, h, 3+int64(i), mb))
+		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, h, 15, mb))
 		lastEvents = dataMaker.events(5)
-		require.NoError(promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 3+int64(i), h, false, lastEvents))
-	}
+		require.NoError(promoteMiniblockCandidate(ctx, store, streamId, 15, h, true, lastEvents))
 
-	streamData, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 14)
-	require.NoError(err)
-	requireSnapshotResult(t, streamData, 1, 1, mbs[1:], lastEvents)
+		streamData, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 6)
+		require.NoError(err)
+		requireSnapshotResult(t, streamData, 10, 5, mbs[10:], lastEvents)
+	})
+}
 
-	mb, h := dataMaker.mb()
-	mbs = append(mbs, mb)
-	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, h, 15, mb))
-	lastEvents = dataMaker.events(5)
-	require.NoError(promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 15, h, true, lastEvents))
+func TestTrimStream(t *testing.T) {
+	forEachStreamStorage(t, func(t *testing.T, ctx context.Context, store StreamStorage) {
+		require := require.New(t)
 
-	streamData, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 6)
-	require.NoError(err)
-	requireSnapshotResult(t, streamData, 10, 5, mbs[10:], lastEvents)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		dataMaker := newDataMaker()
+
+		genMB, _ := dataMaker.mb()
+		require.NoError(store.CreateStreamStorage(ctx, streamId, genMB))
+
+		mbs := dataMaker.mbs(1, 5)
+		mbs[2].Snapshot = true
+		events := dataMaker.events(3)
+		require.NoError(store.WriteMiniblocks(ctx, streamId, mbs, 6, events, 1, 0))
+
+		// Trimming past the latest snapshot is not allowed.
+		err := store.TrimStream(ctx, streamId, 4)
+		require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+
+		require.NoError(store.TrimStream(ctx, streamId, 3))
+
+		_, err = store.ReadMiniblocks(ctx, streamId, 0, 4)
+		require.Equal(Err_MINIBLOCKS_TRIMMED, AsRiverError(err).Code)
+		_, err = store.ReadMiniblocks(ctx, streamId, 1, 2)
+		require.Equal(Err_MINIBLOCKS_TRIMMED, AsRiverError(err).Code)
+
+		read, err := store.ReadMiniblocks(ctx, streamId, 3, 6)
+		require.NoError(err)
+		require.Equal([][]byte{mbs[2].Data, mbs[3].Data, mbs[4].Data}, read)
+
+		read, err = store.ReadMiniblocks(ctx, streamId, 6, 10)
+		require.NoError(err)
+		require.Empty(read)
+
+		result, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 10)
+		require.NoError(err)
+		requireSnapshotResult(t, result, 3, 0, [][]byte{mbs[2].Data, mbs[3].Data, mbs[4].Data}, events)
+
+		lastMiniblockNum, err := store.GetLastMiniblockNumber(ctx, streamId)
+		require.NoError(err)
+		require.EqualValues(5, lastMiniblockNum)
+	})
 }
 
 func TestQueryPlan(t *testing.T) {
@@ -11,6 +11,7 @@ import (
 const (
 	postgres                        = "postgres"
 	StreamStorageTypePostgres       = postgres
+	StreamStorageTypePebble         = "pebble"
 	NotificationStorageTypePostgres = postgres
 	AppRegistryStorageTypePostgres  = postgres
 )
@@ -42,6 +43,7 @@ type StreamStorage interface {
 	) (*ReadStreamFromLastSnapshotResult, error)
 
 	// ReadMiniblocks returns miniblocks with miniblockNum or "generation" from fromInclusive, to toExlusive.
+	// Returns Err_MINIBLOCKS_TRIMMED if miniblocks from the requested range were removed by TrimStream.
 	ReadMiniblocks(ctx context.Context, streamId StreamId, fromInclusive int64, toExclusive int64) ([][]byte, error)
 
 	// ReadMiniblocksByStream calls onEachMb for each selected miniblock
@@ -133,6 +135,11 @@ type StreamStorage interface {
 		miniblocks [][]byte,
 	) error
 
+	// TrimStream deletes miniblocks with numbers lower than trimBeforeMiniblockNum and their indexed events.
+	// trimBeforeMiniblockNum can't be greater than the latest snapshot miniblock number,
+	// so the stream can always be loaded from the remaining miniblocks.
+	TrimStream(ctx context.Context, streamId StreamId, trimBeforeMiniblockNum int64) error
+
 	DebugReadStreamData(
 		ctx context.Context,
 		streamId StreamId,
@@ -37,6 +37,7 @@ type StreamFilter interface {
 
 type StreamsTracker interface {
 	Run(ctx context.Context) error
+	Refresh(ctx context.Context, historySince time.Time) error
 }
 
 // The StreamsTrackerImpl implements watching the river registry, detecting new streams, and syncing them.
@@ -50,7 +51,7 @@ type StreamsTrackerImpl struct {
 	onChainConfig  crypto.OnChainConfiguration
 	listener       StreamEventListener
 	metrics        *TrackStreamsSyncMetrics
-	tracked        sync.Map // map[shared.StreamId] = struct{}
+	tracked        sync.Map // map[shared.StreamId] = context.CancelFunc
 	syncRunner     *SyncRunner
 }
 
@@ -92,6 +93,64 @@ func (tracker *StreamsTrackerImpl) Listener() StreamEventListener {
 
 // Run the stream tracker workers until the given ctx expires.
 func (tracker *StreamsTrackerImpl) Run(ctx context.Context) error {
+	var (
+		log   = logging.FromCtx(ctx)
+		start = time.Now()
+	)
+
+	streamsLoaded, totalStreams, err := tracker.loadStreams(ctx, false, time.Time{})
+	if err != nil {
+		return err
+	}
+
+	log.Infow("Loaded streams from streams registry",
+		"count", streamsLoaded,
+		"total", totalStreams,
+		"took", time.Since(start).String())
+
+	// wait till service stopped
+	<-ctx.Done()
+
+	log.Infow("stream tracker stopped")
+
+	return nil
+}
+
+// Refresh re-evaluates the filter for all streams. Tracked streams that the filter doesn't accept anymore
+// are not synced anymore and streams from the river registry that are accepted and not yet tracked are synced
+// until the given ctx expires. This is used when the set of streams an instance is responsible for changes.
+//
+// Events of newly tracked streams that were created at or after historySince are applied as new events,
+// these events may not have been processed by the instance that was responsible for the stream before.
+func (tracker *StreamsTrackerImpl) Refresh(ctx context.Context, historySince time.Time) error {
+	stopped := 0
+	tracker.tracked.Range(func(key, value any) bool {
+		if !tracker.filter.TrackStream(key.(shared.StreamId)) {
+			tracker.tracked.Delete(key)
+			value.(context.CancelFunc)()
+			stopped++
+		}
+		return true
+	})
+
+	streamsLoaded, _, err := tracker.loadStreams(ctx, true, historySince)
+	if err != nil {
+		return err
+	}
+
+	logging.FromCtx(ctx).Infow("Refreshed tracked streams", "stopped", stopped, "count", streamsLoaded)
+
+	return nil
+}
+
+// loadStreams starts tracking all streams in the river registry that the filter accepts and that are not
+// yet tracked. If applyHistoricalStreamContents is true, events created at or after historySince are applied
+// as new events. It returns the number of accepted streams and the total number of streams.
+func (tracker *StreamsTrackerImpl) loadStreams(
+	ctx context.Context,
+	applyHistoricalStreamContents bool,
+	historySince time.Time,
+) (int, int, error) {
 	// load streams and distribute streams by hashing the stream id over buckets and assign each bucket
 	// to a worker to process stream updates.
 	var (
@@ -100,7 +159,6 @@ func (tracker *StreamsTrackerImpl) Run(ctx context.Context) error {
 		streamsLoaded         = 0
 		totalStreams          = 0
 		streamsLoadedProgress = 0
-		start                 = time.Now()
 	)
 
 	err := tracker.riverRegistry.ForAllStreams(
@@ -136,40 +194,41 @@ func (tracker *StreamsTrackerImpl) Run(ctx context.Context) error {
 			streamsLoaded++
 
 			// start stream sync session for stream if it hasn't seen before
-			_, loaded := tracker.tracked.LoadOrStore(stream.StreamId, struct{}{})
-			if !loaded {
-				// start tracking the stream until ctx expires
-				go func() {
-					idx := rand.Int63n(int64(len(tracker.nodeRegistries)))
-					tracker.syncRunner.Run(
-						ctx,
-						stream,
-						false,
-						tracker.nodeRegistries[idx],
-						tracker.onChainConfig,
-						tracker.filter.NewTrackedStream,
-						tracker.metrics,
-					)
-				}()
-			}
+			tracker.track(ctx, stream, applyHistoricalStreamContents, historySince)
 
 			return true
 		})
-	if err != nil {
-		return err
-	}
-
-	log.Infow("Loaded streams from streams registry",
-		"count", streamsLoaded,
-		"total", totalStreams,
-		"took", time.Since(start).String())
 
-	// wait till service stopped
-	<-ctx.Done()
+	return streamsLoaded, totalStreams, err
+}
 
-	log.Infow("stream tracker stopped")
+// track starts syncing the stream until ctx expires or the stream is untracked by Refresh,
+// if the stream isn't already tracked.
+func (tracker *StreamsTrackerImpl) track(
+	ctx context.Context,
+	stream *registries.GetStreamResult,
+	applyHistoricalStreamContents bool,
+	historySince time.Time,
+) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	if _, loaded := tracker.tracked.LoadOrStore(stream.StreamId, cancel); loaded {
+		cancel()
+		return
+	}
 
-	return nil
+	go func() {
+		idx := rand.Int63n(int64(len(tracker.nodeRegistries)))
+		tracker.syncRunner.Run(
+			streamCtx,
+			stream,
+			applyHistoricalStreamContents,
+			historySince,
+			tracker.nodeRegistries[idx],
+			tracker.onChainConfig,
+			tracker.filter.NewTrackedStream,
+			tracker.metrics,
+		)
+	}()
 }
 
 // OnStreamAllocated is called each time a stream is allocated in the river registry.
@@ -184,26 +243,10 @@ func (tracker *StreamsTrackerImpl) OnStreamAllocated(
 		return
 	}
 
-	_, loaded := tracker.tracked.LoadOrStore(streamID, struct{}{})
-	if !loaded {
-		go func() {
-			stream := &registries.GetStreamResult{
-				StreamId: streamID,
-				Nodes:    event.Nodes,
-			}
-
-			idx := rand.Int63n(int64(len(tracker.nodeRegistries)))
-			tracker.syncRunner.Run(
-				ctx,
-				stream,
-				true,
-				tracker.nodeRegistries[idx],
-				tracker.onChainConfig,
-				tracker.filter.NewTrackedStream,
-				tracker.metrics,
-			)
-		}()
-	}
+	tracker.track(ctx, &registries.GetStreamResult{
+		StreamId: streamID,
+		Nodes:    event.Nodes,
+	}, true, time.Time{})
 }
 
 func (tracker *StreamsTrackerImpl) OnStreamLastMiniblockUpdated(
@@ -91,6 +91,7 @@ func (sr *SyncRunner) Run(
 	rootCtx context.Context,
 	stream *registries.GetStreamResult,
 	applyHistoricalStreamContents bool,
+	historySince time.Time,
 	nodeRegistry nodes.NodeRegistry,
 	onChainConfig crypto.OnChainConfiguration,
 	newTrackedStreamView TrackedViewConstructorFn,
@@ -330,7 +331,8 @@ func (sr *SyncRunner) Run(
 					if applyHistoricalStreamContents {
 						// Send notifications for all events in all blocks.
 						for _, event := range block.GetEvents() {
-							if parsedEvent, err := events.ParseEvent(event); err == nil {
+							if parsedEvent, err := events.ParseEvent(event); err == nil &&
+								isHistoricalEventSince(parsedEvent, historySince) {
 								_ = trackedStream.SendEventNotification(syncCtx, parsedEvent)
 							}
 						}
@@ -342,7 +344,8 @@ func (sr *SyncRunner) Run(
 					// notify on them because they were not added via ApplyEvent. If added below, the events
 					// will be silently skipped because they are already a part of the minipool.
 					if applyHistoricalStreamContents {
-						if parsedEvent, err := events.ParseEvent(event); err == nil {
+						if parsedEvent, err := events.ParseEvent(event); err == nil &&
+							isHistoricalEventSince(parsedEvent, historySince) {
 							if err := trackedStream.SendEventNotification(syncCtx, parsedEvent); err != nil {
 								log.Debugw(
 									"Error sending notification for historical event",
@@ -547,3 +550,9 @@ func (sr *SyncRunner) waitMaxOrUntilCancel(
 		return true
 	}
 }
+
+// isHistoricalEventSince returns true if the historical event was created at or after since.
+// All historical events are accepted when since is zero.
+func isHistoricalEventSince(event *events.ParsedEvent, since time.Time) bool {
+	return since.IsZero() || event.Event.GetCreatedAtEpochMs() >= since.UnixMilli()
+}